@@ -41,13 +41,14 @@ func (s *Service) ParseBeaconBlock(req encoding.ABCIRequest) (
 		)
 	}
 
-	forkVersion := s.chainSpec.ActiveForkVersionForTimestamp(math.U64(req.GetTime().Unix())) //#nosec: G115
+	timestamp := math.U64(req.GetTime().Unix()) //#nosec: G115
 	// Decode signed block and sidecars.
 	signedBlk, sidecars, err := encoding.ExtractBlobsAndBlockFromRequest(
 		req,
 		BeaconBlockTxIndex,
 		BlobSidecarsTxIndex,
-		forkVersion,
+		s.chainSpec.ActiveForkVersionForTimestamp(timestamp),
+		s.chainSpec.IsProposalCompressed(timestamp),
 	)
 	if err != nil {
 		return nil, nil, err
@@ -26,9 +26,38 @@ import (
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/execution/engine"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// isVoting returns true if the node is in the validator set of st, i.e. it
+// votes on the blocks built on top of st.
+func (s *Service) isVoting(st *statedb.StateDB) bool {
+	_, err := st.ValidatorIndexByPubkey(s.proposerPubkey)
+	return err == nil
+}
+
+// VerifiesProposalPayloads returns true if the node verifies the execution
+// payloads of the proposals processed on ctx with the execution client. Only
+// the nodes in the validator set do, as they must verify the payloads they
+// vote for. The other nodes must not stall on an unavailable execution client
+// and hand the payloads over once the blocks are finalized.
+func (s *Service) VerifiesProposalPayloads(ctx sdk.Context) bool {
+	return s.isVoting(s.storageBackend.StateFromContext(ctx))
+}
+
+// optimisticContext returns a copy of ctx allowing the execution engine, if
+// its optimistic mode is enabled, to continue while the execution client is
+// unavailable. Nodes in the validator set of st are never allowed to, as they
+// must verify the payloads they vote for.
+func (s *Service) optimisticContext(ctx context.Context, st *statedb.StateDB) context.Context {
+	if s.isVoting(st) {
+		return ctx
+	}
+	return engine.WithOptimistic(ctx)
+}
+
 // sendPostBlockFCU sends a forkchoice update to the execution client after a
 // block is finalized.
 func (s *Service) sendPostBlockFCU(
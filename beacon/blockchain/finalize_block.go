@@ -50,6 +50,10 @@ func (s *Service) FinalizeBlock(
 	blk := signedBlk.GetBeaconBlock()
 	st := s.storageBackend.StateFromContext(ctx)
 
+	// The block is already committed by the validators, so a non-validator
+	// node may finalize it without the execution client.
+	ctx = ctx.WithContext(s.optimisticContext(ctx.Context(), st))
+
 	// Send an FCU to force the HEAD of the chain on the EL on startup.
 	var finalizeErr error
 	s.forceStartupSyncOnce.Do(func() {
@@ -141,6 +145,12 @@ func (s *Service) PostFinalizeBlockOps(ctx sdk.Context, blk *ctypes.BeaconBlock)
 		return err
 	}
 
+	// Archive the finalized state so it can be served once pruned from the IAVL store.
+	if err := s.archiveState(st); err != nil {
+		s.logger.Error("failed to archive state", "slot", slot, "error", err)
+		return err
+	}
+
 	// Prune the availability and deposit store.
 	if err := s.processPruning(ctx, blk); err != nil {
 		s.logger.Error("failed to processPruning", "error", err)
@@ -154,6 +164,9 @@ func (s *Service) PostFinalizeBlockOps(ctx sdk.Context, blk *ctypes.BeaconBlock)
 	// that no payload is available to reuse for blk.Slot
 	s.localBuilder.CacheLatestVerifiedPayload(blk.Slot, nil)
 
+	if s.finalizeHooks != nil {
+		s.finalizeHooks.RunFinalizeHooks(ctx, blk, st)
+	}
 	return nil
 }
 
@@ -39,6 +39,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/transition"
 	"github.com/berachain/beacon-kit/state-transition/core"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/archive"
 	"github.com/berachain/beacon-kit/storage/block"
 	"github.com/berachain/beacon-kit/storage/deposit"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
@@ -120,6 +121,8 @@ type StorageBackend interface {
 	DepositStore() deposit.StoreManager
 	// BlockStore retrieves the block store.
 	BlockStore() *block.KVStore[*ctypes.BeaconBlock]
+	// StateArchive retrieves the archive of historical beacon states.
+	StateArchive() *archive.Store
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
@@ -149,6 +152,7 @@ type BlockchainI interface {
 		*cmtabci.ProcessProposalRequest,
 		[]byte, // this node address
 	) (transition.ValidatorUpdates, error)
+	VerifiesProposalPayloads(sdk.Context) bool
 	FinalizeSidecars(
 		ctx sdk.Context,
 		syncingToHeight int64,
@@ -164,6 +168,7 @@ type BlockchainI interface {
 		*ctypes.BeaconBlock,
 	) error
 	PruneOrphanedBlobs(lastBlockHeight int64) error
+	BackfillBlockStore(ctx context.Context) error
 }
 
 // BlobProcessor is the interface for the blobs processor.
@@ -196,7 +201,16 @@ type ServiceChainSpec interface {
 	delay.ConfigGetter
 
 	EpochsPerHistoricalVector() uint64
+	SlotsPerHistoricalRoot() uint64
 	SlotToEpoch(slot math.Slot) math.Epoch
 	Eth1FollowDistance() uint64
 	MaxDepositsPerBlock() uint64
 }
+
+// FinalizeHooks are run once a block is finalized, e.g. by the plugins of the
+// node.
+type FinalizeHooks interface {
+	// RunFinalizeHooks runs the hooks on the finalized block and its
+	// post-state.
+	RunFinalizeHooks(ctx context.Context, blk *ctypes.BeaconBlock, st *statedb.StateDB)
+}
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	archive "github.com/berachain/beacon-kit/storage/archive"
+
 	block "github.com/berachain/beacon-kit/storage/block"
 
 	context "context"
@@ -168,6 +170,53 @@ func (_c *StorageBackend_DepositStore_Call) RunAndReturn(run func() deposit.Stor
 	return _c
 }
 
+// StateArchive provides a mock function with no fields
+func (_m *StorageBackend) StateArchive() *archive.Store {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for StateArchive")
+	}
+
+	var r0 *archive.Store
+	if rf, ok := ret.Get(0).(func() *archive.Store); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*archive.Store)
+		}
+	}
+
+	return r0
+}
+
+// StorageBackend_StateArchive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StateArchive'
+type StorageBackend_StateArchive_Call struct {
+	*mock.Call
+}
+
+// StateArchive is a helper method to define mock.On call
+func (_e *StorageBackend_Expecter) StateArchive() *StorageBackend_StateArchive_Call {
+	return &StorageBackend_StateArchive_Call{Call: _e.mock.On("StateArchive")}
+}
+
+func (_c *StorageBackend_StateArchive_Call) Run(run func()) *StorageBackend_StateArchive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *StorageBackend_StateArchive_Call) Return(_a0 *archive.Store) *StorageBackend_StateArchive_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StorageBackend_StateArchive_Call) RunAndReturn(run func() *archive.Store) *StorageBackend_StateArchive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StateFromContext provides a mock function with given fields: _a0
 func (_m *StorageBackend) StateFromContext(_a0 context.Context) *state.StateDB {
 	ret := _m.Called(_a0)
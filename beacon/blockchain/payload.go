@@ -200,6 +200,7 @@ func (s *Service) preFetchBuildData(st *statedb.StateDB, currentTime math.U64) (
 			// of the latest block we verified must be final already.
 			FinalizedBlockHash: lph.GetParentHash(),
 		},
+		ProposerPubkey:       s.proposerPubkey,
 		ParentProposerPubkey: parentProposerPubkey,
 	}, nil
 }
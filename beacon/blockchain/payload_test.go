@@ -42,6 +42,7 @@ import (
 	"github.com/berachain/beacon-kit/payload/builder"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	"github.com/berachain/beacon-kit/primitives/version"
@@ -55,6 +56,9 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// genesisPubkey is the pubkey of the only genesis validator of the tests.
+var genesisPubkey = crypto.BLSPubkey{0x01}
+
 // When we reject a block and we have optimistic payload building enabled
 // we must make sure that a few beacon state quantities are duly pre-processed
 // before building the block.
@@ -64,7 +68,7 @@ func TestOptimisticBlockBuildingRejectedBlockStateChecks(t *testing.T) {
 	cs, err := spec.MainnetChainSpec()
 	require.NoError(t, err)
 
-	chain, st, _, ctx, _, b, sb, eng, depStore := setupOptimisticPayloadTests(t, cs)
+	chain, st, _, ctx, _, b, sb, eng, depStore := setupOptimisticPayloadTests(t, cs, genesisPubkey)
 	sb.EXPECT().StateFromContext(mock.Anything).Return(st)
 	sb.EXPECT().DepositStore().RunAndReturn(func() deposit.StoreManager { return depStore })
 	b.EXPECT().Enabled().Return(true)
@@ -153,7 +157,7 @@ func TestOptimisticBlockBuildingVerifiedBlockStateChecks(t *testing.T) {
 	cs, err := spec.MainnetChainSpec()
 	require.NoError(t, err)
 
-	chain, st, cms, ctx, sp, b, sb, eng, depStore := setupOptimisticPayloadTests(t, cs)
+	chain, st, cms, ctx, sp, b, sb, eng, depStore := setupOptimisticPayloadTests(t, cs, genesisPubkey)
 	sb.EXPECT().StateFromContext(mock.Anything).Return(st).Times(1) // only for genesis
 	sb.EXPECT().DepositStore().RunAndReturn(func() deposit.StoreManager { return depStore })
 	b.EXPECT().Enabled().Return(true)
@@ -253,7 +257,70 @@ func TestOptimisticBlockBuildingVerifiedBlockStateChecks(t *testing.T) {
 	require.Equal(t, validBlk.GetSlot(), slot)
 }
 
-func setupOptimisticPayloadTests(t *testing.T, cs chain.Spec) (
+// A node outside the validator set does not vote on the blocks it verifies,
+// so it must accept a valid block without the execution client, leaving the
+// payload to be handed over once the block is finalized.
+func TestVerifyIncomingBlockWithoutVotingSkipsExecutionClient(t *testing.T) {
+	t.Parallel()
+
+	cs, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+
+	chain, st, cms, ctx, sp, _, sb, eng, depStore := setupOptimisticPayloadTests(t, cs, crypto.BLSPubkey{0xff})
+	sb.EXPECT().StateFromContext(mock.Anything).Return(st)
+	sb.EXPECT().DepositStore().RunAndReturn(func() deposit.StoreManager { return depStore })
+
+	genesisData := testProcessGenesis(t, cs, chain, ctx)
+	//nolint:errcheck // false positive as this has no return value
+	ctx.ConsensusCtx().(sdk.Context).MultiStore().(storetypes.CacheMultiStore).Write()
+	require.False(t, chain.VerifiesProposalPayloads(ctx.ConsensusCtx().(sdk.Context)))
+
+	// Since this is the first block called post genesis
+	// forceSyncUponProcess will be called.
+	eng.EXPECT().NotifyForkchoiceUpdate(mock.Anything, mock.Anything).Return(nil, nil)
+
+	var (
+		consensusTime = time.Now()
+		proposer      = ctx.ProposerAddress()
+	)
+	sdkCtx := sdk.NewContext(cms.CacheMultiStore(), true, log.NewNopLogger())
+	buildState := state.NewBeaconStateFromDB(
+		st.KVStore.WithContext(sdkCtx), cs, sdkCtx.Logger(), metrics.NewNoOpTelemetrySink(),
+	)
+	_, err = sp.ProcessSlots(buildState, constants.GenesisSlot+1)
+	require.NoError(t, err)
+	validBlk := buildNextBlock(
+		t,
+		cs,
+		buildState,
+		ctypes.NewEth1Data(ctypes.Deposits(genesisData.Deposits).HashTreeRoot()),
+		math.U64(cs.GenesisTime()+1),
+	)
+	stateRoot, err := computeStateRoot(
+		ctx.ConsensusCtx(),
+		proposer,
+		math.U64(consensusTime.Unix()),
+		sp,
+		buildState,
+		validBlk,
+	)
+	require.NoError(t, err)
+	validBlk.SetStateRoot(stateRoot)
+
+	_, err = chain.VerifyIncomingBlock(
+		ctx.ConsensusCtx(),
+		types.NewConsensusBlock(validBlk, proposer, consensusTime),
+		false,
+	)
+	require.NoError(t, err)
+	eng.AssertNotCalled(t, "NotifyNewPayload", mock.Anything, mock.Anything, mock.Anything)
+
+	slot, err := st.GetSlot()
+	require.NoError(t, err)
+	require.Equal(t, validBlk.GetSlot(), slot)
+}
+
+func setupOptimisticPayloadTests(t *testing.T, cs chain.Spec, nodePubkey crypto.BLSPubkey) (
 	*blockchain.Service,
 	*statetransition.TestBeaconStateT,
 	storetypes.CommitMultiStore,
@@ -281,7 +348,9 @@ func setupOptimisticPayloadTests(t *testing.T, cs chain.Spec) (
 		eng,
 		b,
 		sp,
+		nodePubkey,
 		ts,
+		nil, // blockchain.FinalizeHooks unused in this test
 	)
 	return chain, st, cms, ctx, sp, b, sb, eng, depStore
 }
@@ -300,7 +369,7 @@ func testProcessGenesis(
 	genesisData.ExecutionPayloadHeader.Timestamp = math.U64(cs.GenesisTime())
 	genesisData.Deposits = []*ctypes.Deposit{
 		{
-			Pubkey: [48]byte{0x01},
+			Pubkey: genesisPubkey,
 			Amount: cs.MaxEffectiveBalance(),
 			Credentials: ctypes.NewCredentialsFromExecutionAddress(
 				common.ExecutionAddress{0x01},
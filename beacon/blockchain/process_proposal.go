@@ -336,8 +336,10 @@ func (s *Service) VerifyIncomingBlock(
 		return nil, err
 	}
 
-	// Verify the state root of the incoming block.
-	valUpdates, err := s.verifyStateRoot(ctx, state, blk)
+	// Verify the state root of the incoming block. A node outside the validator
+	// set does not vote, so it leaves the payload to the execution client until
+	// the block is finalized rather than stalling while the client is unavailable.
+	valUpdates, err := s.verifyStateRoot(ctx, state, blk, s.isVoting(state))
 	if err != nil {
 		s.logger.Error(
 			"Rejecting incoming beacon block ❌ ",
@@ -387,6 +389,7 @@ func (s *Service) verifyStateRoot(
 	ctx context.Context,
 	st *statedb.StateDB,
 	blk *types.ConsensusBlock,
+	verifyPayload bool,
 ) (transition.ValidatorUpdates, error) {
 	startTime := time.Now()
 
@@ -404,7 +407,7 @@ func (s *Service) verifyStateRoot(
 		blk.GetConsensusTime(),
 		blk.GetProposerAddress(),
 	).
-		WithVerifyPayload(true).
+		WithVerifyPayload(verifyPayload).
 		WithVerifyRandao(true).
 		WithVerifyResult(true).
 		WithMeterGas(isCacheActive)
@@ -22,11 +22,15 @@ package blockchain
 
 import (
 	"context"
+	stdmath "math"
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 func (s *Service) processPruning(ctx context.Context, beaconBlk *ctypes.BeaconBlock) error {
+	pruneDeposits := s.shouldPruneDeposits(beaconBlk)
+
 	// prune availability store
 	start, end := availabilityPruneRangeFn(beaconBlk.GetSlot().Unwrap(), s.chainSpec)
 	err := s.storageBackend.AvailabilityStore().Prune(start, end)
@@ -35,20 +39,25 @@ func (s *Service) processPruning(ctx context.Context, beaconBlk *ctypes.BeaconBl
 	}
 
 	// prune deposit store
-	start, end = depositPruneRangeFn(beaconBlk.GetBody().GetDeposits(), s.chainSpec)
-	err = s.storageBackend.DepositStore().Prune(ctx, start, end)
-	if err != nil {
-		return err
+	if !pruneDeposits {
+		return nil
 	}
-
-	return nil
+	return s.storageBackend.DepositStore().PruneToSnapshot(ctx, stdmath.MaxUint64)
 }
 
-func depositPruneRangeFn([]*ctypes.Deposit, PruningChainSpec) (uint64, uint64) {
-	// The whole deposit list is validated in consensus and its Merkle root is part of
-	// Beacon State. Therefore, every node must keep the full deposit list and deposits
-	// pruning must be turned off.
-	return 0, 0
+// shouldPruneDeposits returns true if the deposit store can be pruned once the block is finalized.
+// Before Fulu, the whole deposit list is validated in consensus against the local deposit store,
+// so every node must keep it. The first block of Fulu exhausts the deposit queue from the whole
+// list and later blocks carry EIP-6110 deposit requests instead, so from then on deposits can be
+// replaced with their EIP-4881 snapshot. Pruning runs before the block is committed, so it waits
+// for the parent block to be Fulu: the first Fulu block may otherwise be replayed against a pruned
+// store after a crash or a rollback. The parent is only known once the node finalized a block since
+// it started, so pruning skips the first block after a restart. The deposit store itself only
+// prunes if enabled in its configuration.
+func (s *Service) shouldPruneDeposits(beaconBlk *ctypes.BeaconBlock) bool {
+	parentIsFulu := s.lastFinalizedIsFulu
+	s.lastFinalizedIsFulu = version.EqualsOrIsAfter(beaconBlk.GetForkVersion(), version.Fulu())
+	return parentIsFulu && s.lastFinalizedIsFulu
 }
 
 //nolint:unparam // this is ok
@@ -29,7 +29,10 @@ import (
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/execution/deposit"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 )
 
 // Service is the blockchain service.
@@ -54,6 +57,9 @@ type Service struct {
 	localBuilder LocalBuilder
 	// stateProcessor is the state processor for beacon blocks and states.
 	stateProcessor StateProcessor
+	// proposerPubkey is the public key of the local validator, for which
+	// payloads are optimistically built.
+	proposerPubkey crypto.BLSPubkey
 	// metrics is the metrics for the service.
 	metrics *chainMetrics
 	// forceStartupSyncOnce is used to force a sync of the startup head.
@@ -62,6 +68,11 @@ type Service struct {
 	// It helps avoid resending the same FCU data (and spares a network call)
 	// in case optimistic block building is active
 	latestFcuReq atomic.Pointer[engineprimitives.ForkchoiceStateV1]
+	// finalizeHooks, if set, are run once a block is finalized.
+	finalizeHooks FinalizeHooks
+	// lastFinalizedIsFulu is true if the block last finalized by the node is a Fulu
+	// block. It gates the pruning of the deposit store.
+	lastFinalizedIsFulu bool
 }
 
 // NewService creates a new validator service.
@@ -74,7 +85,9 @@ func NewService(
 	executionEngine ExecutionEngine,
 	localBuilder LocalBuilder,
 	stateProcessor StateProcessor,
+	proposerPubkey crypto.BLSPubkey,
 	telemetrySink TelemetrySink,
+	finalizeHooks FinalizeHooks,
 ) *Service {
 	return &Service{
 		storageBackend:       storageBackend,
@@ -86,8 +99,10 @@ func NewService(
 		executionEngine:      executionEngine,
 		localBuilder:         localBuilder,
 		stateProcessor:       stateProcessor,
+		proposerPubkey:       proposerPubkey,
 		metrics:              newChainMetrics(telemetrySink),
 		forceStartupSyncOnce: new(sync.Once),
+		finalizeHooks:        finalizeHooks,
 	}
 }
 
@@ -101,7 +116,7 @@ func (s *Service) Start(context.Context) error {
 	return nil
 }
 
-// Stop stops the blockchain service and closes the deposit store.
+// Stop stops the blockchain service and closes the deposit and block stores and the state archive.
 func (s *Service) Stop() error {
 	s.logger.Info("Stopping blockchain service")
 
@@ -110,6 +125,16 @@ func (s *Service) Stop() error {
 		s.logger.Error("failed to close deposit store", "err", err)
 	}
 
+	err = s.storageBackend.BlockStore().Close()
+	if err != nil {
+		s.logger.Error("failed to close block store", "err", err)
+	}
+
+	err = s.storageBackend.StateArchive().Close()
+	if err != nil {
+		s.logger.Error("failed to close state archive", "err", err)
+	}
+
 	return nil
 }
 
@@ -148,3 +173,60 @@ func (s *Service) PruneOrphanedBlobs(lastBlockHeight int64) error {
 
 	return nil
 }
+
+// BackfillBlockStore indexes the block and state roots still held in the beacon state, so that blocks
+// finalized before the block store was persisted can be looked up by root. Timestamps are not part of
+// the beacon state and are only indexed for blocks finalized from now on.
+func (s *Service) BackfillBlockStore(ctx context.Context) error {
+	st := s.storageBackend.StateFromContext(ctx)
+	head, err := st.GetSlot()
+	if err != nil {
+		return fmt.Errorf("failed loading state slot: %w", err)
+	}
+
+	// The latest block header does not carry its state root until the next slot is processed, so the
+	// roots of the head block are computed from the current state.
+	headStateRoot := st.HashTreeRoot()
+	latestHeader, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return fmt.Errorf("failed loading latest block header: %w", err)
+	}
+	latestHeader.SetStateRoot(headStateRoot)
+
+	blockStore := s.storageBackend.BlockStore()
+	if err = blockStore.Backfill(head, head, latestHeader.HashTreeRoot(), headStateRoot); err != nil {
+		return err
+	}
+
+	// Roots of previous slots are kept in the state ring buffers.
+	historicalRoots := s.chainSpec.SlotsPerHistoricalRoot()
+	depth := min(historicalRoots, blockStore.AvailabilityWindow(), head.Unwrap())
+	for slot := head - math.Slot(depth); slot < head; slot++ {
+		var blockRoot, stateRoot common.Root
+		if blockRoot, err = st.GetBlockRootAtIndex(slot.Unwrap() % historicalRoots); err != nil {
+			return fmt.Errorf("failed loading block root at slot %d: %w", slot, err)
+		}
+		if stateRoot, err = st.StateRootAtIndex(slot.Unwrap() % historicalRoots); err != nil {
+			return fmt.Errorf("failed loading state root at slot %d: %w", slot, err)
+		}
+		if err = blockStore.Backfill(head, slot, blockRoot, stateRoot); err != nil {
+			return err
+		}
+	}
+
+	s.logger.Info("Backfilled block store from beacon state", "head", head.Base10(), "depth", depth)
+	return nil
+}
+
+// archiveState stores the given finalized state in the state archive, if enabled.
+func (s *Service) archiveState(st *statedb.StateDB) error {
+	stateArchive := s.storageBackend.StateArchive()
+	if !stateArchive.Enabled() {
+		return nil
+	}
+	marshallable, err := st.GetMarshallable()
+	if err != nil {
+		return fmt.Errorf("failed marshalling state: %w", err)
+	}
+	return stateArchive.Append(marshallable)
+}
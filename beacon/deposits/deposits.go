@@ -65,7 +65,7 @@ func CatchupFuluDeposits(
 	}
 
 	// Read the deposits from the deposit contract.
-	deposits, err := depositContract.ReadDeposits(ctx, lph.GetNumber())
+	deposits, blockHash, err := depositContract.ReadDeposits(ctx, lph.GetNumber())
 	if err != nil {
 		return err
 	}
@@ -73,7 +73,9 @@ func CatchupFuluDeposits(
 	// Enqueue the deposits if we found any.
 	if len(deposits) > 0 {
 		logger.Info("Found deposits to catchup for Fulu", "num", len(deposits))
-		if err = depositStore.EnqueueDeposits(ctx, deposits); err != nil {
+		if err = depositStore.EnqueueDepositsFromBlock(
+			ctx, deposits, lph.GetNumber().Unwrap(), blockHash,
+		); err != nil {
 			logger.Error("Failed to store catchup deposits for Fulu", "error", err)
 			return err
 		}
@@ -111,7 +113,7 @@ func FetchPreviousDepositsPreFulu(
 		return
 	}
 	blockToFetch := blockNum - eth1FollowDistance
-	deposits, err := depositContract.ReadDeposits(ctx, blockToFetch)
+	deposits, blockHash, err := depositContract.ReadDeposits(ctx, blockToFetch)
 	if err != nil {
 		logger.Error("Failed to read deposits", "block", blockNum, "error", err)
 		return
@@ -127,7 +129,7 @@ func FetchPreviousDepositsPreFulu(
 		)
 	}
 
-	if err = depositStore.EnqueueDeposits(ctx, deposits); err != nil {
+	if err = depositStore.EnqueueDepositsFromBlock(ctx, deposits, blockToFetch.Unwrap(), blockHash); err != nil {
 		logger.Error("Failed to store deposits", "block", blockNum, "error", err)
 	}
 }
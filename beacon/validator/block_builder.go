@@ -29,9 +29,9 @@ import (
 	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/consensus/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/payload/builder"
-	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -54,6 +54,8 @@ func (s *Service) BuildBlockAndSidecars(
 		// node is not supposed to build blocks
 		return nil, nil, builder.ErrPayloadBuilderDisabled
 	}
+	outcome := proposalOutcomeFailed
+	defer func() { s.metrics.proposal(outcome) }()
 
 	// The goal here is to acquire a payload whose parent is the previously
 	// finalized block, such that, if this payload is accepted, it will be
@@ -78,12 +80,136 @@ func (s *Service) BuildBlockAndSidecars(
 		return nil, nil, err
 	}
 
+	// Propose the block signed by an external validator client for this slot,
+	// if any. Otherwise build and sign the block locally.
+	signedBlk, sidecars, ok := s.takeSubmittedBlock(st, slotData, parentBlockRoot)
+	proposalOutcome := proposalOutcomeFull
+	if !ok {
+		// Keep a copy of the state to build the fallback block on, since a
+		// failed build may leave the state modified.
+		fallbackSt := st.Protect(ctx)
+		signedBlk, sidecars, err = s.buildSignedBlock(ctx, st, slotData, parentBlockRoot)
+		if err != nil {
+			signedBlk, sidecars, err = s.buildFallbackBlock(ctx, fallbackSt, slotData, parentBlockRoot, err)
+			if err != nil {
+				return nil, nil, err
+			}
+			proposalOutcome = proposalOutcomeFallback
+		}
+	}
+
+	s.logger.Info(
+		"Beacon block successfully built",
+		"slot", blkSlot.Base10(),
+		"state_root", signedBlk.GetStateRoot(),
+		"duration", time.Since(startTime).String(),
+	)
+
+	signedBlkBytes, bbErr := signedBlk.MarshalSSZ()
+	if bbErr != nil {
+		return nil, nil, bbErr
+	}
+	sidecarsBytes, scErr := sidecars.MarshalSSZ()
+	if scErr != nil {
+		return nil, nil, scErr
+	}
+
+	outcome = proposalOutcome
+	return signedBlkBytes, sidecarsBytes, nil
+}
+
+// buildFallbackBlock builds and signs a block around a fallback payload after
+// building the block the regular way failed with buildErr, so that the slot is
+// still proposed. It is bounded by the FallbackProposalTimeout deadline: the
+// fallback payload may still require the execution client, see FallbackPayload,
+// in which case an execution client slower than the deadline fails the
+// proposal with buildErr as before.
+func (s *Service) buildFallbackBlock(
+	ctx context.Context,
+	st *statedb.StateDB,
+	slotData *types.SlotData,
+	parentBlockRoot common.Root,
+	buildErr error,
+) (*ctypes.SignedBeaconBlock, datypes.BlobSidecars, error) {
+	if s.cfg.FallbackProposalTimeout <= 0 || ctx.Err() != nil {
+		return nil, nil, buildErr
+	}
+	s.logger.Warn(
+		"Failed building block, building fallback block",
+		"slot", slotData.GetSlot().Base10(),
+		"deadline", s.cfg.FallbackProposalTimeout.String(),
+		"error", buildErr,
+	)
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.FallbackProposalTimeout)
+	defer cancel()
+
+	r, err := s.buildPayloadRequest(st, slotData, parentBlockRoot)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed building fallback block: %w, after: %w", err, buildErr)
+	}
+	envelope, err := s.localPayloadBuilder.FallbackPayload(ctx, r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed retrieving fallback payload: %w, after: %w", err, buildErr)
+	}
+	signedBlk, sidecars, err := s.buildBlock(ctx, st, slotData, parentBlockRoot, envelope)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed building fallback block: %w, after: %w", err, buildErr)
+	}
+	return signedBlk, sidecars, nil
+}
+
+// buildSignedBlock builds and signs the block and its sidecars, around the
+// relay payload if it pays more than the local one.
+func (s *Service) buildSignedBlock(
+	ctx context.Context,
+	st *statedb.StateDB,
+	slotData *types.SlotData,
+	parentBlockRoot common.Root,
+) (*ctypes.SignedBeaconBlock, datypes.BlobSidecars, error) {
+	// Request a bid from the external builders, if any, while the local
+	// payload is retrieved. The parent payload header is read here since the
+	// state must not be accessed concurrently. The bid and the reveal share a
+	// single deadline, so that the relay path never outlasts the local one.
+	relayCtx, cancel := context.WithDeadline(ctx, s.relayClient.PayloadDeadline(time.Now()))
+	defer cancel()
+	bidCh, err := s.requestBid(relayCtx, st, slotData)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// Get the payload for the block.
 	envelope, err := s.retrieveExecutionPayload(ctx, st, parentBlockRoot, slotData)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed retrieving execution payload: %w", err)
 	}
 
+	// Build the block on top of the relay payload if it pays more than the
+	// local one. Any failure along the way falls back to the local payload.
+	if bid := <-bidCh; bid != nil && s.preferBid(slotData.GetSlot(), bid, envelope) {
+		signedBlk, sidecars, bidErr := s.buildBlockFromBid(relayCtx, st.Protect(ctx), slotData, parentBlockRoot, envelope, bid)
+		if bidErr == nil {
+			return signedBlk, sidecars, nil
+		}
+		s.metrics.failedToBuildBlockFromBid(slotData.GetSlot(), bidErr)
+		s.logger.Warn(
+			"Failed building block from relay bid, falling back to local payload",
+			"slot", slotData.GetSlot().Base10(),
+			"error", bidErr,
+		)
+	}
+	return s.buildBlock(ctx, st, slotData, parentBlockRoot, envelope)
+}
+
+// buildBlock builds and signs the block and its sidecars around the given
+// local payload envelope.
+func (s *Service) buildBlock(
+	ctx context.Context,
+	st *statedb.StateDB,
+	slotData *types.SlotData,
+	parentBlockRoot common.Root,
+	envelope ctypes.BuiltExecutionPayloadEnv,
+) (*ctypes.SignedBeaconBlock, datypes.BlobSidecars, error) {
 	// We introduce hard forks with the expectation that the first block proposed after the
 	// hard fork timestamp is when new rules apply. When building blocks, we provide the Execution
 	// Layer client with a timestamp, and it will create its payload based on that timestamp. We
@@ -97,33 +223,20 @@ func (s *Service) BuildBlockAndSidecars(
 		return nil, nil, err
 	}
 
-	// Create a new empty block from the current state.
-	blk, err := s.getEmptyBeaconBlockForSlot(st, blkSlot, forkData.CurrentVersion, parentBlockRoot)
-	if err != nil {
-		return nil, nil, err
-	}
-
 	// Build the reveal for the current slot.
 	// TODO: We can optimize to pre-compute this in parallel?
-	reveal, err := s.buildRandaoReveal(forkData, blkSlot)
+	reveal, err := s.buildRandaoReveal(forkData, slotData.GetSlot())
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// We have to assemble the block body prior to producing the sidecars
-	// since we need to generate the inclusion proofs.
-	if err = s.buildBlockBody(ctx, st, blk, reveal, envelope); err != nil {
-		return nil, nil, fmt.Errorf("failed build block body: %w", err)
+	graffiti, err := s.graffiti.Graffiti(ctx, s.signer.PublicKey())
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// Compute the state root for the block.
-	if err = s.computeAndSetStateRoot(
-		ctx,
-		slotData.GetProposerAddress(),
-		slotData.GetConsensusTime(),
-		st,
-		blk,
-	); err != nil {
+	blk, err := s.assembleBlock(ctx, st, slotData, parentBlockRoot, forkData, reveal, graffiti, envelope)
+	if err != nil {
 		return nil, nil, err
 	}
 
@@ -138,48 +251,85 @@ func (s *Service) BuildBlockAndSidecars(
 	if err != nil {
 		return nil, nil, err
 	}
+	s.metrics.payloadSource(payloadSourceLocal)
+	return signedBlk, sidecars, nil
+}
 
-	s.logger.Info(
-		"Beacon block successfully built",
-		"slot", blkSlot.Base10(),
-		"state_root", blk.GetStateRoot(),
-		"duration", time.Since(startTime).String(),
-	)
-
-	signedBlkBytes, bbErr := signedBlk.MarshalSSZ()
-	if bbErr != nil {
-		return nil, nil, bbErr
+// assembleBlock assembles the unsigned block around the given payload
+// envelope, and sets its state root.
+func (s *Service) assembleBlock(
+	ctx context.Context,
+	st *statedb.StateDB,
+	slotData *types.SlotData,
+	parentBlockRoot common.Root,
+	forkData *ctypes.ForkData,
+	reveal crypto.BLSSignature,
+	graffiti common.Bytes32,
+	envelope ctypes.BuiltExecutionPayloadEnv,
+) (*ctypes.BeaconBlock, error) {
+	// Create a new empty block from the current state.
+	blk, err := s.getEmptyBeaconBlockForSlot(st, slotData, forkData.CurrentVersion, parentBlockRoot)
+	if err != nil {
+		return nil, err
 	}
-	sidecarsBytes, scErr := sidecars.MarshalSSZ()
-	if scErr != nil {
-		return nil, nil, scErr
+
+	// We have to assemble the block body prior to producing the sidecars
+	// since we need to generate the inclusion proofs.
+	if err = s.buildBlockBody(ctx, st, blk, reveal, graffiti, envelope); err != nil {
+		return nil, fmt.Errorf("failed build block body: %w", err)
 	}
 
-	return signedBlkBytes, sidecarsBytes, nil
+	// Compute the state root for the block.
+	if err = s.computeAndSetStateRoot(
+		ctx,
+		slotData.GetProposerAddress(),
+		slotData.GetConsensusTime(),
+		st,
+		blk,
+	); err != nil {
+		return nil, err
+	}
+	return blk, nil
 }
 
 // getEmptyBeaconBlockForSlot creates a new empty block.
 func (s *Service) getEmptyBeaconBlockForSlot(
-	st *statedb.StateDB, requestedSlot math.Slot,
+	st *statedb.StateDB, slotData *types.SlotData,
 	forkVersion common.Version, parentBlockRoot common.Root,
 ) (*ctypes.BeaconBlock, error) {
 	// Get the proposer index for the slot.
-	proposerIndex, err := st.ValidatorIndexByPubkey(
-		s.signer.PublicKey(),
-	)
+	proposerIndex, _, err := s.slotProposer(st, slotData)
 	if err != nil {
 		return nil, err
 	}
 
 	// Create a new block.
 	return ctypes.NewBeaconBlockWithVersion(
-		requestedSlot,
+		slotData.GetSlot(),
 		proposerIndex,
 		parentBlockRoot,
 		forkVersion,
 	)
 }
 
+// slotProposer returns the index and public key of the validator consensus
+// picked as proposer of the slot. Proposers are not scheduled by the beacon
+// state, hence they are resolved from their CometBFT address.
+func (s *Service) slotProposer(
+	st *statedb.StateDB,
+	slotData *types.SlotData,
+) (math.ValidatorIndex, crypto.BLSPubkey, error) {
+	proposerIndex, err := st.ValidatorIndexByCometBFTAddress(slotData.GetProposerAddress())
+	if err != nil {
+		return 0, crypto.BLSPubkey{}, fmt.Errorf("failed retrieving slot proposer: %w", err)
+	}
+	proposer, err := st.ValidatorByIndex(proposerIndex)
+	if err != nil {
+		return 0, crypto.BLSPubkey{}, err
+	}
+	return proposerIndex, proposer.GetPubkey(), nil
+}
+
 func (s *Service) buildForkData(st *statedb.StateDB, timestamp math.U64) (*ctypes.ForkData, error) {
 	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
 	if err != nil {
@@ -214,8 +364,6 @@ func (s *Service) retrieveExecutionPayload(
 	parentBlockRoot common.Root,
 	slotData *types.SlotData,
 ) (ctypes.BuiltExecutionPayloadEnv, error) {
-	// TODO: Add external block builders to this flow.
-	//
 	// Get the payload for the block. Pass the expected fork given the current
 	// CometBFT timestamp to try and build coherent blocks (i.e. blocks whose fork
 	// version is the same for payload and the rest of CometBFT block). This coherence
@@ -223,8 +371,14 @@ func (s *Service) retrieveExecutionPayload(
 	// timestamp provided here will be the one used in the block (Comet takes into account
 	// the time it takes to build the block, which should be very small normally).
 	slot := slotData.GetSlot()
+	_, proposerPubkey, err := s.slotProposer(st, slotData)
+	if err != nil {
+		return nil, err
+	}
 	expectedPayloadFork := s.chainSpec.ActiveForkVersionForTimestamp(slotData.GetConsensusTime())
-	envelope, err := s.localPayloadBuilder.RetrievePayload(ctx, slot, parentBlockRoot, expectedPayloadFork)
+	envelope, err := s.localPayloadBuilder.RetrievePayload(
+		ctx, slot, parentBlockRoot, expectedPayloadFork, proposerPubkey,
+	)
 	if err == nil {
 		return envelope, nil
 	}
@@ -239,6 +393,20 @@ func (s *Service) retrieveExecutionPayload(
 	// this less confusing.
 	s.metrics.failedToRetrievePayload(slot, err)
 
+	r, err := s.buildPayloadRequest(st, slotData, parentBlockRoot)
+	if err != nil {
+		return nil, err
+	}
+	return s.localPayloadBuilder.RequestPayloadSync(ctx, r)
+}
+
+// buildPayloadRequest prepares the state for the fork of the block being
+// built and returns the request for its payload.
+func (s *Service) buildPayloadRequest(
+	st *statedb.StateDB,
+	slotData *types.SlotData,
+	parentBlockRoot common.Root,
+) (*builder.RequestPayloadData, error) {
 	// The latest execution payload header will be from the previous block
 	// during the block building phase.
 	lph, err := st.GetLatestExecutionPayloadHeader()
@@ -271,6 +439,7 @@ func (s *Service) retrieveExecutionPayload(
 		return nil, err
 	}
 	// Get the previous randao mix.
+	slot := slotData.GetSlot()
 	epoch := s.chainSpec.SlotToEpoch(slot)
 	prevRandao, err := st.GetRandaoMixAtIndex(
 		epoch.Unwrap() % s.chainSpec.EpochsPerHistoricalVector(),
@@ -279,12 +448,16 @@ func (s *Service) retrieveExecutionPayload(
 		return nil, err
 	}
 
+	_, proposerPubkey, err := s.slotProposer(st, slotData)
+	if err != nil {
+		return nil, err
+	}
 	parentProposerPubkey, err := st.ParentProposerPubkey(nextPayloadTimestamp)
 	if err != nil {
 		return nil, fmt.Errorf("failed retrieving previous proposer public key: %w", err)
 	}
 
-	r := &builder.RequestPayloadData{
+	return &builder.RequestPayloadData{
 		Slot:               slot,
 		Timestamp:          nextPayloadTimestamp,
 		PayloadWithdrawals: payloadWithdrawals,
@@ -295,9 +468,9 @@ func (s *Service) retrieveExecutionPayload(
 			SafeBlockHash:      lph.GetParentHash(),
 			FinalizedBlockHash: lph.GetParentHash(),
 		},
+		ProposerPubkey:       proposerPubkey,
 		ParentProposerPubkey: parentProposerPubkey,
-	}
-	return s.localPayloadBuilder.RequestPayloadSync(ctx, r)
+	}, nil
 }
 
 // BuildBlockBody assembles the block body with necessary components.
@@ -306,6 +479,7 @@ func (s *Service) buildBlockBody(
 	st *statedb.StateDB,
 	blk *ctypes.BeaconBlock,
 	reveal crypto.BLSSignature,
+	graffiti common.Bytes32,
 	envelope ctypes.BuiltExecutionPayloadEnv,
 ) error {
 	// Assemble a new block with the payload.
@@ -341,11 +515,6 @@ func (s *Service) buildBlockBody(
 	}
 
 	// Set the graffiti on the block body.
-	sizedGraffiti := bytes.ExtendToSize([]byte(s.cfg.Graffiti), bytes.B32Size)
-	graffiti, err := bytes.ToBytes32(sizedGraffiti)
-	if err != nil {
-		return fmt.Errorf("failed processing graffiti: %w", err)
-	}
 	body.SetGraffiti(graffiti)
 
 	// Fill in unused field with non-nil value
@@ -361,8 +530,8 @@ func (s *Service) buildBlockBody(
 			result[i] = req // conversion from ExecutionRequest to []byte
 		}
 
-		var requests *ctypes.ExecutionRequests
-		if requests, err = ctypes.DecodeExecutionRequests(result); err != nil {
+		requests, err := ctypes.DecodeExecutionRequests(result)
+		if err != nil {
 			return err
 		}
 		if err = body.SetExecutionRequests(requests); err != nil {
@@ -412,9 +581,22 @@ func (s *Service) computeStateRoot(
 	startTime := time.Now()
 	defer s.metrics.measureStateRootComputationTime(startTime)
 
-	// TODO: Think about how this would affect the proposer when
-	// the payload in their block has come from a remote builder.
-	txCtx := transition.NewTransitionCtx(
+	txCtx := newProposalTransitionCtx(ctx, proposerAddress, consensusTime)
+	if _, err := s.stateProcessor.Transition(txCtx, st, blk); err != nil {
+		return common.Root{}, err
+	}
+
+	return st.HashTreeRoot(), nil
+}
+
+// newProposalTransitionCtx returns the context used to transition the state
+// with a block being built, skipping verifications of data produced locally.
+func newProposalTransitionCtx(
+	ctx context.Context,
+	proposerAddress []byte,
+	consensusTime math.U64,
+) *transition.Context {
+	return transition.NewTransitionCtx(
 		ctx,
 		consensusTime,
 		proposerAddress,
@@ -423,10 +605,4 @@ func (s *Service) computeStateRoot(
 		WithVerifyRandao(false).
 		WithVerifyResult(false).
 		WithMeterGas(false)
-
-	if _, err := s.stateProcessor.Transition(txCtx, st, blk); err != nil {
-		return common.Root{}, err
-	}
-
-	return st.HashTreeRoot(), nil
 }
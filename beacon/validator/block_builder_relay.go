@@ -0,0 +1,303 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/consensus/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/payload/relay"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+const (
+	// payloadSourceLocal labels blocks built around the local payload.
+	payloadSourceLocal = "local"
+	// payloadSourceRelay labels blocks built around a relay payload.
+	payloadSourceRelay = "relay"
+)
+
+// requestBid asynchronously requests a bid for the block being built from the
+// external builders. The returned channel yields nil if the relay client is
+// disabled or if no valid bid was received before the context deadline.
+func (s *Service) requestBid(
+	ctx context.Context,
+	st *statedb.StateDB,
+	slotData *types.SlotData,
+) (<-chan *relay.SignedBuilderBid, error) {
+	bidCh := make(chan *relay.SignedBuilderBid, 1)
+	if !s.relayClient.Enabled() {
+		bidCh <- nil
+		return bidCh, nil
+	}
+
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		slot        = slotData.GetSlot()
+		parentHash  = lph.GetBlockHash()
+		forkVersion = s.chainSpec.ActiveForkVersionForTimestamp(slotData.GetConsensusTime())
+	)
+	go func() {
+		bid, getErr := s.relayClient.GetHeader(ctx, slot, parentHash, forkVersion)
+		if getErr != nil {
+			s.logger.Info("No relay bid for slot, using local payload", "slot", slot.Base10(), "reason", getErr)
+		}
+		bidCh <- bid
+	}()
+	return bidCh, nil
+}
+
+// preferBid returns true if the relay bid should be used in place of the
+// local payload.
+func (s *Service) preferBid(
+	slot math.Slot,
+	bid *relay.SignedBuilderBid,
+	envelope ctypes.BuiltExecutionPayloadEnv,
+) bool {
+	localValue := envelope.GetBlockValue()
+	if envelope.ShouldOverrideBuilder() {
+		s.logger.Info("Execution client requested to override relay bid", "slot", slot.Base10())
+		return false
+	}
+	if !s.relayClient.IsBetterThanLocal(bid.Message, localValue) {
+		s.logger.Info(
+			"Local payload preferred over relay bid",
+			"slot", slot.Base10(),
+			"bid_value", bid.Message.Value,
+			"local_value", localValue,
+		)
+		return false
+	}
+	return true
+}
+
+// buildBlockFromBid builds the block around the payload of the relay bid.
+//
+// The block is first built blinded: its state root is computed with a payload
+// made of the bid header fields and the expected withdrawals, stripped of its
+// transactions, and the state is then patched with the bid header. Once the
+// blinded block is signed, the relay reveals the full payload, which must match
+// the bid header so that the signature covers the unblinded block.
+//
+// NOTE: the given state must be disposable, as the caller falls back to the
+// local payload on error. Signing a second block for the same slot is safe
+// since proposer slashings are unused.
+func (s *Service) buildBlockFromBid(
+	ctx context.Context,
+	st *statedb.StateDB,
+	slotData *types.SlotData,
+	parentBlockRoot common.Root,
+	localEnvelope ctypes.BuiltExecutionPayloadEnv,
+	bid *relay.SignedBuilderBid,
+) (*ctypes.SignedBeaconBlock, datypes.BlobSidecars, error) {
+	header := bid.Message.Header
+	withdrawals, err := s.verifyBid(st, slotData, localEnvelope, bid.Message)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	forkData, err := s.buildForkData(st, header.GetTimestamp())
+	if err != nil {
+		return nil, nil, err
+	}
+	blk, err := s.getEmptyBeaconBlockForSlot(st, slotData.GetSlot(), forkData.CurrentVersion, parentBlockRoot)
+	if err != nil {
+		return nil, nil, err
+	}
+	reveal, err := s.buildRandaoReveal(forkData, slotData.GetSlot())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var encodedRequests []ctypes.EncodedExecutionRequest
+	if version.EqualsOrIsAfter(forkData.CurrentVersion, version.Electra()) {
+		if encodedRequests, err = ctypes.GetExecutionRequestsList(bid.Message.ExecutionRequests); err != nil {
+			return nil, nil, err
+		}
+	}
+	envelope := ctypes.NewExecutionPayloadEnvelope(
+		blindedPayload(header, withdrawals, forkData.CurrentVersion),
+		&engineprimitives.BlobsBundleV1{Commitments: bid.Message.BlobKzgCommitments},
+		encodedRequests,
+	)
+	graffiti, err := s.graffiti.Graffiti(ctx, s.signer.PublicKey())
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = s.buildBlockBody(ctx, st, blk, reveal, graffiti, envelope); err != nil {
+		return nil, nil, fmt.Errorf("failed build block body: %w", err)
+	}
+
+	// Compute the state root and patch the fields derived from the payload.
+	txCtx := newProposalTransitionCtx(ctx, slotData.GetProposerAddress(), slotData.GetConsensusTime())
+	if _, err = s.stateProcessor.Transition(txCtx, st, blk); err != nil {
+		return nil, nil, err
+	}
+	blinded, err := relay.NewBlindedBeaconBlock(blk, header)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = st.SetLatestExecutionPayloadHeader(header); err != nil {
+		return nil, nil, err
+	}
+	lbh, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return nil, nil, err
+	}
+	lbh.SetBodyRoot(blinded.Body.HashTreeRoot())
+	if err = st.SetLatestBlockHeader(lbh); err != nil {
+		return nil, nil, err
+	}
+	blk.SetStateRoot(st.HashTreeRoot())
+	blinded.StateRoot = blk.GetStateRoot()
+
+	// Sign the blinded block, whose root is the one of the unblinded block.
+	signingRoot := ctypes.ComputeSigningRoot(blinded, forkData.ComputeDomain(s.chainSpec.DomainTypeProposer()))
+	signature, err := s.signer.Sign(signingRoot[:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	revealed, err := s.relayClient.SubmitBlindedBlock(
+		ctx, &relay.SignedBlindedBeaconBlock{Message: blinded, Signature: signature},
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed submitting blinded block: %w", err)
+	}
+	if !slices.Equal(revealed.BlobsBundle.GetCommitments(), bid.Message.BlobKzgCommitments) {
+		return nil, nil, ErrBidCommitmentsMismatch
+	}
+	blk.GetBody().SetExecutionPayload(revealed.ExecutionPayload)
+	if blk.HashTreeRoot() != blinded.HashTreeRoot() {
+		return nil, nil, relay.ErrPayloadMismatch
+	}
+
+	signedBlk := &ctypes.SignedBeaconBlock{BeaconBlock: blk, Signature: signature}
+	sidecars, err := s.blobFactory.BuildSidecars(signedBlk, revealed.BlobsBundle)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.metrics.payloadSource(payloadSourceRelay)
+	s.logger.Info(
+		"Built block around relay payload",
+		"slot", slotData.GetSlot().Base10(),
+		"bid_value", bid.Message.Value,
+		"block_hash", header.GetBlockHash(),
+	)
+	return signedBlk, sidecars, nil
+}
+
+// verifyBid checks the bid header against the state, the same way the payload
+// is verified when processing the proposal, and returns the withdrawals the
+// payload includes.
+func (s *Service) verifyBid(
+	st *statedb.StateDB,
+	slotData *types.SlotData,
+	localEnvelope ctypes.BuiltExecutionPayloadEnv,
+	bid *relay.BuilderBid,
+) (engineprimitives.Withdrawals, error) {
+	header := bid.Header
+	localTimestamp := localEnvelope.GetExecutionPayload().GetTimestamp()
+	if !version.Equals(
+		s.chainSpec.ActiveForkVersionForTimestamp(header.GetTimestamp()),
+		s.chainSpec.ActiveForkVersionForTimestamp(localTimestamp),
+	) {
+		return nil, ErrBidForkVersionMismatch
+	}
+
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+	if header.GetParentHash() != lph.GetBlockHash() {
+		return nil, errors.Wrapf(
+			ErrBidParentHashMismatch, "expected %s, got %s", lph.GetBlockHash(), header.GetParentHash(),
+		)
+	}
+	if err = payloadtime.Verify(slotData.GetConsensusTime(), lph.GetTimestamp(), header.GetTimestamp()); err != nil {
+		return nil, err
+	}
+
+	epoch := s.chainSpec.SlotToEpoch(slotData.GetSlot())
+	prevRandao, err := st.GetRandaoMixAtIndex(epoch.Unwrap() % s.chainSpec.EpochsPerHistoricalVector())
+	if err != nil {
+		return nil, err
+	}
+	if header.GetPrevRandao() != prevRandao {
+		return nil, ErrBidPrevRandaoMismatch
+	}
+
+	withdrawals, _, err := st.ExpectedWithdrawals(header.GetTimestamp())
+	if err != nil {
+		return nil, err
+	}
+	if header.GetWithdrawalsRoot() != withdrawals.HashTreeRoot() {
+		return nil, ErrBidWithdrawalsMismatch
+	}
+
+	if uint64(len(bid.BlobKzgCommitments)) > s.chainSpec.MaxBlobsPerBlock() {
+		return nil, errors.Wrapf(
+			ErrBidTooManyBlobs, "max %d, got %d", s.chainSpec.MaxBlobsPerBlock(), len(bid.BlobKzgCommitments),
+		)
+	}
+	return withdrawals, nil
+}
+
+// blindedPayload returns a payload matching the given header, except for its
+// transactions which are left empty.
+func blindedPayload(
+	header *ctypes.ExecutionPayloadHeader,
+	withdrawals engineprimitives.Withdrawals,
+	forkVersion common.Version,
+) *ctypes.ExecutionPayload {
+	payload := ctypes.NewEmptyExecutionPayloadWithVersion(forkVersion)
+	payload.ParentHash = header.GetParentHash()
+	payload.FeeRecipient = header.GetFeeRecipient()
+	payload.StateRoot = header.GetStateRoot()
+	payload.ReceiptsRoot = header.GetReceiptsRoot()
+	payload.LogsBloom = header.GetLogsBloom()
+	payload.Random = header.GetPrevRandao()
+	payload.Number = header.GetNumber()
+	payload.GasLimit = header.GetGasLimit()
+	payload.GasUsed = header.GetGasUsed()
+	payload.Timestamp = header.GetTimestamp()
+	payload.ExtraData = header.GetExtraData()
+	payload.BaseFeePerGas = header.GetBaseFeePerGas()
+	payload.BlockHash = header.GetBlockHash()
+	payload.Transactions = engineprimitives.Transactions{}
+	payload.Withdrawals = withdrawals
+	payload.BlobGasUsed = header.GetBlobGasUsed()
+	payload.ExcessBlobGas = header.GetExcessBlobGas()
+	return payload
+}
@@ -0,0 +1,282 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/consensus/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/payload/builder"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// submittedBlock is a block signed by an external validator client, waiting
+// for consensus to request a proposal for its slot.
+type submittedBlock struct {
+	signedBlk *ctypes.SignedBeaconBlock
+	sidecars  datypes.BlobSidecars
+}
+
+// ProduceBlock builds the unsigned block for the given slot, along with the
+// blobs it commits to, so that it can be signed by an external validator
+// client. The block is built by the same code path used for proposals, on top
+// of the state carried by the given context, for the given proposer and with
+// the randao reveal provided by the validator client. A nil graffiti defaults
+// to the configured one.
+//
+// Proposers are picked by consensus rather than scheduled by the beacon state,
+// hence a nil proposer defaults to the node's own key, which the validator
+// client may override by naming the proposer it produces the block for.
+//
+// The state carried by the context is modified, hence it must be disposable.
+// The returned value is the value of the execution payload.
+func (s *Service) ProduceBlock(
+	ctx context.Context,
+	slot math.Slot,
+	proposer *crypto.BLSPubkey,
+	randaoReveal crypto.BLSSignature,
+	graffiti *common.Bytes32,
+	skipRandaoVerification bool,
+) (*ctypes.BlockContents, *math.U256, error) {
+	if !s.localPayloadBuilder.Enabled() {
+		// node is not supposed to build blocks
+		return nil, nil, builder.ErrPayloadBuilderDisabled
+	}
+
+	if proposer == nil {
+		pubkey := s.signer.PublicKey()
+		proposer = &pubkey
+	}
+
+	st := s.sb.StateFromContext(ctx)
+	parentBlockRoot, err := s.prepareStateForSlot(st, slot)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err = st.ValidatorIndexByPubkey(*proposer); err != nil {
+		return nil, nil, fmt.Errorf("unknown proposer %s: %w", *proposer, err)
+	}
+	proposerAddress, err := crypto.GetAddressFromPubKey(*proposer)
+	if err != nil {
+		return nil, nil, err
+	}
+	slotData := types.NewSlotData(slot, nil, nil, proposerAddress, time.Now())
+
+	envelope, err := s.retrieveExecutionPayload(ctx, st, parentBlockRoot, slotData)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed retrieving execution payload: %w", err)
+	}
+	forkData, err := s.buildForkData(st, envelope.GetExecutionPayload().GetTimestamp())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !skipRandaoVerification {
+		signingRoot := forkData.ComputeRandaoSigningRoot(
+			s.chainSpec.DomainTypeRandao(),
+			s.chainSpec.SlotToEpoch(slot),
+		)
+		if err = s.signer.VerifySignature(*proposer, signingRoot[:], randaoReveal); err != nil {
+			return nil, nil, errors.Wrap(ErrInvalidRandaoReveal, err.Error())
+		}
+	}
+
+	if graffiti == nil {
+		var configured common.Bytes32
+		if configured, err = s.graffiti.Graffiti(ctx, *proposer); err != nil {
+			return nil, nil, err
+		}
+		graffiti = &configured
+	}
+
+	blk, err := s.assembleBlock(ctx, st, slotData, parentBlockRoot, forkData, randaoReveal, *graffiti, envelope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bundle := envelope.GetBlobsBundle()
+	blobs := make([]eip4844.Blob, len(bundle.GetBlobs()))
+	for i, blob := range bundle.GetBlobs() {
+		blobs[i] = *blob
+	}
+	contents := &ctypes.BlockContents{
+		Block:     blk,
+		KZGProofs: bundle.GetProofs(),
+		Blobs:     blobs,
+	}
+
+	s.logger.Info(
+		"Beacon block produced for external signing",
+		"slot", slot.Base10(),
+		"state_root", blk.GetStateRoot(),
+	)
+	return contents, envelope.GetBlockValue(), nil
+}
+
+// SubmitSignedBlock verifies the block signed by an external validator
+// client, on top of the state carried by the given context, and keeps it to be
+// proposed once consensus requests a proposal for its slot. The signature is
+// verified against the proposer named by the block, which must be the one
+// consensus picks for the block to be proposed.
+//
+// The state carried by the context is modified, hence it must be disposable.
+func (s *Service) SubmitSignedBlock(
+	ctx context.Context,
+	contents *ctypes.SignedBlockContents,
+) error {
+	var (
+		st        = s.sb.StateFromContext(ctx)
+		signedBlk = contents.SignedBlock
+		blk       = signedBlk.GetBeaconBlock()
+		slot      = blk.GetSlot()
+	)
+	parentBlockRoot, err := s.prepareStateForSlot(st, slot)
+	if err != nil {
+		return err
+	}
+	if blk.GetParentBlockRoot() != parentBlockRoot {
+		return errors.Wrapf(
+			ErrSubmittedBlockParentMismatch, "expected %s, got %s", parentBlockRoot, blk.GetParentBlockRoot(),
+		)
+	}
+
+	proposer, err := st.ValidatorByIndex(blk.GetProposerIndex())
+	if err != nil {
+		return fmt.Errorf("unknown proposer %d: %w", blk.GetProposerIndex(), err)
+	}
+	proposerPubkey := proposer.GetPubkey()
+
+	// Verify the signature before the state transition, which is costly.
+	forkData, err := s.buildForkData(st, blk.GetTimestamp())
+	if err != nil {
+		return err
+	}
+	if forkData.CurrentVersion != blk.GetForkVersion() {
+		return errors.Wrapf(
+			ErrSubmittedBlockForkMismatch, "expected %s, got %s", forkData.CurrentVersion, blk.GetForkVersion(),
+		)
+	}
+	signingRoot := ctypes.ComputeSigningRoot(blk, forkData.ComputeDomain(s.chainSpec.DomainTypeProposer()))
+	if err = s.signer.VerifySignature(proposerPubkey, signingRoot[:], signedBlk.GetSignature()); err != nil {
+		return errors.Wrap(ErrInvalidBlockSignature, err.Error())
+	}
+
+	// Run the state transition so that an invalid block is rejected here
+	// rather than proposed, which would cost the slot.
+	proposerAddress, err := crypto.GetAddressFromPubKey(proposerPubkey)
+	if err != nil {
+		return err
+	}
+	consensusTime := math.U64(time.Now().Unix()) //#nosec:G115 // unix time is positive
+	txCtx := transition.NewTransitionCtx(ctx, consensusTime, proposerAddress).
+		WithVerifyPayload(false).
+		WithVerifyRandao(true).
+		WithVerifyResult(true).
+		WithMeterGas(false)
+	if _, err = s.stateProcessor.Transition(txCtx, st, blk); err != nil {
+		return fmt.Errorf("invalid submitted block: %w", err)
+	}
+
+	sidecars, err := s.blobFactory.BuildSidecars(signedBlk, contents)
+	if err != nil {
+		return err
+	}
+
+	s.muSubmitted.Lock()
+	defer s.muSubmitted.Unlock()
+	for submittedSlot := range s.submitted {
+		if submittedSlot < slot {
+			delete(s.submitted, submittedSlot)
+		}
+	}
+	s.submitted[slot] = &submittedBlock{signedBlk: signedBlk, sidecars: sidecars}
+
+	s.logger.Info(
+		"Externally signed beacon block submitted for proposal",
+		"slot", slot.Base10(),
+		"block_root", blk.HashTreeRoot(),
+	)
+	return nil
+}
+
+// takeSubmittedBlock returns the externally signed block for the slot, if it
+// was submitted, builds on top of the given parent block root and is proposed
+// by the validator consensus picked.
+func (s *Service) takeSubmittedBlock(
+	st *statedb.StateDB,
+	slotData *types.SlotData,
+	parentBlockRoot common.Root,
+) (*ctypes.SignedBeaconBlock, datypes.BlobSidecars, bool) {
+	slot := slotData.GetSlot()
+	s.muSubmitted.Lock()
+	defer s.muSubmitted.Unlock()
+	submitted, ok := s.submitted[slot]
+	if !ok {
+		return nil, nil, false
+	}
+	delete(s.submitted, slot)
+	if submitted.signedBlk.GetParentBlockRoot() != parentBlockRoot {
+		s.logger.Warn(
+			"Discarding externally signed block built on a stale parent",
+			"slot", slot.Base10(),
+			"parent_root", submitted.signedBlk.GetParentBlockRoot(),
+		)
+		return nil, nil, false
+	}
+	proposerIndex, _, err := s.slotProposer(st, slotData)
+	if err != nil || submitted.signedBlk.GetProposerIndex() != proposerIndex {
+		s.logger.Warn(
+			"Discarding externally signed block of another proposer",
+			"slot", slot.Base10(),
+			"proposer_index", submitted.signedBlk.GetProposerIndex(),
+			"error", errors.Join(err, ErrSubmittedBlockProposerMismatch),
+		)
+		return nil, nil, false
+	}
+	return submitted.signedBlk, submitted.sidecars, true
+}
+
+// prepareStateForSlot processes the state up to the given slot, which must be
+// the one following the state slot, and returns the parent block root.
+func (s *Service) prepareStateForSlot(st *statedb.StateDB, slot math.Slot) (common.Root, error) {
+	stateSlot, err := st.GetSlot()
+	if err != nil {
+		return common.Root{}, err
+	}
+	if slot != stateSlot+1 {
+		return common.Root{}, errors.Wrapf(ErrUnexpectedSlot, "expected %d, got %d", stateSlot+1, slot)
+	}
+	if _, err = s.stateProcessor.ProcessSlots(st, slot); err != nil {
+		return common.Root{}, err
+	}
+	return st.GetBlockRootAtIndex((slot.Unwrap() - 1) % s.chainSpec.SlotsPerHistoricalRoot())
+}
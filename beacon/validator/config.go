@@ -20,19 +20,49 @@
 
 package validator
 
-// defaultGraffiti is the default graffiti string.
-const defaultGraffiti = ""
+import "time"
+
+const (
+	// defaultGraffiti is the default graffiti string.
+	defaultGraffiti = ""
+	// defaultGraffitiMode is the default graffiti mode.
+	defaultGraffitiMode = GraffitiModeStatic
+	// defaultFallbackProposalTimeout is the default deadline of fallback
+	// proposals.
+	defaultFallbackProposalTimeout = 500 * time.Millisecond
+)
 
 // Config is the validator configuration.
 type Config struct {
 	// Graffiti is the string that will be included in the
 	// graffiti field of the beacon block.
 	Graffiti string `mapstructure:"graffiti"`
+	// GraffitiMode selects how the graffiti is computed, one of "static",
+	// "file" or "auto".
+	GraffitiMode string `mapstructure:"graffiti-mode"`
+	// GraffitiFile is the path to the graffiti file read in "file" mode.
+	GraffitiFile string `mapstructure:"graffiti-file"`
+	// KeystorePath is the path to the EIP-2335 keystore holding the validator
+	// key. When set, it is used in place of the CometBFT privval key file.
+	KeystorePath string `mapstructure:"keystore-path"`
+	// KeystorePasswordFile is the path to the file holding the keystore
+	// password. If empty, the password is read from the environment.
+	KeystorePasswordFile string `mapstructure:"keystore-password-file"`
+	// FallbackProposalTimeout is the deadline for building a fallback
+	// proposal when building the block the regular way fails. It bounds the
+	// calls to the execution client the fallback may still need. Zero
+	// disables fallback proposals.
+	FallbackProposalTimeout time.Duration `mapstructure:"fallback-proposal-timeout"`
 }
 
 // DefaultConfig returns the default fork configuration.
 func DefaultConfig() Config {
 	return Config{
-		Graffiti: defaultGraffiti,
+		Graffiti:                defaultGraffiti,
+		GraffitiMode:            defaultGraffitiMode,
+		GraffitiFile:            "",
+		KeystorePath:            "",
+		KeystorePasswordFile:    "",
+		FallbackProposalTimeout: defaultFallbackProposalTimeout,
 	}
 }
@@ -28,4 +28,63 @@ var (
 
 	// ErrNilBlobsBundle is an error for when the blobs bundle is nil.
 	ErrNilBlobsBundle = errors.New("nil blobs bundle")
+
+	// ErrBidForkVersionMismatch is returned when a relay bid is not for the
+	// fork version of the local payload.
+	ErrBidForkVersionMismatch = errors.New("bid fork version mismatch")
+
+	// ErrBidParentHashMismatch is returned when a relay bid does not build on
+	// top of the latest execution payload.
+	ErrBidParentHashMismatch = errors.New("bid parent hash mismatch")
+
+	// ErrBidPrevRandaoMismatch is returned when a relay bid does not use the
+	// expected randao mix.
+	ErrBidPrevRandaoMismatch = errors.New("bid prev randao mismatch")
+
+	// ErrBidWithdrawalsMismatch is returned when a relay bid does not include
+	// the expected withdrawals.
+	ErrBidWithdrawalsMismatch = errors.New("bid withdrawals mismatch")
+
+	// ErrBidTooManyBlobs is returned when a relay bid has more blobs than
+	// allowed in a block.
+	ErrBidTooManyBlobs = errors.New("bid has too many blobs")
+
+	// ErrBidCommitmentsMismatch is returned when the blobs revealed by a relay
+	// do not match the commitments of its bid.
+	ErrBidCommitmentsMismatch = errors.New("revealed blobs do not match bid commitments")
+
+	// ErrUnexpectedSlot is returned when a block is requested or submitted for
+	// a slot other than the one following the head.
+	ErrUnexpectedSlot = errors.New("slot does not follow the head")
+
+	// ErrInvalidRandaoReveal is returned when the randao reveal provided by a
+	// validator client is not signed by the validator of this node.
+	ErrInvalidRandaoReveal = errors.New("invalid randao reveal")
+
+	// ErrInvalidBlockSignature is returned when a submitted block is not
+	// signed by the validator of this node.
+	ErrInvalidBlockSignature = errors.New("invalid block signature")
+
+	// ErrSubmittedBlockParentMismatch is returned when a submitted block does
+	// not build on top of the head.
+	ErrSubmittedBlockParentMismatch = errors.New("submitted block parent root mismatch")
+
+	// ErrSubmittedBlockProposerMismatch is returned when a submitted block is
+	// not proposed by the validator consensus picked for its slot.
+	ErrSubmittedBlockProposerMismatch = errors.New("submitted block proposer mismatch")
+
+	// ErrSubmittedBlockForkMismatch is returned when a submitted block fork
+	// version does not match the one of its payload timestamp.
+	ErrSubmittedBlockForkMismatch = errors.New("submitted block fork version mismatch")
+
+	// ErrUnknownGraffitiMode is returned when the configured graffiti mode is
+	// not supported.
+	ErrUnknownGraffitiMode = errors.New("unknown graffiti mode")
+
+	// ErrGraffitiFileRequired is returned when the "file" graffiti mode is
+	// configured without a graffiti file.
+	ErrGraffitiFileRequired = errors.New("graffiti file is required in file graffiti mode")
+
+	// ErrInvalidGraffitiFile is returned when the graffiti file is malformed.
+	ErrInvalidGraffitiFile = errors.New("invalid graffiti file")
 )
@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/execution/client/ethclient"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+const (
+	// GraffitiModeStatic uses the configured graffiti as is.
+	GraffitiModeStatic = "static"
+	// GraffitiModeFile re-reads the graffiti file for every proposal.
+	GraffitiModeFile = "file"
+	// GraffitiModeAuto appends the client identifiers to the configured
+	// graffiti.
+	GraffitiModeAuto = "auto"
+
+	// clientCode is the two letters code identifying beacon-kit in the
+	// client version graffiti.
+	clientCode = "BK"
+	// graffitiFileDefaultKey is the graffiti file key of the entry used when
+	// no entry matches the proposer pubkey.
+	graffitiFileDefaultKey = "default"
+	// elVersionRefreshInterval is the interval after which the execution
+	// client version is queried again, since the execution client can be
+	// upgraded separately.
+	elVersionRefreshInterval = 5 * time.Minute
+	// elVersionTimeout bounds the execution client version query, so that
+	// it never delays block building noticeably.
+	elVersionTimeout = 500 * time.Millisecond
+)
+
+// clientCommitLengths are the lengths of the commit prefixes tried, from
+// the longest, when encoding the client identifiers in the graffiti.
+//
+//nolint:gochecknoglobals // constant slice.
+var clientCommitLengths = []int{4, 2, 0}
+
+// GraffitiCalculator computes the graffiti of the blocks proposed by this
+// node according to the configured graffiti mode.
+type GraffitiCalculator struct {
+	// cfg is the validator config.
+	cfg *Config
+	// logger is a logger.
+	logger log.Logger
+	// elClient is used to query the execution client version.
+	elClient ExecutionClient
+	// commit is the commit beacond was built from.
+	commit string
+
+	// mu protects elVersion and elVersionFetchedAt.
+	mu sync.Mutex
+	// elVersion is the last execution client version fetched, nil if
+	// unknown.
+	elVersion *engineprimitives.ClientVersionV1
+	// elVersionFetchedAt is the time elVersion was last queried.
+	elVersionFetchedAt time.Time
+}
+
+// NewGraffitiCalculator creates a new graffiti calculator. The commit is the
+// commit beacond was built from, encoded in the graffiti in "auto" mode.
+func NewGraffitiCalculator(
+	cfg *Config,
+	logger log.Logger,
+	elClient ExecutionClient,
+	commit string,
+) (*GraffitiCalculator, error) {
+	switch cfg.GraffitiMode {
+	case GraffitiModeStatic, GraffitiModeAuto:
+	case GraffitiModeFile:
+		if cfg.GraffitiFile == "" {
+			return nil, ErrGraffitiFileRequired
+		}
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownGraffitiMode, cfg.GraffitiMode)
+	}
+	if _, err := toGraffiti(cfg.Graffiti); err != nil {
+		return nil, err
+	}
+	return &GraffitiCalculator{
+		cfg:      cfg,
+		logger:   logger,
+		elClient: elClient,
+		commit:   normalizeCommit(commit),
+	}, nil
+}
+
+// Graffiti returns the graffiti of a block proposed by the given validator.
+func (g *GraffitiCalculator) Graffiti(
+	ctx context.Context,
+	pubkey crypto.BLSPubkey,
+) (common.Bytes32, error) {
+	switch g.cfg.GraffitiMode {
+	case GraffitiModeFile:
+		graffiti, err := g.fileGraffiti(pubkey)
+		if err != nil {
+			// A broken graffiti file must not prevent proposing.
+			g.logger.Warn(
+				"Failed reading graffiti file, using configured graffiti",
+				"path", g.cfg.GraffitiFile, "error", err,
+			)
+			return toGraffiti(g.cfg.Graffiti)
+		}
+		return toGraffiti(graffiti)
+	case GraffitiModeAuto:
+		return toGraffiti(appendClientVersion(g.cfg.Graffiti, g.executionVersion(ctx), g.commit))
+	default:
+		return toGraffiti(g.cfg.Graffiti)
+	}
+}
+
+// fileGraffiti reads the graffiti file and returns the entry of the given
+// pubkey, falling back to the default entry and then to the configured
+// graffiti.
+func (g *GraffitiCalculator) fileGraffiti(pubkey crypto.BLSPubkey) (string, error) {
+	f, err := os.Open(g.cfg.GraffitiFile)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	entries, err := parseGraffitiFile(bufio.NewScanner(f))
+	if err != nil {
+		return "", err
+	}
+	if graffiti, ok := entries[pubkey.String()]; ok {
+		return graffiti, nil
+	}
+	if graffiti, ok := entries[graffitiFileDefaultKey]; ok {
+		return graffiti, nil
+	}
+	return g.cfg.Graffiti, nil
+}
+
+// executionVersion returns the execution client version, querying it again
+// once elVersionRefreshInterval has elapsed. It returns nil if the version is
+// unknown.
+func (g *GraffitiCalculator) executionVersion(ctx context.Context) *engineprimitives.ClientVersionV1 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.elClient == nil || time.Since(g.elVersionFetchedAt) < elVersionRefreshInterval {
+		return g.elVersion
+	}
+	g.elVersionFetchedAt = time.Now()
+	if !g.elClient.HasCapability(ethclient.GetClientVersionV1) {
+		return g.elVersion
+	}
+	ctx, cancel := context.WithTimeout(ctx, elVersionTimeout)
+	defer cancel()
+	versions, err := g.elClient.GetClientVersionV1(ctx)
+	if err != nil || len(versions) == 0 {
+		g.logger.Warn("Failed to get execution client version for graffiti", "error", err)
+		return g.elVersion
+	}
+	g.elVersion = &versions[0]
+	return g.elVersion
+}
+
+// parseGraffitiFile parses the graffiti file entries, keyed by lowercase
+// 0x-prefixed pubkey or by "default". Empty lines and lines starting with
+// '#' are ignored. Entries longer than a graffiti make the file invalid.
+func parseGraffitiFile(scanner *bufio.Scanner) (map[string]string, error) {
+	entries := make(map[string]string)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		key, graffiti, found := strings.Cut(text, ":")
+		if !found {
+			return nil, fmt.Errorf("%w: line %d", ErrInvalidGraffitiFile, line)
+		}
+		key = strings.TrimSpace(key)
+		if key != graffitiFileDefaultKey {
+			var pubkey crypto.BLSPubkey
+			if err := pubkey.UnmarshalText([]byte(key)); err != nil {
+				return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidGraffitiFile, line, err)
+			}
+			key = pubkey.String()
+		}
+		graffiti = strings.TrimSpace(graffiti)
+		if len(graffiti) > bytes.B32Size {
+			return nil, fmt.Errorf(
+				"%w: line %d: graffiti longer than %d bytes", ErrInvalidGraffitiFile, line, bytes.B32Size,
+			)
+		}
+		entries[key] = graffiti
+	}
+	return entries, scanner.Err()
+}
+
+// appendClientVersion appends to the graffiti the client identifiers, as the
+// execution client code and commit followed by the beacon-kit code and
+// commit. The commits are shortened, and eventually dropped, so that the
+// identifiers fit in the graffiti. If even the codes do not fit, the graffiti
+// is returned unchanged.
+func appendClientVersion(
+	graffiti string,
+	elVersion *engineprimitives.ClientVersionV1,
+	commit string,
+) string {
+	for _, n := range clientCommitLengths {
+		id := clientCode + commit[:min(n, len(commit))]
+		if elVersion != nil {
+			elCommit := normalizeCommit(elVersion.Commit)
+			id = elVersion.Code + elCommit[:min(n, len(elCommit))] + id
+		}
+		if graffiti == "" && len(id) <= bytes.B32Size {
+			return id
+		}
+		if len(graffiti)+len(id) < bytes.B32Size {
+			return graffiti + " " + id
+		}
+	}
+	return graffiti
+}
+
+// normalizeCommit returns the commit as lowercase hex without 0x prefix.
+func normalizeCommit(commit string) string {
+	return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(commit)), "0x")
+}
+
+// toGraffiti right pads the graffiti with zeros to 32 bytes.
+func toGraffiti(s string) (common.Bytes32, error) {
+	graffiti, err := bytes.ToBytes32(bytes.ExtendToSize([]byte(s), bytes.B32Size))
+	if err != nil {
+		return common.Bytes32{}, fmt.Errorf("failed processing graffiti: %w", err)
+	}
+	return graffiti, nil
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/beacon/validator"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeExecutionClient struct {
+	version engineprimitives.ClientVersionV1
+	calls   int
+}
+
+func (*fakeExecutionClient) HasCapability(string) bool { return true }
+
+func (c *fakeExecutionClient) GetClientVersionV1(
+	context.Context,
+) ([]engineprimitives.ClientVersionV1, error) {
+	c.calls++
+	return []engineprimitives.ClientVersionV1{c.version}, nil
+}
+
+func graffitiString(t *testing.T, g *validator.GraffitiCalculator, pubkey crypto.BLSPubkey) string {
+	t.Helper()
+	graffiti, err := g.Graffiti(context.Background(), pubkey)
+	require.NoError(t, err)
+	return strings.TrimRight(string(graffiti[:]), "\x00")
+}
+
+func TestGraffitiStatic(t *testing.T) {
+	t.Parallel()
+	cfg := validator.DefaultConfig()
+	cfg.Graffiti = "hello"
+	g, err := validator.NewGraffitiCalculator(&cfg, noop.NewLogger[any](), nil, "abcdef")
+	require.NoError(t, err)
+	require.Equal(t, "hello", graffitiString(t, g, crypto.BLSPubkey{}))
+
+	cfg.Graffiti = strings.Repeat("a", 33)
+	_, err = validator.NewGraffitiCalculator(&cfg, noop.NewLogger[any](), nil, "")
+	require.Error(t, err)
+
+	cfg = validator.DefaultConfig()
+	cfg.GraffitiMode = "unknown"
+	_, err = validator.NewGraffitiCalculator(&cfg, noop.NewLogger[any](), nil, "")
+	require.ErrorIs(t, err, validator.ErrUnknownGraffitiMode)
+}
+
+func TestGraffitiFile(t *testing.T) {
+	t.Parallel()
+	pubkey := crypto.BLSPubkey{0xAB}
+	path := filepath.Join(t.TempDir(), "graffiti.txt")
+	cfg := validator.DefaultConfig()
+	cfg.Graffiti = "fallback"
+	cfg.GraffitiMode = validator.GraffitiModeFile
+	cfg.GraffitiFile = path
+	g, err := validator.NewGraffitiCalculator(&cfg, noop.NewLogger[any](), nil, "")
+	require.NoError(t, err)
+
+	// A missing file falls back to the configured graffiti.
+	require.Equal(t, "fallback", graffitiString(t, g, pubkey))
+
+	content := "# comment\n\ndefault: everyone\n" + "0x" + strings.ToUpper(pubkey.String()[2:]) + ": mine\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	require.Equal(t, "mine", graffitiString(t, g, pubkey))
+	require.Equal(t, "everyone", graffitiString(t, g, crypto.BLSPubkey{0x01}))
+
+	// The file is re-read for every proposal.
+	require.NoError(t, os.WriteFile(path, []byte("default: updated\n"), 0o600))
+	require.Equal(t, "updated", graffitiString(t, g, pubkey))
+
+	// A malformed file falls back to the configured graffiti.
+	require.NoError(t, os.WriteFile(path, []byte("0x1234: bad pubkey\n"), 0o600))
+	require.Equal(t, "fallback", graffitiString(t, g, pubkey))
+
+	// So does an entry too long to fit in a graffiti.
+	require.NoError(t, os.WriteFile(path, []byte("default: "+strings.Repeat("a", 33)+"\n"), 0o600))
+	require.Equal(t, "fallback", graffitiString(t, g, pubkey))
+}
+
+func TestGraffitiAuto(t *testing.T) {
+	t.Parallel()
+	el := &fakeExecutionClient{
+		version: engineprimitives.ClientVersionV1{Code: "GE", Commit: "0x1A2B3C4D"},
+	}
+	tests := []struct {
+		graffiti string
+		expected string
+	}{
+		{"", "GE1a2bBKfedc"},
+		{"hello", "hello GE1a2bBKfedc"},
+		{strings.Repeat("a", 19), strings.Repeat("a", 19) + " GE1a2bBKfedc"},
+		{strings.Repeat("a", 20), strings.Repeat("a", 20) + " GE1aBKfe"},
+		{strings.Repeat("a", 24), strings.Repeat("a", 24) + " GEBK"},
+		{strings.Repeat("a", 28), strings.Repeat("a", 28)},
+	}
+	for _, tc := range tests {
+		cfg := validator.DefaultConfig()
+		cfg.Graffiti = tc.graffiti
+		cfg.GraffitiMode = validator.GraffitiModeAuto
+		g, err := validator.NewGraffitiCalculator(&cfg, noop.NewLogger[any](), el, "0xFEDCBA98")
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, graffitiString(t, g, crypto.BLSPubkey{}))
+		// The execution client version is cached between proposals.
+		calls := el.calls
+		require.Equal(t, tc.expected, graffitiString(t, g, crypto.BLSPubkey{}))
+		require.Equal(t, calls, el.calls)
+	}
+}
@@ -29,7 +29,9 @@ import (
 	datypes "github.com/berachain/beacon-kit/da/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/payload/builder"
+	"github.com/berachain/beacon-kit/payload/relay"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	"github.com/berachain/beacon-kit/state-transition/core"
@@ -46,6 +48,15 @@ type BlobFactory interface {
 	) (datypes.BlobSidecars, error)
 }
 
+// ExecutionClient is used to identify the execution client.
+type ExecutionClient interface {
+	// HasCapability returns true if the execution client supports the given
+	// engine API method.
+	HasCapability(capability string) bool
+	// GetClientVersionV1 returns the execution client versions.
+	GetClientVersionV1(ctx context.Context) ([]engineprimitives.ClientVersionV1, error)
+}
+
 // PayloadBuilder represents a service that is responsible for
 // building eth1 blocks.
 type PayloadBuilder interface {
@@ -59,6 +70,7 @@ type PayloadBuilder interface {
 		slot math.Slot,
 		parentBlockRoot common.Root,
 		expectedForkVersion common.Version,
+		proposerPubkey crypto.BLSPubkey,
 	) (ctypes.BuiltExecutionPayloadEnv, error)
 	// RequestPayloadSync requests a payload for the given slot and
 	// blocks until the payload is delivered.
@@ -66,6 +78,39 @@ type PayloadBuilder interface {
 		ctx context.Context,
 		r *builder.RequestPayloadData,
 	) (ctypes.BuiltExecutionPayloadEnv, error)
+	// FallbackPayload returns a payload for the given slot after building
+	// it the regular way failed, within the deadline of ctx.
+	FallbackPayload(
+		ctx context.Context,
+		r *builder.RequestPayloadData,
+	) (ctypes.BuiltExecutionPayloadEnv, error)
+}
+
+// RelayClient represents a builder-API client sourcing execution payloads
+// from external builders.
+type RelayClient interface {
+	// Enabled returns true if payloads should be sourced from the relays.
+	Enabled() bool
+	// PayloadDeadline returns the deadline by which both the bid and the
+	// reveal of a proposal started at the given time must complete.
+	PayloadDeadline(start time.Time) time.Time
+	// GetHeader returns the most valuable bid offered by the relays for the
+	// given slot, on top of the given parent execution block.
+	GetHeader(
+		ctx context.Context,
+		slot math.Slot,
+		parentHash common.ExecutionHash,
+		forkVersion common.Version,
+	) (*relay.SignedBuilderBid, error)
+	// IsBetterThanLocal returns true if the bid, weighted by the configured
+	// boost factor, pays more than the local payload.
+	IsBetterThanLocal(bid *relay.BuilderBid, localValue *math.U256) bool
+	// SubmitBlindedBlock submits the signed blinded block to the relays and
+	// returns the revealed execution payload and blobs.
+	SubmitBlindedBlock(
+		ctx context.Context,
+		blk *relay.SignedBlindedBeaconBlock,
+	) (*relay.ExecutionPayloadAndBlobsBundle, error)
 }
 
 // StateProcessor defines the interface for processing the state.
@@ -119,6 +164,7 @@ type ChainSpec interface {
 	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
 	SlotToEpoch(slot math.Slot) math.Epoch
 	EpochsPerHistoricalVector() uint64
+	MaxBlobsPerBlock() uint64
 
 	ctypes.ProposerDomain
 }
@@ -26,6 +26,16 @@ import (
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
+const (
+	// proposalOutcomeFull labels proposals built the regular way.
+	proposalOutcomeFull = "full"
+	// proposalOutcomeFallback labels proposals built around a fallback
+	// payload.
+	proposalOutcomeFallback = "fallback"
+	// proposalOutcomeFailed labels proposals that could not be built.
+	proposalOutcomeFailed = "failed"
+)
+
 // validatorMetrics is a struct that contains metrics for the chain.
 type validatorMetrics struct {
 	// sink is the sink for the metrics.
@@ -74,3 +84,38 @@ func (cm *validatorMetrics) failedToRetrievePayload(
 		err.Error(),
 	)
 }
+
+// payloadSource increments the counter for the number of blocks proposed
+// with a payload from the given source, either local or relay.
+func (cm *validatorMetrics) payloadSource(source string) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.payload_source",
+		"source",
+		source,
+	)
+}
+
+// failedToBuildBlockFromBid increments the counter for the number of
+// times the validator fell back to the local payload after selecting a
+// relay bid.
+func (cm *validatorMetrics) failedToBuildBlockFromBid(
+	slot math.Slot, err error,
+) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.failed_to_build_block_from_bid",
+		"slot",
+		slot.Base10(),
+		"error",
+		err.Error(),
+	)
+}
+
+// proposal increments the counter for the number of proposals with the given
+// outcome, either full, fallback or failed.
+func (cm *validatorMetrics) proposal(outcome string) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.proposal",
+		"outcome",
+		outcome,
+	)
+}
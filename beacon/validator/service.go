@@ -22,10 +22,12 @@ package validator
 
 import (
 	"context"
+	"sync"
 
 	"github.com/berachain/beacon-kit/execution/deposit"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
 )
 
 // Service is responsible for building beacon blocks and sidecars.
@@ -52,8 +54,19 @@ type Service struct {
 	// Building blocks are done by submitting forkchoice updates through.
 	// The local Builder.
 	localPayloadBuilder PayloadBuilder
+	// relayClient sources payloads from external builders, which are
+	// preferred over the local payload when they pay more.
+	relayClient RelayClient
+	// graffiti computes the graffiti of the proposed blocks.
+	graffiti *GraffitiCalculator
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
+
+	// muSubmitted protects submitted.
+	muSubmitted sync.Mutex
+	// submitted holds the blocks signed by external validator clients,
+	// by slot, until they are proposed.
+	submitted map[math.Slot]*submittedBlock
 }
 
 // NewService creates a new validator service.
@@ -67,6 +80,8 @@ func NewService(
 	signer crypto.BLSSigner,
 	blobFactory BlobFactory,
 	localPayloadBuilder PayloadBuilder,
+	relayClient RelayClient,
+	graffiti *GraffitiCalculator,
 	ts TelemetrySink,
 ) *Service {
 	return &Service{
@@ -79,7 +94,10 @@ func NewService(
 		stateProcessor:      stateProcessor,
 		blobFactory:         blobFactory,
 		localPayloadBuilder: localPayloadBuilder,
+		relayClient:         relayClient,
+		graffiti:            graffiti,
 		metrics:             newValidatorMetrics(ts),
+		submitted:           make(map[math.Slot]*submittedBlock),
 	}
 }
 
@@ -104,6 +104,12 @@ type SpecData struct {
 	Electra1ForkTime uint64 `mapstructure:"electra-one-fork-time"`
 	// FuluForkTime is the time at which the Fulu fork is activated.
 	FuluForkTime uint64 `mapstructure:"fulu-fork-time"`
+	// ProposalCompressionForkTime is the time from which the beacon block and
+	// blob sidecars transactions of the proposals are compressed.
+	ProposalCompressionForkTime uint64 `mapstructure:"proposal-compression-fork-time"`
+	// ValidatorLookupIndexesForkTime is the time from which the validators are
+	// indexed by withdrawal credentials, activation epoch and exit epoch.
+	ValidatorLookupIndexesForkTime uint64 `mapstructure:"validator-lookup-indexes-fork-time"`
 
 	// State list lengths
 	//
@@ -44,6 +44,18 @@ func (s spec) ActiveForkVersionForTimestamp(timestamp math.U64) common.Version {
 	return version.Deneb()
 }
 
+// IsProposalCompressed returns true if the transactions of the proposal with
+// the given timestamp are compressed.
+func (s spec) IsProposalCompressed(timestamp math.U64) bool {
+	return timestamp.Unwrap() >= s.ProposalCompressionForkTime()
+}
+
+// HasValidatorLookupIndexes returns true if the validators are indexed by
+// withdrawal credentials and lifecycle epochs at the given timestamp.
+func (s spec) HasValidatorLookupIndexes(timestamp math.U64) bool {
+	return timestamp.Unwrap() >= s.ValidatorLookupIndexesForkTime()
+}
+
 // GenesisForkVersion returns the fork version at genesis.
 func (s spec) GenesisForkVersion() common.Version {
 	return s.ActiveForkVersionForTimestamp(math.U64(s.GenesisTime()))
@@ -39,6 +39,8 @@ var spec, _ = chain.NewSpec(
 		ElectraForkTime:                  10 * 32 * 2,
 		Electra1ForkTime:                 11 * 32 * 2,
 		FuluForkTime:                     12 * 32 * 2,
+		ProposalCompressionForkTime:      13 * 32 * 2,
+		ValidatorLookupIndexesForkTime:   14 * 32 * 2,
 		SlotsPerEpoch:                    32,
 		MinEpochsForBlobsSidecarsRequest: 5,
 		MaxWithdrawalsPerPayload:         2,
@@ -72,6 +74,56 @@ func TestActiveForkVersionForTimestamp(t *testing.T) {
 	}
 }
 
+// TestIsProposalCompressed tests the IsProposalCompressed method.
+func TestIsProposalCompressed(t *testing.T) {
+	t.Parallel()
+	// Define test cases
+	tests := []struct {
+		name      string
+		timestamp uint64
+		expected  bool
+	}{
+		{name: "At Fulu Fork", timestamp: spec.FuluForkTime(), expected: false},
+		{name: "Before Compression Fork", timestamp: spec.ProposalCompressionForkTime() - 1, expected: false},
+		{name: "At Compression Fork", timestamp: spec.ProposalCompressionForkTime(), expected: true},
+		{name: "After Compression Fork", timestamp: spec.ProposalCompressionForkTime() + 1, expected: true},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result := spec.IsProposalCompressed(math.U64(tt.timestamp))
+			require.Equal(t, tt.expected, result, "Test case : %s", tt.name)
+		})
+	}
+}
+
+// TestHasValidatorLookupIndexes tests the HasValidatorLookupIndexes method.
+func TestHasValidatorLookupIndexes(t *testing.T) {
+	t.Parallel()
+	// Define test cases
+	tests := []struct {
+		name      string
+		timestamp uint64
+		expected  bool
+	}{
+		{name: "At Fulu Fork", timestamp: spec.FuluForkTime(), expected: false},
+		{name: "Before Lookup Indexes Fork", timestamp: spec.ValidatorLookupIndexesForkTime() - 1, expected: false},
+		{name: "At Lookup Indexes Fork", timestamp: spec.ValidatorLookupIndexesForkTime(), expected: true},
+		{name: "After Lookup Indexes Fork", timestamp: spec.ValidatorLookupIndexesForkTime() + 1, expected: true},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result := spec.HasValidatorLookupIndexes(math.U64(tt.timestamp))
+			require.Equal(t, tt.expected, result, "Test case : %s", tt.name)
+		})
+	}
+}
+
 // TestSlotToEpoch tests the SlotToEpoch method.
 func TestSlotToEpoch(t *testing.T) {
 	t.Parallel()
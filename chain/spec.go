@@ -115,6 +115,14 @@ type ForkSpec interface {
 
 	// FuluForkTime returns the time at which the Fulu fork takes effect.
 	FuluForkTime() uint64
+
+	// ProposalCompressionForkTime returns the time from which the proposal
+	// transactions are compressed.
+	ProposalCompressionForkTime() uint64
+
+	// ValidatorLookupIndexesForkTime returns the time from which the
+	// validators are indexed by withdrawal credentials and lifecycle epochs.
+	ValidatorLookupIndexesForkTime() uint64
 }
 
 type BlobSpec interface {
@@ -147,6 +155,14 @@ type ForkVersionSpec interface {
 
 	// ActiveForkVersionForTimestamp returns the active fork version for a given timestamp.
 	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
+
+	// IsProposalCompressed returns true if the transactions of the proposal
+	// with the given timestamp are compressed.
+	IsProposalCompressed(timestamp math.U64) bool
+
+	// HasValidatorLookupIndexes returns true if the validators are indexed by
+	// withdrawal credentials and lifecycle epochs at the given timestamp.
+	HasValidatorLookupIndexes(timestamp math.U64) bool
 }
 
 type BerachainSpec interface {
@@ -479,6 +495,16 @@ func (s spec) FuluForkTime() uint64 {
 	return s.Data.FuluForkTime
 }
 
+// ProposalCompressionForkTime returns the timestamp of the proposal compression fork.
+func (s spec) ProposalCompressionForkTime() uint64 {
+	return s.Data.ProposalCompressionForkTime
+}
+
+// ValidatorLookupIndexesForkTime returns the timestamp of the validator lookup indexes fork.
+func (s spec) ValidatorLookupIndexesForkTime() uint64 {
+	return s.Data.ValidatorLookupIndexesForkTime
+}
+
 // EpochsPerHistoricalVector returns the number of epochs per historical vector.
 func (s spec) EpochsPerHistoricalVector() uint64 {
 	return s.Data.EpochsPerHistoricalVector
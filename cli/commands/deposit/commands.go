@@ -39,6 +39,11 @@ func Commands(chainSpecCreator servertypes.ChainSpecCreator, appCreator serverty
 	cmd.AddCommand(
 		GetValidateDepositCmd(chainSpecCreator),
 		GetCreateValidatorCmd(chainSpecCreator),
+		GetCreateDepositDataCmd(chainSpecCreator),
+		GetRequestWithdrawalCmd(),
+		GetRequestConsolidationCmd(),
+		GetTopUpCmd(chainSpecCreator),
+		GetStatusCmd(chainSpecCreator, appCreator),
 		GetValidatorKeysCmd(),
 		GetDBCheckCmd(appCreator),
 	)
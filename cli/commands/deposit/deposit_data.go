@@ -0,0 +1,261 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	clitypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/cli/utils/parser"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/eip2333"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/spf13/cobra"
+)
+
+const (
+	depositDataAddr0 = iota
+	depositDataAmt1  = iota
+
+	numValidators     = "num-validators"
+	startIndex        = "start-index"
+	outputFile        = "output-file"
+	keystoreDir       = "keystore-dir"
+	keystorePassword  = "keystore-password-file"
+	defaultValidators = 1
+
+	depositDataFilePerms os.FileMode = 0o644
+	keystoreDirPerms     os.FileMode = 0o700
+)
+
+// DepositDataEntry is a deposit-data entry for one validator, carrying what
+// is needed to submit its deposit to the deposit contract.
+type DepositDataEntry struct {
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+	// WithdrawalCredentials are the withdrawal credentials of the deposit.
+	WithdrawalCredentials types.WithdrawalCredentials `json:"withdrawal_credentials"`
+	// Amount is the deposit amount in gwei.
+	Amount math.Gwei `json:"amount"`
+	// Signature is the signature of the deposit message.
+	Signature crypto.BLSSignature `json:"signature"`
+	// DepositMessageRoot is the hash tree root of the deposit message.
+	DepositMessageRoot common.Root `json:"deposit_message_root"`
+	// DepositDataRoot is the hash tree root of the deposit data, as expected
+	// by the deposit contract.
+	DepositDataRoot common.Root `json:"deposit_data_root"`
+	// ForkVersion is the fork version the deposit is signed with.
+	ForkVersion common.Version `json:"fork_version"`
+	// Path is the EIP-2334 derivation path of the validator key, if any.
+	Path string `json:"path,omitempty"`
+}
+
+// GetCreateDepositDataCmd returns a command to derive validator keys from a
+// mnemonic and create their deposit data.
+//
+//nolint:lll // Reads better if long description is one line.
+func GetCreateDepositDataCmd(
+	chainSpecCreator clitypes.ChainSpecCreator,
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-deposit-data [withdrawal-address] [amount] ?[beacond/genesis.json]",
+		Short: "Creates the deposit data of validator keys derived from a mnemonic",
+		Long:  `Derives validator keys from a bip39 mnemonic, read from stdin, at the EIP-2334 signing key paths m/12381/3600/i/0/0 for i in [start-index, start-index + num-validators), and outputs the deposit data JSON of each validator. The arguments are expected in the order of withdrawal address, deposit amount, and optionally the beacond genesis file. If the genesis validator root flag is NOT set, the beacond genesis file MUST be provided as the last argument. If the keystore directory is set, the derived keys are also written there as EIP-2335 keystores encrypted with the password read from --keystore-password-file or the BEACOND_KEYSTORE_PASSWORD environment variable.`,
+		Args:  cobra.RangeArgs(minArgsCreateDeposit, maxArgsCreateDeposit),
+		RunE:  createDepositDataCmd(chainSpecCreator),
+	}
+
+	cmd.Flags().Uint32(numValidators, defaultValidators, "number of validator keys to derive")
+	cmd.Flags().Uint32(startIndex, 0, "index of the first validator key to derive")
+	cmd.Flags().String(outputFile, "", "deposit data output file, defaults to stdout")
+	cmd.Flags().String(keystoreDir, "", "optional directory to write the derived keys to as EIP-2335 keystores")
+	cmd.Flags().String(keystorePassword, "", "optional keystore password file path")
+	cmd.Flags().StringP(
+		useGenesisValidatorRoot,
+		useGenesisValidatorRootShorthand,
+		defaultGenesisValidatorRoot,
+		"Use the provided genesis validator root. If this is not set, the beacond genesis file must be provided manually as the last argument.",
+	)
+
+	return cmd
+}
+
+// createDepositDataCmd returns a command that builds the deposit data of
+// validator keys derived from a mnemonic.
+//
+//nolint:funlen,gocognit // reads better in one place.
+func createDepositDataCmd(
+	chainSpecCreator clitypes.ChainSpecCreator,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		chainSpec, err := chainSpecCreator(clicontext.GetViperFromCmd(cmd))
+		if err != nil {
+			return err
+		}
+
+		var withdrawalAddress common.ExecutionAddress
+		if err = withdrawalAddress.UnmarshalText([]byte(args[depositDataAddr0])); err != nil {
+			return err
+		}
+		credentials := types.NewCredentialsFromExecutionAddress(withdrawalAddress)
+		amount, err := parser.ConvertAmount(args[depositDataAmt1])
+		if err != nil {
+			return err
+		}
+		genesisValidatorRoot, err := getGenesisValidatorRoot(
+			cmd, chainSpec, args, maxArgsCreateDeposit,
+		)
+		if err != nil {
+			return err
+		}
+
+		count, err := cmd.Flags().GetUint32(numValidators)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return ErrNoValidators
+		}
+		start, err := cmd.Flags().GetUint32(startIndex)
+		if err != nil {
+			return err
+		}
+		if uint64(start)+uint64(count) > 1<<32 {
+			return fmt.Errorf("%w: start index %d with %d validators", ErrIndexOverflow, start, count)
+		}
+		output, err := cmd.Flags().GetString(outputFile)
+		if err != nil {
+			return err
+		}
+		ksDir, err := cmd.Flags().GetString(keystoreDir)
+		if err != nil {
+			return err
+		}
+		var password string
+		if ksDir != "" {
+			var passwordFile string
+			if passwordFile, err = cmd.Flags().GetString(keystorePassword); err != nil {
+				return err
+			}
+			if password, err = signer.ReadKeystorePassword(passwordFile); err != nil {
+				return err
+			}
+			if err = os.MkdirAll(ksDir, keystoreDirPerms); err != nil {
+				return err
+			}
+		}
+
+		mnemonic, err := input.GetString("Enter your bip39 mnemonic", bufio.NewReader(cmd.InOrStdin()))
+		if err != nil {
+			return err
+		}
+
+		entries := make([]*DepositDataEntry, 0, count)
+		for index := start; index-start < count; index++ {
+			path := eip2333.SigningKeyPath(index)
+			var secret signer.LegacyKey
+			if secret, err = signer.LegacyKeyFromMnemonic(mnemonic, path); err != nil {
+				return err
+			}
+			var blsSigner *signer.LegacySigner
+			if blsSigner, err = signer.NewLegacySigner(secret); err != nil {
+				return err
+			}
+
+			var entry *DepositDataEntry
+			entry, err = CreateDepositData(chainSpec, blsSigner, genesisValidatorRoot, credentials, amount)
+			if err != nil {
+				return fmt.Errorf("failed creating deposit data at %s: %w", path, err)
+			}
+			entry.Path = path
+			entries = append(entries, entry)
+
+			if ksDir != "" {
+				if err = writeKeystore(ksDir, secret, entry.Pubkey, path, password); err != nil {
+					return err
+				}
+			}
+		}
+
+		bz, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return err
+		}
+		if output == "" {
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s\n", bz)
+			return err
+		}
+		if err = os.WriteFile(output, bz, depositDataFilePerms); err != nil {
+			return err
+		}
+		cmd.Printf("✅ Deposit data of %d validators written to %s\n", count, output)
+		return nil
+	}
+}
+
+// CreateDepositData signs the deposit message of the given signer and returns
+// its deposit data entry.
+func CreateDepositData(
+	cs ChainSpec,
+	blsSigner crypto.BLSSigner,
+	genValRoot common.Root,
+	creds types.WithdrawalCredentials,
+	amount math.Gwei,
+) (*DepositDataEntry, error) {
+	depositMsg, signature, err := CreateDepositMessage(cs, blsSigner, genValRoot, creds, amount)
+	if err != nil {
+		return nil, err
+	}
+	return &DepositDataEntry{
+		Pubkey:                depositMsg.Pubkey,
+		WithdrawalCredentials: depositMsg.Credentials,
+		Amount:                depositMsg.Amount,
+		Signature:             signature,
+		DepositMessageRoot:    depositMsg.HashTreeRoot(),
+		DepositDataRoot:       types.NewDepositData(depositMsg, signature).HashTreeRoot(),
+		ForkVersion:           cs.GenesisForkVersion(),
+	}, nil
+}
+
+// writeKeystore encrypts the secret key into an EIP-2335 keystore written to
+// dir, named after its public key.
+func writeKeystore(dir string, secret signer.LegacyKey, pubkey crypto.BLSPubkey, path, password string) error {
+	ks, err := signer.EncryptKeystore(secret[:], pubkey, password, signer.KDFScrypt)
+	if err != nil {
+		return err
+	}
+	ks.Path = path
+	ksPath := filepath.Join(dir, "keystore-"+ks.Pubkey+".json")
+	if _, err = os.Stat(ksPath); err == nil {
+		return errors.Wrapf(ErrKeystoreExists, "%s", ksPath)
+	}
+	return ks.WriteFile(ksPath)
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/deposit"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/eip2333"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestCreateDepositDataFromMnemonic(t *testing.T) {
+	t.Parallel()
+
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	genValRoot := common.Root{0x01}
+	creds := types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x02})
+	amount := math.Gwei(32e9)
+
+	pubkeys := make(map[crypto.BLSPubkey]struct{})
+	for index := range uint32(3) {
+		secret, errDerive := signer.LegacyKeyFromMnemonic(testMnemonic, eip2333.SigningKeyPath(index))
+		require.NoError(t, errDerive)
+		blsSigner, errSigner := signer.NewLegacySigner(secret)
+		require.NoError(t, errSigner)
+
+		entry, errCreate := deposit.CreateDepositData(cs, blsSigner, genValRoot, creds, amount)
+		require.NoError(t, errCreate)
+		require.Equal(t, blsSigner.PublicKey(), entry.Pubkey)
+		require.Equal(t, cs.GenesisForkVersion(), entry.ForkVersion)
+		require.NoError(t, deposit.ValidateDeposit(
+			cs, entry.Pubkey, entry.WithdrawalCredentials, entry.Amount, genValRoot, entry.Signature,
+		))
+
+		data := &types.DepositData{
+			Pubkey:      entry.Pubkey,
+			Credentials: entry.WithdrawalCredentials,
+			Amount:      entry.Amount,
+			Signature:   entry.Signature,
+		}
+		require.Equal(t, data.HashTreeRoot(), entry.DepositDataRoot)
+		pubkeys[entry.Pubkey] = struct{}{}
+	}
+	require.Len(t, pubkeys, 3)
+
+	_, err = signer.LegacyKeyFromMnemonic("not a mnemonic", eip2333.SigningKeyPath(0))
+	require.ErrorIs(t, err, signer.ErrInvalidMnemonic)
+}
@@ -42,4 +42,24 @@ var (
 	// ErrPrivateKeyEmpty is returned when the private key is empty.
 	ErrPrivateKeyEmpty = errors.New(
 		"private key is empty")
+
+	// ErrNoValidators is returned when no validator key is requested.
+	ErrNoValidators = errors.New("number of validators must be positive")
+
+	// ErrIndexOverflow is returned when the requested validator key indices
+	// overflow the EIP-2334 index range.
+	ErrIndexOverflow = errors.New("validator key index overflows uint32")
+
+	// ErrKeystoreExists is returned when a keystore would be overwritten.
+	ErrKeystoreExists = errors.New("keystore already exists")
+
+	// ErrSenderRequired is returned when neither a private key nor a sender
+	// address is provided for a request transaction.
+	ErrSenderRequired = errors.New("private key or sender address required")
+
+	// ErrTxFailed is returned when a transaction is reverted.
+	ErrTxFailed = errors.New("transaction reverted")
+
+	// ErrNodeAPI is returned when the node API returns an unexpected status.
+	ErrNodeAPI = errors.New("unexpected node API response")
 )
@@ -51,8 +51,13 @@ func GetValidatorKeysCmd() *cobra.Command {
 			if err != nil {
 				return errors.Wrap(err, "failed to initialize BLS signer from validator files")
 			}
-			blsSigner, ok := blsSignerI.(*signer.BLSSigner)
-			if !ok {
+			var blsSigner *signer.BLSSigner
+			switch typed := blsSignerI.(type) {
+			case *signer.BLSSigner:
+				blsSigner = typed
+			case *signer.KeystoreSigner:
+				blsSigner = &typed.BLSSigner
+			default:
 				return errors.New("failed to assert BLS signer type")
 			}
 
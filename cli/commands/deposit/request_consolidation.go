@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/berachain/beacon-kit/cli/utils/parser"
+	"github.com/berachain/beacon-kit/execution/requests/eip7251"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/spf13/cobra"
+)
+
+const (
+	consolidationSource0 = iota
+	consolidationTarget1 = iota
+
+	numArgsRequestConsolidation = 2
+)
+
+// GetRequestConsolidationCmd returns a command to request the consolidation
+// of a source validator into a target validator as defined by EIP-7251.
+//
+//nolint:lll // Reads better if long description is one line.
+func GetRequestConsolidationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-consolidation [source-pubkey] [target-pubkey]",
+		Short: "Creates an EIP-7251 consolidation request transaction",
+		Long:  `Creates a transaction to the EIP-7251 consolidation request system contract, consolidating the source validator into the target validator. The transaction pays the current request fee and must be sent by the source validator withdrawal address. It is signed with --private-key, or output unsigned for the --from address otherwise. With --broadcast, the signed transaction is sent and its inclusion awaited. Note that consolidation requests are included in blocks but not yet processed by the beacon chain.`,
+		Args:  cobra.ExactArgs(numArgsRequestConsolidation),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			source, err := parser.ConvertPubkey(args[consolidationSource0])
+			if err != nil {
+				return err
+			}
+			target, err := parser.ConvertPubkey(args[consolidationTarget1])
+			if err != nil {
+				return err
+			}
+			data, err := eip7251.CreateConsolidationRequestData(source, target)
+			if err != nil {
+				return err
+			}
+			_, err = sendContractTx(cmd, &contractTx{
+				to:   params.ConsolidationQueueAddress,
+				data: data,
+				value: func(ctx context.Context, client feeClient) (*big.Int, error) {
+					return eip7251.GetConsolidationFee(ctx, client)
+				},
+			})
+			return err
+		},
+	}
+	addTxFlags(cmd)
+	return cmd
+}
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/berachain/beacon-kit/cli/utils/parser"
+	"github.com/berachain/beacon-kit/execution/requests/eip7002"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/spf13/cobra"
+)
+
+const (
+	withdrawalPubkey0 = iota
+	withdrawalAmt1    = iota
+
+	numArgsRequestWithdrawal = 2
+)
+
+// GetRequestWithdrawalCmd returns a command to request a withdrawal or a
+// full exit of a validator as defined by EIP-7002.
+//
+//nolint:lll // Reads better if long description is one line.
+func GetRequestWithdrawalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-withdrawal [validator-pubkey] [amount]",
+		Short: "Creates an EIP-7002 withdrawal request transaction",
+		Long:  `Creates a transaction to the EIP-7002 withdrawal request system contract, withdrawing the given amount in gwei from the validator, or fully exiting it if the amount is 0. The transaction pays the current request fee and must be sent by the validator withdrawal address. It is signed with --private-key, or output unsigned for the --from address otherwise. With --broadcast, the signed transaction is sent and, once included, the node API is polled until the withdrawal is pending or the validator is exiting.`,
+		Args:  cobra.ExactArgs(numArgsRequestWithdrawal),
+		RunE:  requestWithdrawalCmd,
+	}
+	addTxFlags(cmd)
+	return cmd
+}
+
+// requestWithdrawalCmd builds, and possibly broadcasts, a withdrawal request.
+func requestWithdrawalCmd(cmd *cobra.Command, args []string) error {
+	pubkey, err := parser.ConvertPubkey(args[withdrawalPubkey0])
+	if err != nil {
+		return err
+	}
+	amount, err := parser.ConvertAmount(args[withdrawalAmt1])
+	if err != nil {
+		return err
+	}
+	data, err := eip7002.CreateWithdrawalRequestData(pubkey, amount)
+	if err != nil {
+		return err
+	}
+
+	receipt, err := sendContractTx(cmd, &contractTx{
+		to:   params.WithdrawalQueueAddress,
+		data: data,
+		value: func(ctx context.Context, client feeClient) (*big.Int, error) {
+			return eip7002.GetWithdrawalFee(ctx, client)
+		},
+	})
+	if err != nil || receipt == nil {
+		return err
+	}
+
+	baseURL, err := cmd.Flags().GetString(nodeAPIURL)
+	if err != nil {
+		return err
+	}
+	timeout, err := cmd.Flags().GetDuration(pollTimeout)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(cmdContext(cmd), timeout)
+	defer cancel()
+	if amount == constants.FullExitRequestAmount {
+		return waitForExit(ctx, cmd, baseURL, pubkey)
+	}
+	return waitForPendingWithdrawal(ctx, cmd, baseURL, pubkey)
+}
+
+// waitForPendingWithdrawal polls the node API until a partial withdrawal of
+// the validator is pending.
+func waitForPendingWithdrawal(
+	ctx context.Context, cmd *cobra.Command, baseURL string, pubkey crypto.BLSPubkey,
+) error {
+	validator, err := nodeAPIGet[beacontypes.ValidatorData](
+		ctx, baseURL, "/eth/v1/beacon/states/head/validators/"+pubkey.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed getting validator: %w", err)
+	}
+	withdrawal, err := poll(ctx, func(ctx context.Context) (*beacontypes.PendingPartialWithdrawalData, bool, error) {
+		pending, errGet := nodeAPIGet[[]*beacontypes.PendingPartialWithdrawalData](
+			ctx, baseURL, "/eth/v1/beacon/states/head/pending_partial_withdrawals",
+		)
+		if errGet != nil {
+			return nil, false, errGet
+		}
+		for _, w := range pending {
+			if w.ValidatorIndex == validator.Index {
+				return w, true, nil
+			}
+		}
+		return nil, false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed waiting for pending withdrawal of validator %d: %w", validator.Index, err)
+	}
+	cmd.Printf(
+		"✅ Withdrawal of %d gwei pending for validator %d, withdrawable at epoch %d\n",
+		withdrawal.Amount, withdrawal.ValidatorIndex, withdrawal.WithdrawalEpoch,
+	)
+	return nil
+}
+
+// waitForExit polls the node API until the validator is no longer active
+// ongoing.
+func waitForExit(ctx context.Context, cmd *cobra.Command, baseURL string, pubkey crypto.BLSPubkey) error {
+	validator, err := poll(ctx, func(ctx context.Context) (*beacontypes.ValidatorData, bool, error) {
+		v, errGet := nodeAPIGet[*beacontypes.ValidatorData](
+			ctx, baseURL, "/eth/v1/beacon/states/head/validators/"+pubkey.String(),
+		)
+		if errGet != nil {
+			return nil, false, errGet
+		}
+		return v, v != nil && v.Status != constants.ValidatorStatusActiveOngoing, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed waiting for exit of validator %s: %w", pubkey, err)
+	}
+	cmd.Printf("✅ Validator %d is now %s\n", validator.Index, validator.Status)
+	return nil
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"bytes"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/deposit"
+	"github.com/berachain/beacon-kit/execution/requests/eip7002"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeELServer returns a JSON-RPC server answering the calls made to build
+// a request transaction.
+func newFakeELServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	results := map[string]string{
+		"eth_chainId":              "0x138d5",
+		"eth_call":                 "0x2",
+		"eth_getTransactionCount":  "0x7",
+		"eth_maxPriorityFeePerGas": "0x3b9aca00",
+		"eth_gasPrice":             "0x77359400",
+		"eth_estimateGas":          "0x1d4c0",
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		bz, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(bz, &req))
+		result, ok := results[req.Method]
+		require.True(t, ok, "unexpected method %s", req.Method)
+		w.Header().Set("Content-Type", "application/json")
+		_, err = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":"` + result + `"}`))
+		require.NoError(t, err)
+	}))
+}
+
+func TestRequestWithdrawalSignsTx(t *testing.T) {
+	t.Parallel()
+	server := newFakeELServer(t)
+	defer server.Close()
+
+	key, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	pubkey := crypto.BLSPubkey{0x0a, 0x0b}
+
+	var out bytes.Buffer
+	cmd := deposit.GetRequestWithdrawalCmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs([]string{
+		pubkey.String(), "1000",
+		"--rpc-url", server.URL,
+		"--private-key", hexutil.Encode(ethcrypto.FromECDSA(key)),
+	})
+	require.NoError(t, cmd.Execute())
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	raw, err := hexutil.Decode(lines[len(lines)-1])
+	require.NoError(t, err)
+	tx := new(gethtypes.Transaction)
+	require.NoError(t, tx.UnmarshalBinary(raw))
+
+	expectedData, err := eip7002.CreateWithdrawalRequestData(pubkey, math.Gwei(1000))
+	require.NoError(t, err)
+	require.Equal(t, params.WithdrawalQueueAddress, *tx.To())
+	require.Equal(t, []byte(expectedData), tx.Data())
+	require.Equal(t, big.NewInt(2), tx.Value())
+	require.Equal(t, uint64(7), tx.Nonce())
+	require.Equal(t, uint64(120000), tx.Gas())
+	require.Equal(t, big.NewInt(4e9), tx.GasFeeCap())
+
+	sender, err := gethtypes.Sender(gethtypes.NewPragueSigner(tx.ChainId()), tx)
+	require.NoError(t, err)
+	require.Equal(t, ethcrypto.PubkeyToAddress(key.PublicKey), sender)
+}
+
+func TestRequestConsolidationRequiresSender(t *testing.T) {
+	t.Parallel()
+	pubkey := crypto.BLSPubkey{0x0a}
+
+	cmd := deposit.GetRequestConsolidationCmd()
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs([]string{pubkey.String(), pubkey.String()})
+	require.ErrorIs(t, cmd.Execute(), deposit.ErrSenderRequired)
+
+	cmd = deposit.GetRequestConsolidationCmd()
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs([]string{pubkey.String(), pubkey.String(), "--from", "0x20f33ce90a13a4b5e7697e3544c3083b8f8a51d4", "--broadcast"})
+	require.ErrorIs(t, cmd.Execute(), deposit.ErrPrivateKeyRequired)
+}
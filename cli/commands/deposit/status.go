@@ -0,0 +1,423 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	stdbytes "bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	stdmath "math"
+	"slices"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/chain"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/cli/utils/parser"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/db"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	offline = "offline"
+
+	// depositsPageSize is the number of deposits requested per node API call.
+	depositsPageSize = 1024
+)
+
+// DepositRecord is a deposit of the validator read from the deposit contract.
+type DepositRecord struct {
+	// Index is the index of the deposit in the deposit contract.
+	Index uint64
+	// Amount is the deposit amount in gwei.
+	Amount math.Gwei
+	// ExecutionBlockNumber is the execution block the deposit was read from,
+	// empty for genesis deposits.
+	ExecutionBlockNumber string
+	// Included reports whether the deposit was included in a beacon block.
+	Included bool
+}
+
+// DepositStatus is the deposit and activation status of a validator.
+type DepositStatus struct {
+	// Deposits are the deposits of the validator in the deposit store.
+	Deposits []*DepositRecord
+	// Validator is the validator in the registry, nil if not created yet.
+	Validator *ctypes.Validator
+	// ValidatorIndex is the index of the validator in the registry.
+	ValidatorIndex math.ValidatorIndex
+	// Status is the validator status at the head epoch.
+	Status string
+	// ActiveValidators is the number of validators active next epoch.
+	ActiveValidators uint64
+	// CapThreshold is the lowest effective balance of the validators kept in
+	// the validator set once capped, zero if the set is not full.
+	CapThreshold math.Gwei
+	// CappedOut reports whether the validator is ejected at the next epoch
+	// transition to enforce the validator set cap.
+	CappedOut bool
+}
+
+// statusSource reads the data reported by the status command, from the node
+// API or from the local databases.
+type statusSource interface {
+	// deposits returns the deposits of the deposit store with the given pubkey.
+	deposits(ctx context.Context, pubkey crypto.BLSPubkey) ([]*DepositRecord, error)
+	// headState returns the head slot, the index of the next deposit to be
+	// included and the validator registry of the head state.
+	headState(ctx context.Context) (math.Slot, uint64, ctypes.Validators, error)
+}
+
+// GetStatusCmd returns a command reporting the deposit and activation status
+// of a validator.
+//
+//nolint:lll // Reads better if long description is one line.
+func GetStatusCmd(chainSpecCreator servertypes.ChainSpecCreator, appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [validator-pubkey]",
+		Short: "Reports the deposit and activation status of a validator",
+		Long:  `Reports whether the deposits of the validator were read from the deposit contract, whether they are queued or included in the beacon chain, and the validator status, activation epoch and effective balance at the head state. It also reports whether the validator is ejected to enforce the validator set cap. The data is read from the node API, or from the local databases with --offline, which requires the node to be stopped. Note that after the Fulu fork deposits are processed from deposit requests and no longer tracked by the deposit store.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainSpec, err := chainSpecCreator(clicontext.GetViperFromCmd(cmd))
+			if err != nil {
+				return err
+			}
+			pubkey, err := parser.ConvertPubkey(args[0])
+			if err != nil {
+				return err
+			}
+
+			isOffline, err := cmd.Flags().GetBool(offline)
+			if err != nil {
+				return err
+			}
+			var source statusSource
+			if isOffline {
+				localSource, errLocal := newLocalStatusSource(cmd, appCreator)
+				if errLocal != nil {
+					return errLocal
+				}
+				defer localSource.close()
+				source = localSource
+			} else {
+				baseURL, errURL := cmd.Flags().GetString(nodeAPIURL)
+				if errURL != nil {
+					return errURL
+				}
+				source = &nodeAPIStatusSource{baseURL: baseURL}
+			}
+
+			status, err := getDepositStatus(cmdContext(cmd), chainSpec, source, pubkey)
+			if err != nil {
+				return err
+			}
+			printDepositStatus(cmd, chainSpec, pubkey, status)
+			return nil
+		},
+	}
+	cmd.Flags().Bool(offline, false, "read the local databases instead of the node API")
+	cmd.Flags().String(nodeAPIURL, defaultNodeAPIURL, "beacon node API URL")
+	return cmd
+}
+
+// getDepositStatus computes the deposit status of the validator with the
+// given pubkey from the source.
+func getDepositStatus(
+	ctx context.Context, cs chain.Spec, source statusSource, pubkey crypto.BLSPubkey,
+) (*DepositStatus, error) {
+	deposits, err := source.deposits(ctx, pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading deposits: %w", err)
+	}
+	slot, eth1DepositIndex, validators, err := source.headState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading head state: %w", err)
+	}
+
+	status := &DepositStatus{Deposits: deposits}
+	for _, d := range deposits {
+		d.Included = d.Index < eth1DepositIndex
+	}
+	epoch := cs.SlotToEpoch(slot)
+	for i, v := range validators {
+		if v.GetPubkey() == pubkey {
+			status.Validator = v
+			status.ValidatorIndex = math.ValidatorIndex(i)
+			if status.Status, err = v.Status(epoch); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	// Mirror the validator set cap enforcement of the state processor, which
+	// ejects the validators active next epoch with the lowest stake.
+	nextEpochVals := make(ctypes.Validators, 0, len(validators))
+	for _, v := range validators {
+		if v.IsActive(epoch + 1) {
+			nextEpochVals = append(nextEpochVals, v)
+		}
+	}
+	status.ActiveValidators = uint64(len(nextEpochVals))
+	validatorSetCap := cs.ValidatorSetCap()
+	if status.ActiveValidators < validatorSetCap {
+		return status, nil
+	}
+	slices.SortFunc(nextEpochVals, func(lhs, rhs *ctypes.Validator) int {
+		if c := cmp.Compare(lhs.GetEffectiveBalance(), rhs.GetEffectiveBalance()); c != 0 {
+			return c
+		}
+		lhsPk, rhsPk := lhs.GetPubkey(), rhs.GetPubkey()
+		return stdbytes.Compare(lhsPk[:], rhsPk[:])
+	})
+	ejected := status.ActiveValidators - validatorSetCap
+	status.CapThreshold = nextEpochVals[ejected].GetEffectiveBalance()
+	for _, v := range nextEpochVals[:ejected] {
+		if v.GetPubkey() == pubkey {
+			status.CappedOut = true
+		}
+	}
+	return status, nil
+}
+
+// printDepositStatus prints the deposit status in a human-readable form.
+func printDepositStatus(cmd *cobra.Command, cs chain.Spec, pubkey crypto.BLSPubkey, status *DepositStatus) {
+	cmd.Printf("Validator %s\n\n", pubkey)
+	if len(status.Deposits) == 0 {
+		cmd.Println("Deposits: none read from the deposit contract")
+	} else {
+		cmd.Println("Deposits:")
+	}
+	for _, d := range status.Deposits {
+		state := "queued"
+		if d.Included {
+			state = "included"
+		}
+		block := "genesis"
+		if d.ExecutionBlockNumber != "" {
+			block = "EL block " + d.ExecutionBlockNumber
+		}
+		cmd.Printf("  #%d: %s gwei, %s, %s\n", d.Index, d.Amount.Base10(), block, state)
+	}
+
+	if status.Validator == nil {
+		cmd.Println("\nValidator: not in the registry")
+	} else {
+		v := status.Validator
+		cmd.Printf("\nValidator index: %d\n", status.ValidatorIndex)
+		cmd.Printf("Status: %s\n", status.Status)
+		cmd.Printf("Effective balance: %s gwei\n", v.GetEffectiveBalance().Base10())
+		cmd.Printf("Activation epoch: %s\n", formatEpoch(v.GetActivationEpoch()))
+		cmd.Printf("Exit epoch: %s\n", formatEpoch(v.GetExitEpoch()))
+	}
+
+	cmd.Printf("\nValidator set: %d active next epoch, cap %d\n", status.ActiveValidators, cs.ValidatorSetCap())
+	if status.CapThreshold > 0 {
+		cmd.Printf("Lowest effective balance kept in the set: %s gwei\n", status.CapThreshold.Base10())
+	}
+	if status.CappedOut {
+		cmd.Println("⚠️ Validator is ejected at the next epoch to enforce the validator set cap")
+	}
+}
+
+// formatEpoch formats an epoch, showing the far future epoch as unset.
+func formatEpoch(epoch math.Epoch) string {
+	if epoch == constants.FarFutureEpoch {
+		return "not set"
+	}
+	return epoch.Base10()
+}
+
+// nodeAPIStatusSource reads the status from the node API.
+type nodeAPIStatusSource struct {
+	baseURL string
+}
+
+func (s *nodeAPIStatusSource) deposits(ctx context.Context, pubkey crypto.BLSPubkey) ([]*DepositRecord, error) {
+	var records []*DepositRecord
+	for start := uint64(0); ; start += depositsPageSize {
+		page, err := nodeAPIGet[[]*beacontypes.DepositData](
+			ctx, s.baseURL, fmt.Sprintf("/bkit/v1/deposits?start_index=%d&limit=%d", start, depositsPageSize),
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range page {
+			if d.Pubkey != pubkey.String() {
+				continue
+			}
+			records = append(records, &DepositRecord{
+				Index:                d.Index,
+				Amount:               math.Gwei(d.Amount),
+				ExecutionBlockNumber: d.ExecutionBlockNumber,
+			})
+		}
+		if len(page) < depositsPageSize {
+			return records, nil
+		}
+	}
+}
+
+func (s *nodeAPIStatusSource) headState(ctx context.Context) (math.Slot, uint64, ctypes.Validators, error) {
+	header, err := nodeAPIGet[beacontypes.BlockHeaderResponse](ctx, s.baseURL, "/eth/v1/beacon/headers/head")
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	if header.Header == nil || header.Header.Message == nil {
+		return 0, 0, nil, ErrNodeAPI
+	}
+	slot, err := strconv.ParseUint(header.Header.Message.Slot, 10, 64)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	root, err := nodeAPIGet[beacontypes.DepositRootData](ctx, s.baseURL, "/bkit/v1/deposits/root/head")
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	data, err := nodeAPIGet[[]*beacontypes.ValidatorData](ctx, s.baseURL, "/eth/v1/beacon/states/head/validators")
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	validators := make(ctypes.Validators, len(data))
+	for _, d := range data {
+		if d.Validator == nil || d.Index >= uint64(len(data)) {
+			return 0, 0, nil, ErrNodeAPI
+		}
+		if validators[d.Index], err = toValidator(d.Validator); err != nil {
+			return 0, 0, nil, err
+		}
+	}
+	return math.Slot(slot), root.DepositCount, validators, nil
+}
+
+// toValidator converts a validator of the node API to its beacon type.
+func toValidator(v *beacontypes.Validator) (*ctypes.Validator, error) {
+	val := &ctypes.Validator{Slashed: v.Slashed}
+	if err := val.Pubkey.UnmarshalText([]byte(v.PublicKey)); err != nil {
+		return nil, err
+	}
+	if err := val.WithdrawalCredentials.UnmarshalText([]byte(v.WithdrawalCredentials)); err != nil {
+		return nil, err
+	}
+	for _, field := range []struct {
+		value string
+		dst   *math.U64
+	}{
+		{v.EffectiveBalance, &val.EffectiveBalance},
+		{v.ActivationEligibilityEpoch, &val.ActivationEligibilityEpoch},
+		{v.ActivationEpoch, &val.ActivationEpoch},
+		{v.ExitEpoch, &val.ExitEpoch},
+		{v.WithdrawableEpoch, &val.WithdrawableEpoch},
+	} {
+		n, err := strconv.ParseUint(field.value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		*field.dst = math.U64(n)
+	}
+	return val, nil
+}
+
+// localStatusSource reads the status from the local databases.
+type localStatusSource struct {
+	db           dbm.DB
+	st           *statedb.StateDB
+	depositStore depositstore.StoreManager
+}
+
+// newLocalStatusSource opens the local databases, like the db-check command.
+func newLocalStatusSource(cmd *cobra.Command, appCreator servertypes.AppCreator) (*localStatusSource, error) {
+	logger := clicontext.GetLoggerFromCmd(cmd)
+	cfg := clicontext.GetConfigFromCmd(cmd)
+	database, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+	if err != nil {
+		return nil, err
+	}
+	app := appCreator(logger, database, nil, cfg, clicontext.GetViperFromCmd(cmd))
+	ctx := sdk.NewContext(
+		app.CommitMultiStore().CacheMultiStore(), false, servercmtlog.WrapSDKLogger(logger),
+	).WithContext(cmdContext(cmd))
+	return &localStatusSource{
+		db:           database,
+		st:           app.StorageBackend().StateFromContext(ctx),
+		depositStore: app.StorageBackend().DepositStore(),
+	}, nil
+}
+
+func (s *localStatusSource) deposits(ctx context.Context, pubkey crypto.BLSPubkey) ([]*DepositRecord, error) {
+	deposits, _, err := s.depositStore.GetDepositsByIndex(ctx, 0, stdmath.MaxUint64)
+	if err != nil {
+		return nil, err
+	}
+	var records []*DepositRecord
+	for _, d := range deposits {
+		if d.GetPubkey() != pubkey {
+			continue
+		}
+		record := &DepositRecord{Index: d.GetIndex().Unwrap(), Amount: d.GetAmount()}
+		blockNumber, errNumber := s.depositStore.GetDepositBlockNumber(ctx, record.Index)
+		switch {
+		case errNumber == nil:
+			record.ExecutionBlockNumber = strconv.FormatUint(blockNumber, 10)
+		case errors.Is(errNumber, collections.ErrNotFound):
+			// Genesis deposits are not read from an execution block.
+		default:
+			return nil, errNumber
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func (s *localStatusSource) headState(context.Context) (math.Slot, uint64, ctypes.Validators, error) {
+	slot, err := s.st.GetSlot()
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	eth1DepositIndex, err := s.st.GetEth1DepositIndex()
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	validators, err := s.st.GetValidators()
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	return slot, eth1DepositIndex, validators, nil
+}
+
+func (s *localStatusSource) close() {
+	_ = s.db.Close()
+}
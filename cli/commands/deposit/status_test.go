@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/cli/commands/deposit"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	"github.com/berachain/beacon-kit/config/spec"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeNodeAPIServer returns a node API serving a head state with the
+// given validator effective balances, all active, and two deposits of the
+// first validator, the first one included.
+func newFakeNodeAPIServer(t *testing.T, pubkeys []crypto.BLSPubkey, balances []uint64) *httptest.Server {
+	t.Helper()
+	validators := make([]*beacontypes.ValidatorData, len(pubkeys))
+	for i, pk := range pubkeys {
+		validators[i] = &beacontypes.ValidatorData{
+			ValidatorBalanceData: beacontypes.ValidatorBalanceData{Index: uint64(i), Balance: balances[i]},
+			Status:               constants.ValidatorStatusActiveOngoing,
+			Validator: &beacontypes.Validator{
+				PublicKey:                  pk.String(),
+				WithdrawalCredentials:      "0x0100000000000000000000000000000000000000000000000000000000000001",
+				EffectiveBalance:           strconv.FormatUint(balances[i], 10),
+				ActivationEligibilityEpoch: "0",
+				ActivationEpoch:            "0",
+				ExitEpoch:                  constants.FarFutureEpoch.Base10(),
+				WithdrawableEpoch:          constants.FarFutureEpoch.Base10(),
+			},
+		}
+	}
+	responses := map[string]any{
+		"/eth/v1/beacon/headers/head": &beacontypes.BlockHeaderResponse{
+			Header: &beacontypes.SignedBeaconBlockHeader{Message: &beacontypes.BeaconBlockHeader{Slot: "0"}},
+		},
+		"/bkit/v1/deposits/root/head":           &beacontypes.DepositRootData{DepositCount: 2},
+		"/eth/v1/beacon/states/head/validators": validators,
+		"/bkit/v1/deposits": []*beacontypes.DepositData{
+			{Index: 0, Pubkey: pubkeys[0].String(), Amount: balances[0]},
+			{Index: 1, Pubkey: pubkeys[1].String(), Amount: balances[1]},
+			{Index: 2, Pubkey: pubkeys[2].String(), Amount: balances[2]},
+			{Index: 3, Pubkey: pubkeys[0].String(), Amount: 1e9, ExecutionBlockNumber: "42"},
+		},
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		bz, err := json.Marshal(beacontypes.NewResponse(data, false))
+		require.NoError(t, err)
+		_, err = w.Write(bz)
+		require.NoError(t, err)
+	}))
+}
+
+func TestStatusReportsCappedOutValidator(t *testing.T) {
+	t.Parallel()
+	pubkeys := []crypto.BLSPubkey{{0x01}, {0x02}, {0x03}}
+	server := newFakeNodeAPIServer(t, pubkeys, []uint64{10e9, 30e9, 20e9})
+	defer server.Close()
+
+	specData := spec.DevnetChainSpecData()
+	specData.ValidatorSetCap = 2
+	cs, err := chain.NewSpec(specData)
+	require.NoError(t, err)
+	chainSpecCreator := func(servertypes.AppOptions) (chain.Spec, error) { return cs, nil }
+
+	var out bytes.Buffer
+	cmd := deposit.GetStatusCmd(chainSpecCreator, nil)
+	cmd.SetOut(&out)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs([]string{pubkeys[0].String(), "--node-api-url", server.URL})
+	require.NoError(t, cmd.ExecuteContext(context.Background()))
+
+	report := out.String()
+	require.Contains(t, report, "#0: 10000000000 gwei, genesis, included")
+	require.Contains(t, report, "#3: 1000000000 gwei, EL block 42, queued")
+	require.Contains(t, report, "Validator index: 0")
+	require.Contains(t, report, "Status: active_ongoing")
+	require.Contains(t, report, "Validator set: 3 active next epoch, cap 2")
+	require.Contains(t, report, "Lowest effective balance kept in the set: 20000000000 gwei")
+	require.Contains(t, report, "ejected at the next epoch")
+
+	out.Reset()
+	cmd = deposit.GetStatusCmd(chainSpecCreator, nil)
+	cmd.SetOut(&out)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs([]string{pubkeys[1].String(), "--node-api-url", server.URL})
+	require.NoError(t, cmd.ExecuteContext(context.Background()))
+	require.Contains(t, out.String(), "Validator index: 1")
+	require.NotContains(t, out.String(), "ejected")
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	clitypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/cli/utils/parser"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	depositcontract "github.com/berachain/beacon-kit/gethlib/deposit"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/spf13/cobra"
+)
+
+const (
+	topUpPubkey0 = iota
+	topUpAmt1    = iota
+
+	numArgsTopUp = 2
+
+	withdrawalAddress = "withdrawal-address"
+)
+
+// GetTopUpCmd returns a command to top up the balance of an existing
+// validator through the deposit contract.
+//
+//nolint:lll // Reads better if long description is one line.
+func GetTopUpCmd(chainSpecCreator clitypes.ChainSpecCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-up [validator-pubkey] [amount]",
+		Short: "Creates a deposit transaction topping up an existing validator",
+		Long:  `Creates a transaction depositing the given amount in gwei to the deposit contract for a validator which already made its initial deposit. The withdrawal credentials are read from the validator at the node API head state, or built from --withdrawal-address. Top-up deposits are not signed, as the beacon chain only verifies the signature of the deposit creating a validator. The transaction is signed with --private-key, or output unsigned for the --from address otherwise, and sent with --broadcast.`,
+		Args:  cobra.ExactArgs(numArgsTopUp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainSpec, err := chainSpecCreator(clicontext.GetViperFromCmd(cmd))
+			if err != nil {
+				return err
+			}
+			pubkey, err := parser.ConvertPubkey(args[topUpPubkey0])
+			if err != nil {
+				return err
+			}
+			amount, err := parser.ConvertAmount(args[topUpAmt1])
+			if err != nil {
+				return err
+			}
+			credentials, err := topUpCredentials(cmd, pubkey)
+			if err != nil {
+				return err
+			}
+
+			contractABI, err := depositcontract.DepositContractMetaData.GetAbi()
+			if err != nil {
+				return err
+			}
+			// The operator is set by the initial deposit and must be zero after.
+			var signature crypto.BLSSignature
+			data, err := contractABI.Pack(
+				"deposit", pubkey[:], credentials[:], signature[:], gethcommon.Address{},
+			)
+			if err != nil {
+				return err
+			}
+
+			value := new(big.Int).Mul(new(big.Int).SetUint64(amount.Unwrap()), big.NewInt(params.GWei))
+			_, err = sendContractTx(cmd, &contractTx{
+				to:   gethcommon.Address(chainSpec.DepositContractAddress()),
+				data: data,
+				value: func(context.Context, feeClient) (*big.Int, error) {
+					return value, nil
+				},
+			})
+			return err
+		},
+	}
+	addTxFlags(cmd)
+	cmd.Flags().String(
+		withdrawalAddress, "", "withdrawal address of the validator, read from the node API if not set",
+	)
+	return cmd
+}
+
+// topUpCredentials returns the withdrawal credentials of the top-up deposit,
+// built from the withdrawal address flag or read from the node API.
+func topUpCredentials(cmd *cobra.Command, pubkey crypto.BLSPubkey) (types.WithdrawalCredentials, error) {
+	addr, err := cmd.Flags().GetString(withdrawalAddress)
+	if err != nil {
+		return types.WithdrawalCredentials{}, err
+	}
+	if addr != "" {
+		var executionAddress common.ExecutionAddress
+		if err = executionAddress.UnmarshalText([]byte(addr)); err != nil {
+			return types.WithdrawalCredentials{}, err
+		}
+		return types.NewCredentialsFromExecutionAddress(executionAddress), nil
+	}
+
+	baseURL, err := cmd.Flags().GetString(nodeAPIURL)
+	if err != nil {
+		return types.WithdrawalCredentials{}, err
+	}
+	validator, err := nodeAPIGet[beacontypes.ValidatorData](
+		cmdContext(cmd), baseURL, "/eth/v1/beacon/states/head/validators/"+pubkey.String(),
+	)
+	if err != nil {
+		return types.WithdrawalCredentials{}, fmt.Errorf(
+			"failed reading validator credentials, set --%s to top up a validator not yet in the registry: %w",
+			withdrawalAddress, err,
+		)
+	}
+	if validator.Validator == nil {
+		return types.WithdrawalCredentials{}, ErrNodeAPI
+	}
+	var credentials types.WithdrawalCredentials
+	err = credentials.UnmarshalText([]byte(validator.Validator.WithdrawalCredentials))
+	return credentials, err
+}
@@ -0,0 +1,299 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/ethereum/go-ethereum"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+)
+
+const (
+	rpcURL      = "rpc-url"
+	privateKey  = "private-key"
+	fromAddress = "from"
+	gasLimit    = "gas-limit"
+	broadcast   = "broadcast"
+	nodeAPIURL  = "node-api-url"
+	pollTimeout = "poll-timeout"
+
+	defaultRPCURL      = "http://localhost:8545"
+	defaultNodeAPIURL  = "http://localhost:3500"
+	defaultPollTimeout = 2 * time.Minute
+
+	// pollInterval is the interval between two polls of the EL or the node API.
+	pollInterval = 2 * time.Second
+)
+
+// addTxFlags adds the flags shared by the commands sending execution layer
+// transactions.
+func addTxFlags(cmd *cobra.Command) {
+	cmd.Flags().String(rpcURL, defaultRPCURL, "execution layer JSON-RPC URL")
+	cmd.Flags().String(
+		privateKey, "",
+		"hex-encoded execution layer private key signing the transaction. If not set, the unsigned transaction is output",
+	)
+	cmd.Flags().String(fromAddress, "", "sender address of the unsigned transaction, required if no private key is set")
+	cmd.Flags().Uint64(gasLimit, 0, "gas limit of the transaction, estimated if not set")
+	cmd.Flags().Bool(broadcast, false, "broadcast the signed transaction and wait for its inclusion")
+	cmd.Flags().String(nodeAPIURL, defaultNodeAPIURL, "beacon node API URL polled once the transaction is included")
+	cmd.Flags().Duration(
+		pollTimeout, defaultPollTimeout, "how long to wait for the transaction to be included and processed, 0 to not wait",
+	)
+}
+
+// contractTx describes a transaction to an EIP-7685 system contract or to the
+// deposit contract.
+type contractTx struct {
+	// to is the address of the contract.
+	to gethcommon.Address
+	// data is the transaction calldata.
+	data []byte
+	// value returns the value of the transaction, e.g. the current request
+	// fee of a system contract.
+	value func(ctx context.Context, client feeClient) (*big.Int, error)
+}
+
+// feeClient adapts the go-ethereum client to the request fee helpers.
+type feeClient struct {
+	*ethclient.Client
+}
+
+// Call performs a JSON-RPC call with the given method and params.
+func (c feeClient) Call(ctx context.Context, target any, method string, params ...any) error {
+	return c.Client.Client().CallContext(ctx, target, method, params...)
+}
+
+// sendContractTx builds the transaction and either outputs it, unsigned or
+// signed, or broadcasts it and waits for its receipt. It returns the receipt
+// of the broadcast transaction, or nil if it was not broadcast.
+//
+//nolint:funlen,gocognit // reads better in one place.
+func sendContractTx(cmd *cobra.Command, req *contractTx) (*gethtypes.Receipt, error) {
+	ctx := cmdContext(cmd)
+	url, err := cmd.Flags().GetString(rpcURL)
+	if err != nil {
+		return nil, err
+	}
+	keyHex, err := cmd.Flags().GetString(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	from, err := cmd.Flags().GetString(fromAddress)
+	if err != nil {
+		return nil, err
+	}
+	gas, err := cmd.Flags().GetUint64(gasLimit)
+	if err != nil {
+		return nil, err
+	}
+	shouldBroadcast, err := cmd.Flags().GetBool(broadcast)
+	if err != nil {
+		return nil, err
+	}
+	timeout, err := cmd.Flags().GetDuration(pollTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		key    *ecdsa.PrivateKey
+		sender gethcommon.Address
+	)
+	switch {
+	case keyHex != "":
+		if key, err = ethcrypto.HexToECDSA(strings.TrimPrefix(keyHex, "0x")); err != nil {
+			return nil, fmt.Errorf("invalid private key: %w", err)
+		}
+		sender = ethcrypto.PubkeyToAddress(key.PublicKey)
+	case shouldBroadcast:
+		return nil, ErrPrivateKeyRequired
+	case gethcommon.IsHexAddress(from):
+		sender = gethcommon.HexToAddress(from)
+	default:
+		return nil, ErrSenderRequired
+	}
+
+	client, err := ethclient.DialContext(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed dialing %s: %w", url, err)
+	}
+	defer client.Close()
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	value, err := req.value(ctx, feeClient{client})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting transaction value: %w", err)
+	}
+	nonce, err := client.PendingNonceAt(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+	tip, err := client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if gas == 0 {
+		gas, err = client.EstimateGas(ctx, ethereum.CallMsg{
+			From: sender, To: &req.to, Value: value, Data: req.data,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed estimating gas: %w", err)
+		}
+	}
+
+	txData := &gethtypes.DynamicFeeTx{
+		ChainID: chainID,
+		Nonce:   nonce,
+		To:      &req.to,
+		Gas:     gas,
+		// Leave headroom for the base fee to rise before inclusion.
+		GasFeeCap: new(big.Int).Mul(gasPrice, big.NewInt(2)), //nolint:mnd // 2x.
+		GasTipCap: tip,
+		Value:     value,
+		Data:      req.data,
+	}
+	cmd.Printf("Transaction value: %s wei\n", value)
+
+	if key == nil {
+		bz, errJSON := json.MarshalIndent(gethtypes.NewTx(txData), "", "  ")
+		if errJSON != nil {
+			return nil, errJSON
+		}
+		cmd.Println("Unsigned transaction:")
+		_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s\n", bz)
+		return nil, err
+	}
+
+	tx, err := gethtypes.SignNewTx(key, gethtypes.NewPragueSigner(chainID), txData)
+	if err != nil {
+		return nil, err
+	}
+	if !shouldBroadcast {
+		raw, errRaw := tx.MarshalBinary()
+		if errRaw != nil {
+			return nil, errRaw
+		}
+		cmd.Println("Signed transaction:")
+		_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s\n", hexutil.Encode(raw))
+		return nil, err
+	}
+
+	if err = client.SendTransaction(ctx, tx); err != nil {
+		return nil, fmt.Errorf("failed sending transaction: %w", err)
+	}
+	cmd.Printf("Transaction sent: %s\n", tx.Hash())
+	if timeout == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	receipt, err := poll(ctx, func(ctx context.Context) (*gethtypes.Receipt, bool, error) {
+		r, errReceipt := client.TransactionReceipt(ctx, tx.Hash())
+		if errors.Is(errReceipt, ethereum.NotFound) {
+			return nil, false, nil
+		}
+		return r, errReceipt == nil, errReceipt
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed waiting for transaction: %w", err)
+	}
+	if receipt.Status != gethtypes.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("%w: %s", ErrTxFailed, tx.Hash())
+	}
+	cmd.Printf("Transaction included in block %s\n", receipt.BlockNumber)
+	return receipt, nil
+}
+
+// cmdContext returns the context of the command, or a background context if
+// the command was not executed with one.
+func cmdContext(cmd *cobra.Command) context.Context {
+	if ctx := cmd.Context(); ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
+
+// poll calls fn every pollInterval until it reports done, fails or ctx is
+// done.
+func poll[T any](ctx context.Context, fn func(context.Context) (T, bool, error)) (T, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		res, done, err := fn(ctx)
+		if err != nil || done {
+			return res, err
+		}
+		select {
+		case <-ctx.Done():
+			return res, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// nodeAPIGet returns the data of the given beacon node API path.
+func nodeAPIGet[T any](ctx context.Context, baseURL, path string) (T, error) {
+	var res struct {
+		Data T `json:"data"`
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(baseURL, "/")+path, nil)
+	if err != nil {
+		return res.Data, err
+	}
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return res.Data, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return res.Data, fmt.Errorf("%w: GET %s returned %s", ErrNodeAPI, path, resp.Status)
+	}
+	bz, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return res.Data, err
+	}
+	err = json.Unmarshal(bz, &res)
+	return res.Data, err
+}
@@ -0,0 +1,578 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"path/filepath"
+
+	gentypes "github.com/berachain/beacon-kit/cli/commands/genesis/types"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	"github.com/berachain/beacon-kit/cli/context"
+	beaconflags "github.com/berachain/beacon-kit/cli/flags"
+	"github.com/berachain/beacon-kit/cli/utils/genesis"
+	"github.com/berachain/beacon-kit/cli/utils/parser"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/afero"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagEthGenesisOutput is the path the final eth genesis is written to.
+	flagEthGenesisOutput = "eth-genesis-output"
+	// flagSummaryOutput is the path the ceremony summary is written to.
+	flagSummaryOutput = "summary-output"
+
+	// chainSpecFile is the chain spec flag value selecting a chain spec file.
+	chainSpecFile = "file"
+)
+
+var (
+	// ErrChainSpecMismatch is returned when the local chain spec is not the
+	// one of the ceremony.
+	ErrChainSpecMismatch = errors.New("chain spec does not match the ceremony")
+	// ErrDepositContractNotInAlloc is returned when the eth genesis does not
+	// allocate the deposit contract.
+	ErrDepositContractNotInAlloc = errors.New("deposit contract not in eth genesis alloc")
+	// ErrDuplicateCeremonyDeposit is returned when a validator deposits twice
+	// in a ceremony.
+	ErrDuplicateCeremonyDeposit = errors.New("validator already deposited in ceremony")
+	// ErrNoCeremonyDeposits is returned when finalizing a ceremony without
+	// deposits.
+	ErrNoCeremonyDeposits = errors.New("ceremony has no deposits")
+	// ErrCeremonySummaryMismatch is returned when the summary computed from a
+	// ceremony differs from the published one.
+	ErrCeremonySummaryMismatch = errors.New("ceremony summary mismatch")
+)
+
+// Ceremony holds the inputs of a genesis ceremony. It is created by the
+// coordinator, passed to the participants which add their deposits, and
+// finalized by the coordinator into the genesis files of the network.
+type Ceremony struct {
+	// ChainSpec is the chain spec flag value of the network.
+	ChainSpec string `json:"chain_spec"`
+	// ChainSpecFile is the content of the chain spec file, if ChainSpec is
+	// "file".
+	ChainSpecFile string `json:"chain_spec_file,omitempty"`
+	// GenesisForkVersion is the genesis fork version deposits are signed
+	// with.
+	GenesisForkVersion common.Version `json:"genesis_fork_version"`
+	// DepositContractAddress is the address of the deposit contract.
+	DepositContractAddress common.ExecutionAddress `json:"deposit_contract_address"`
+	// Genesis is the CometBFT genesis created by the coordinator, without
+	// deposits and execution payload header.
+	Genesis json.RawMessage `json:"genesis"`
+	// EthGenesis is the eth genesis, without deposit contract storage.
+	EthGenesis json.RawMessage `json:"eth_genesis"`
+	// Deposits are the signed deposits of the participants.
+	Deposits types.Deposits `json:"deposits"`
+}
+
+// CeremonySummary holds the hashes identifying the outputs of a ceremony.
+// Since finalizing a ceremony is deterministic, participants can recompute
+// it to verify the genesis files they are given.
+type CeremonySummary struct {
+	// ChainID is the CometBFT chain ID.
+	ChainID string `json:"chain_id"`
+	// CeremonyHash is the hash of the ceremony.
+	CeremonyHash common.Root `json:"ceremony_hash"`
+	// NumDeposits is the number of genesis deposits.
+	NumDeposits int `json:"num_deposits"`
+	// DepositsRoot is the root of the genesis deposits.
+	DepositsRoot common.Root `json:"deposits_root"`
+	// GenesisValidatorsRoot is the root of the genesis validators.
+	GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
+	// ExecutionBlockHash is the hash of the eth genesis block.
+	ExecutionBlockHash common.ExecutionHash `json:"execution_block_hash"`
+	// GenesisHash is the SHA-256 hash of the CometBFT genesis file.
+	GenesisHash common.Root `json:"genesis_hash"`
+	// EthGenesisHash is the SHA-256 hash of the eth genesis file.
+	EthGenesisHash common.Root `json:"eth_genesis_hash"`
+}
+
+// CeremonyResult holds the genesis files produced by a ceremony.
+type CeremonyResult struct {
+	// Genesis is the CometBFT genesis file.
+	Genesis []byte
+	// EthGenesis is the eth genesis file.
+	EthGenesis []byte
+	// Summary identifies the genesis files.
+	Summary *CeremonySummary
+}
+
+// CeremonyCmd returns the command suite running a genesis ceremony.
+//
+//nolint:lll // reads better if long description is one line.
+func CeremonyCmd(chainSpecCreator servertypes.ChainSpecCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ceremony",
+		Short: "runs a genesis ceremony",
+		Long: `Runs a genesis ceremony creating a new network:
+  1. the coordinator runs "beacond init" and creates the ceremony file from its genesis and the eth genesis with "new";
+  2. each participant adds a signed deposit to the ceremony file with "add-deposit", which does not need network access;
+  3. the coordinator verifies the deposits and writes the genesis files and their summary with "finalize";
+  4. participants check the genesis files against the ceremony with "verify".`,
+		RunE: client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		NewCeremonyCmd(chainSpecCreator),
+		AddCeremonyDepositCmd(chainSpecCreator),
+		FinalizeCeremonyCmd(chainSpecCreator),
+		VerifyCeremonyCmd(chainSpecCreator),
+	)
+	return cmd
+}
+
+// NewCeremonyCmd returns the command creating a ceremony file.
+//
+//nolint:lll // reads better if long description is one line.
+func NewCeremonyCmd(chainSpecCreator servertypes.ChainSpecCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "new [eth/genesis/file.json] [ceremony.json]",
+		Short: "creates a genesis ceremony file",
+		Long:  `Creates a genesis ceremony file from the CometBFT genesis in the BEACOND_HOME directory, the given eth genesis and the configured chain spec.`,
+		Args:  cobra.ExactArgs(2), //nolint:mnd // The number of arguments.
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := context.GetConfigFromCmd(cmd)
+			appOpts := context.GetViperFromCmd(cmd)
+			chainSpec, err := chainSpecCreator(appOpts)
+			if err != nil {
+				return err
+			}
+			genesisBz, err := afero.ReadFile(afero.NewOsFs(), config.GenesisFile())
+			if err != nil {
+				return errors.Wrap(err, "failed to read genesis file")
+			}
+			ethGenesisBz, err := afero.ReadFile(afero.NewOsFs(), args[0])
+			if err != nil {
+				return errors.Wrap(err, "failed to read eth1 genesis file")
+			}
+
+			ceremony, err := NewCeremony(chainSpec, genesisBz, ethGenesisBz)
+			if err != nil {
+				return err
+			}
+			ceremony.ChainSpec = cast.ToString(appOpts.Get(beaconflags.ChainSpec))
+			if ceremony.ChainSpec == chainSpecFile {
+				var specBz []byte
+				specBz, err = afero.ReadFile(afero.NewOsFs(), cast.ToString(appOpts.Get(beaconflags.ChainSpecFilePath)))
+				if err != nil {
+					return errors.Wrap(err, "failed to read chain spec file")
+				}
+				ceremony.ChainSpecFile = string(specBz)
+			}
+			return writeCeremony(args[1], ceremony)
+		},
+	}
+}
+
+// AddCeremonyDepositCmd returns the command adding the deposit of the local
+// validator to a ceremony file.
+//
+//nolint:lll // reads better if long description is one line.
+func AddCeremonyDepositCmd(chainSpecCreator servertypes.ChainSpecCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "add-deposit [ceremony.json] [amount] [withdrawal-address]",
+		Short: "adds the deposit of the local validator to a ceremony file",
+		Long:  `Signs a deposit of the given amount with the validator key of this node and adds it to the ceremony file. The deposit is signed offline, no network access is needed.`,
+		Args:  cobra.ExactArgs(3), //nolint:mnd // The number of arguments.
+		RunE: func(cmd *cobra.Command, args []string) error {
+			amount, err := parser.ConvertAmount(args[1])
+			if err != nil {
+				return err
+			}
+			withdrawalAddress, err := common.NewExecutionAddressFromHex(args[2])
+			if err != nil {
+				return err
+			}
+			appOpts := context.GetViperFromCmd(cmd)
+			chainSpec, err := chainSpecCreator(appOpts)
+			if err != nil {
+				return err
+			}
+			blsSigner, err := components.ProvideBlsSigner(
+				components.BlsSignerInput{
+					AppOpts: appOpts,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			ceremony, err := readCeremony(args[0])
+			if err != nil {
+				return err
+			}
+			deposit, err := ceremony.AddDeposit(chainSpec, blsSigner, amount, withdrawalAddress)
+			if err != nil {
+				return err
+			}
+			if err = writeCeremony(args[0], ceremony); err != nil {
+				return err
+			}
+			cmd.Printf("Added deposit of %s to the ceremony\n", deposit.Pubkey)
+			return nil
+		},
+	}
+}
+
+// FinalizeCeremonyCmd returns the command writing the genesis files of a
+// ceremony.
+//
+//nolint:lll // reads better if long description is one line.
+func FinalizeCeremonyCmd(chainSpecCreator servertypes.ChainSpecCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize [ceremony.json]",
+		Short: "verifies the ceremony deposits and writes the genesis files",
+		Long:  `Verifies the signatures of the ceremony deposits, writes the CometBFT genesis in the BEACOND_HOME directory, the eth genesis with the deposit contract storage set, and the ceremony summary participants verify the genesis files with.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := context.GetConfigFromCmd(cmd)
+			chainSpec, err := chainSpecCreator(context.GetViperFromCmd(cmd))
+			if err != nil {
+				return err
+			}
+			ceremony, err := readCeremony(args[0])
+			if err != nil {
+				return err
+			}
+			result, err := FinalizeCeremony(chainSpec, ceremony)
+			if err != nil {
+				return err
+			}
+			summaryBz, err := json.MarshalIndent(result.Summary, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			ethGenesisOutput, _ := cmd.Flags().GetString(flagEthGenesisOutput)
+			if ethGenesisOutput == "" {
+				ethGenesisOutput = filepath.Join(config.RootDir, "eth-genesis.json")
+			}
+			summaryOutput, _ := cmd.Flags().GetString(flagSummaryOutput)
+			if summaryOutput == "" {
+				summaryOutput = filepath.Join(config.RootDir, "ceremony-summary.json")
+			}
+			fs := afero.NewOsFs()
+			//nolint:mnd // file permissions.
+			for path, bz := range map[string][]byte{
+				config.GenesisFile(): result.Genesis,
+				ethGenesisOutput:     result.EthGenesis,
+				summaryOutput:        summaryBz,
+			} {
+				if err = afero.WriteFile(fs, path, bz, 0o644); err != nil {
+					return errors.Wrapf(err, "failed to write %s", path)
+				}
+			}
+
+			cmd.Printf("%s\n", summaryBz)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagEthGenesisOutput, "", "path the eth genesis is written to, defaults to BEACOND_HOME/eth-genesis.json")
+	cmd.Flags().String(flagSummaryOutput, "", "path the ceremony summary is written to, defaults to BEACOND_HOME/ceremony-summary.json")
+	return cmd
+}
+
+// VerifyCeremonyCmd returns the command checking a ceremony summary against
+// the ceremony file.
+//
+//nolint:lll // reads better if long description is one line.
+func VerifyCeremonyCmd(chainSpecCreator servertypes.ChainSpecCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify [ceremony.json] [ceremony-summary.json]",
+		Short: "verifies a ceremony summary",
+		Long:  `Finalizes the ceremony locally and checks that the resulting genesis files match the given summary. The hashes in the summary are the SHA-256 hashes of the genesis files.`,
+		Args:  cobra.ExactArgs(2), //nolint:mnd // The number of arguments.
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainSpec, err := chainSpecCreator(context.GetViperFromCmd(cmd))
+			if err != nil {
+				return err
+			}
+			ceremony, err := readCeremony(args[0])
+			if err != nil {
+				return err
+			}
+			summaryBz, err := afero.ReadFile(afero.NewOsFs(), args[1])
+			if err != nil {
+				return errors.Wrap(err, "failed to read ceremony summary")
+			}
+			published := &CeremonySummary{}
+			if err = json.Unmarshal(summaryBz, published); err != nil {
+				return errors.Wrap(err, "failed to unmarshal ceremony summary")
+			}
+
+			result, err := FinalizeCeremony(chainSpec, ceremony)
+			if err != nil {
+				return err
+			}
+			if *result.Summary != *published {
+				computedBz, _ := json.MarshalIndent(result.Summary, "", "  ")
+				return fmt.Errorf("%w, computed summary:\n%s", ErrCeremonySummaryMismatch, computedBz)
+			}
+			cmd.Println("Ceremony summary verified")
+			return nil
+		},
+	}
+}
+
+// NewCeremony creates a ceremony from the CometBFT and eth genesis files.
+func NewCeremony(cs ChainSpec, genesisBz, ethGenesisBz []byte) (*Ceremony, error) {
+	if _, err := genutiltypes.AppGenesisFromReader(bytes.NewReader(genesisBz)); err != nil {
+		return nil, errors.Wrap(err, "failed to read genesis doc")
+	}
+	if _, err := executionPayloadHeaderFromGenesis(cs, ethGenesisBz); err != nil {
+		return nil, err
+	}
+	elGenesis := &gentypes.DefaultEthGenesisJSON{}
+	if err := json.Unmarshal(ethGenesisBz, elGenesis); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal eth1 genesis")
+	}
+	if _, ok := elGenesis.Alloc()[gethcommon.Address(cs.DepositContractAddress())]; !ok {
+		return nil, ErrDepositContractNotInAlloc
+	}
+
+	return &Ceremony{
+		GenesisForkVersion:     cs.GenesisForkVersion(),
+		DepositContractAddress: cs.DepositContractAddress(),
+		Genesis:                genesisBz,
+		EthGenesis:             ethGenesisBz,
+		Deposits:               types.Deposits{},
+	}, nil
+}
+
+// AddDeposit signs a deposit with the given signer and adds it to the
+// ceremony.
+func (c *Ceremony) AddDeposit(
+	cs ChainSpec,
+	blsSigner crypto.BLSSigner,
+	amount math.Gwei,
+	withdrawalAddress common.ExecutionAddress,
+) (*types.Deposit, error) {
+	if err := c.checkChainSpec(cs); err != nil {
+		return nil, err
+	}
+	pubkey := blsSigner.PublicKey()
+	for _, deposit := range c.Deposits {
+		if deposit.Pubkey == pubkey {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateCeremonyDeposit, pubkey)
+		}
+	}
+
+	depositMsg, signature, err := types.CreateAndSignDepositMessage(
+		types.NewForkData(c.GenesisForkVersion, common.Root{}),
+		cs.DomainTypeDeposit(),
+		blsSigner,
+		types.NewCredentialsFromExecutionAddress(withdrawalAddress),
+		amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	deposit := &types.Deposit{
+		Pubkey:      depositMsg.Pubkey,
+		Credentials: depositMsg.Credentials,
+		Amount:      depositMsg.Amount,
+		Signature:   signature,
+	}
+	if err = c.verifyDeposit(cs, deposit); err != nil {
+		return nil, err
+	}
+	c.Deposits = append(c.Deposits, deposit)
+	return deposit, nil
+}
+
+// FinalizeCeremony verifies the ceremony deposits and produces the genesis
+// files of the network. It is deterministic, so that participants can check
+// the genesis files they are given.
+func FinalizeCeremony(cs ChainSpec, c *Ceremony) (*CeremonyResult, error) {
+	if err := c.checkChainSpec(cs); err != nil {
+		return nil, err
+	}
+	if len(c.Deposits) == 0 {
+		return nil, ErrNoCeremonyDeposits
+	}
+	ceremonyBz, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify the deposits and index them in ceremony order.
+	deposits := make(types.Deposits, len(c.Deposits))
+	seen := make(map[crypto.BLSPubkey]struct{}, len(c.Deposits))
+	for i, deposit := range c.Deposits {
+		if _, ok := seen[deposit.Pubkey]; ok {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateCeremonyDeposit, deposit.Pubkey)
+		}
+		seen[deposit.Pubkey] = struct{}{}
+		if err = c.verifyDeposit(cs, deposit); err != nil {
+			return nil, fmt.Errorf("deposit %d: %w", i, err)
+		}
+		indexed := *deposit
+		indexed.Index = uint64(i) // #nosec G115 -- won't realistically overflow.
+		deposits[i] = &indexed
+	}
+
+	// Set the deposit contract storage in the eth genesis.
+	elGenesis := &gentypes.DefaultEthGenesisJSON{}
+	if err = json.Unmarshal(c.EthGenesis, elGenesis); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal eth1 genesis")
+	}
+	depositAddr := gethcommon.Address(cs.DepositContractAddress())
+	allocs := writeDepositStorage(elGenesis, depositAddr, big.NewInt(int64(len(deposits))), deposits.HashTreeRoot())
+	ethGenesisBz, err := setDepositContractAlloc(c.EthGenesis, depositAddr, allocs, gentypes.DefaultAllocsKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to set deposit contract storage")
+	}
+
+	// Set the deposits and the execution payload header in the genesis.
+	eph, err := executionPayloadHeaderFromGenesis(cs, ethGenesisBz)
+	if err != nil {
+		return nil, err
+	}
+	appGenesis, err := genutiltypes.AppGenesisFromReader(bytes.NewReader(c.Genesis))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read genesis doc")
+	}
+	if err = setBeaconGenesis(appGenesis, deposits, eph); err != nil {
+		return nil, err
+	}
+	if err = appGenesis.ValidateAndComplete(); err != nil {
+		return nil, err
+	}
+	// Marshalled as by AppGenesis.SaveAs.
+	genesisBz, err := json.MarshalIndent(appGenesis, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return &CeremonyResult{
+		Genesis:    genesisBz,
+		EthGenesis: ethGenesisBz,
+		Summary: &CeremonySummary{
+			ChainID:               appGenesis.ChainID,
+			CeremonyHash:          sha256.Sum256(ceremonyBz),
+			NumDeposits:           len(deposits),
+			DepositsRoot:          deposits.HashTreeRoot(),
+			GenesisValidatorsRoot: genesis.ComputeValidatorsRoot(deposits, cs),
+			ExecutionBlockHash:    eph.BlockHash,
+			GenesisHash:           sha256.Sum256(genesisBz),
+			EthGenesisHash:        sha256.Sum256(ethGenesisBz),
+		},
+	}, nil
+}
+
+// checkChainSpec checks that the ceremony is run with the given chain spec.
+func (c *Ceremony) checkChainSpec(cs ChainSpec) error {
+	if c.GenesisForkVersion != cs.GenesisForkVersion() {
+		return fmt.Errorf(
+			"%w: genesis fork version %s, expected %s",
+			ErrChainSpecMismatch, cs.GenesisForkVersion(), c.GenesisForkVersion,
+		)
+	}
+	if c.DepositContractAddress != cs.DepositContractAddress() {
+		return fmt.Errorf(
+			"%w: deposit contract address %s, expected %s",
+			ErrChainSpecMismatch, cs.DepositContractAddress(), c.DepositContractAddress,
+		)
+	}
+	return nil
+}
+
+// verifyDeposit verifies the signature of a ceremony deposit.
+func (c *Ceremony) verifyDeposit(cs ChainSpec, deposit *types.Deposit) error {
+	depositMsg := &types.DepositMessage{
+		Pubkey:      deposit.Pubkey,
+		Credentials: deposit.Credentials,
+		Amount:      deposit.Amount,
+	}
+	return depositMsg.VerifyCreateValidator(
+		types.NewForkData(c.GenesisForkVersion, common.Root{}),
+		deposit.Signature,
+		cs.DomainTypeDeposit(),
+		signer.BLSSigner{}.VerifySignature,
+	)
+}
+
+// setBeaconGenesis sets the deposits and the execution payload header of the
+// beacon genesis.
+func setBeaconGenesis(
+	appGenesis *genutiltypes.AppGenesis,
+	deposits types.Deposits,
+	eph *types.ExecutionPayloadHeader,
+) error {
+	appGenesisState, err := genutiltypes.GenesisStateFromAppGenesis(appGenesis)
+	if err != nil {
+		return err
+	}
+	if appGenesisState == nil {
+		appGenesisState = make(map[string]json.RawMessage)
+	}
+
+	genesisInfo := &types.Genesis{}
+	if err = json.Unmarshal(appGenesisState["beacon"], genesisInfo); err != nil {
+		return errors.Wrap(err, "failed to unmarshal beacon genesis")
+	}
+	genesisInfo.Deposits = deposits
+	genesisInfo.ExecutionPayloadHeader = eph
+
+	if appGenesisState["beacon"], err = json.Marshal(genesisInfo); err != nil {
+		return errors.Wrap(err, "failed to marshal beacon genesis")
+	}
+	appGenesis.AppState, err = json.MarshalIndent(appGenesisState, "", "  ")
+	return err
+}
+
+// readCeremony reads a ceremony file.
+func readCeremony(path string) (*Ceremony, error) {
+	bz, err := afero.ReadFile(afero.NewOsFs(), path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read ceremony file")
+	}
+	ceremony := &Ceremony{}
+	if err = json.Unmarshal(bz, ceremony); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ceremony file")
+	}
+	return ceremony, nil
+}
+
+// writeCeremony writes a ceremony file.
+func writeCeremony(path string, ceremony *Ceremony) error {
+	bz, err := json.MarshalIndent(ceremony, "", "  ")
+	if err != nil {
+		return err
+	}
+	return afero.WriteFile(afero.NewOsFs(), path, bz, 0o644) //nolint:mnd // file permissions.
+}
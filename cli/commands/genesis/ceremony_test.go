@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/genesis"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisCeremony(t *testing.T) {
+	t.Parallel()
+	chainSpec, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	ethGenesisBz, err := os.ReadFile("../../../testing/files/eth-genesis.json")
+	require.NoError(t, err)
+	genesisBz, err := json.Marshal(map[string]any{
+		"chain_id": "beacond-ceremony",
+		"app_state": map[string]any{
+			"beacon": map[string]any{
+				"fork_version": chainSpec.GenesisForkVersion(),
+				"deposits":     []any{},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	ceremony, err := genesis.NewCeremony(chainSpec, genesisBz, ethGenesisBz)
+	require.NoError(t, err)
+
+	// Finalizing requires deposits.
+	_, err = genesis.FinalizeCeremony(chainSpec, ceremony)
+	require.ErrorIs(t, err, genesis.ErrNoCeremonyDeposits)
+
+	withdrawalAddress, err := common.NewExecutionAddressFromHex("0x981114102592310C347E61368342DDA67017bf84")
+	require.NoError(t, err)
+	amount := math.Gwei(250_000 * params.GWei)
+	signers := []signer.BLSSigner{
+		{PrivValidator: cmttypes.NewMockPVWithKeyType(bls12381.KeyType)},
+		{PrivValidator: cmttypes.NewMockPVWithKeyType(bls12381.KeyType)},
+	}
+	for _, blsSigner := range signers {
+		_, err = ceremony.AddDeposit(chainSpec, blsSigner, amount, withdrawalAddress)
+		require.NoError(t, err)
+	}
+	_, err = ceremony.AddDeposit(chainSpec, signers[0], amount, withdrawalAddress)
+	require.ErrorIs(t, err, genesis.ErrDuplicateCeremonyDeposit)
+
+	// Participants can round trip the ceremony file.
+	ceremonyBz, err := json.Marshal(ceremony)
+	require.NoError(t, err)
+	ceremony = &genesis.Ceremony{}
+	require.NoError(t, json.Unmarshal(ceremonyBz, ceremony))
+
+	result, err := genesis.FinalizeCeremony(chainSpec, ceremony)
+	require.NoError(t, err)
+	require.Equal(t, "beacond-ceremony", result.Summary.ChainID)
+	require.Equal(t, 2, result.Summary.NumDeposits)
+	require.NotEmpty(t, result.Genesis)
+	require.NotEmpty(t, result.EthGenesis)
+
+	// Finalizing is reproducible.
+	again, err := genesis.FinalizeCeremony(chainSpec, ceremony)
+	require.NoError(t, err)
+	require.Equal(t, result.Summary, again.Summary)
+	require.Equal(t, result.Genesis, again.Genesis)
+	require.Equal(t, result.EthGenesis, again.EthGenesis)
+
+	// A tampered deposit is rejected.
+	ceremony.Deposits[1].Amount = amount + 1
+	_, err = genesis.FinalizeCeremony(chainSpec, ceremony)
+	require.Error(t, err)
+}
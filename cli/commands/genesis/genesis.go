@@ -43,6 +43,7 @@ func Commands(
 	// Adding subcommands for genesis-related operations.
 	cmd.AddCommand(
 		AddGenesisDepositCmd(csc),
+		CeremonyCmd(csc),
 		CollectGenesisDepositsCmd(),
 		AddExecutionPayloadCmd(csc),
 		GetGenesisValidatorRootCmd(csc),
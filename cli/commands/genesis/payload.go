@@ -69,21 +69,10 @@ func AddExecutionPayload(chainSpec ChainSpec, elGenesisPath string, config *cmtc
 	if err != nil {
 		return errors.Wrap(err, "failed to read eth1 genesis file")
 	}
-
-	// Unmarshal the genesis file.
-	ethGenesis := &bkitgethtypes.Genesis{}
-	if err = ethGenesis.UnmarshalJSON(genesisBz); err != nil {
-		return errors.Wrap(err, "failed to unmarshal eth1 genesis")
+	eph, err := executionPayloadHeaderFromGenesis(chainSpec, genesisBz)
+	if err != nil {
+		return err
 	}
-	genesisBlock := ethGenesis.ToBlock()
-
-	// Create the execution payload.
-	payload := bkitgethtypes.BlockToExecutableData(
-		genesisBlock,
-		nil,
-		nil,
-		nil,
-	).ExecutionPayload
 
 	appGenesis, err := genutiltypes.AppGenesisFromFile(config.GenesisFile())
 	if err != nil {
@@ -111,17 +100,6 @@ func AddExecutionPayload(chainSpec ChainSpec, elGenesisPath string, config *cmtc
 		return errors.Wrap(err, "failed to unmarshal beacon state")
 	}
 	// Inject the execution payload.
-	eph, err := executableDataToExecutionPayloadHeader(
-		chainSpec.GenesisForkVersion(),
-		payload,
-		chainSpec.MaxWithdrawalsPerPayload(),
-	)
-	if err != nil {
-		return errors.Wrap(err, "failed to convert executable data to execution payload header")
-	}
-	if eph == nil {
-		return errors.New("failed to get execution payload header")
-	}
 	genesisInfo.ExecutionPayloadHeader = eph
 
 	appGenesisState["beacon"], err = json.Marshal(genesisInfo)
@@ -138,6 +116,41 @@ func AddExecutionPayload(chainSpec ChainSpec, elGenesisPath string, config *cmtc
 	return genutil.ExportGenesisFile(appGenesis, config.GenesisFile())
 }
 
+// executionPayloadHeaderFromGenesis computes the execution payload header of
+// the genesis block of the given eth1 genesis.
+func executionPayloadHeaderFromGenesis(
+	chainSpec ChainSpec,
+	genesisBz []byte,
+) (*types.ExecutionPayloadHeader, error) {
+	// Unmarshal the genesis file.
+	ethGenesis := &bkitgethtypes.Genesis{}
+	if err := ethGenesis.UnmarshalJSON(genesisBz); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal eth1 genesis")
+	}
+	genesisBlock := ethGenesis.ToBlock()
+
+	// Create the execution payload.
+	payload := bkitgethtypes.BlockToExecutableData(
+		genesisBlock,
+		nil,
+		nil,
+		nil,
+	).ExecutionPayload
+
+	eph, err := executableDataToExecutionPayloadHeader(
+		chainSpec.GenesisForkVersion(),
+		payload,
+		chainSpec.MaxWithdrawalsPerPayload(),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert executable data to execution payload header")
+	}
+	if eph == nil {
+		return nil, errors.New("failed to get execution payload header")
+	}
+	return eph, nil
+}
+
 // Converts the eth executable data type to the beacon execution payload header
 // interface.
 func executableDataToExecutionPayloadHeader(
@@ -159,18 +159,41 @@ func writeGenesisAllocToFile(
 		return err
 	}
 
+	bz, err := setDepositContractAlloc(existingBz, depositAddr, genesisAlloc, allocsKey)
+	if err != nil {
+		return err
+	}
+
+	// Write back to file
+	return afero.WriteFile(
+		afero.NewOsFs(),
+		outputDocument,
+		bz,
+		0o644, //nolint:mnd // file permissions.
+	)
+}
+
+// setDepositContractAlloc returns the EL genesis with the deposit contract
+// entry of its alloc replaced by the one in genesisAlloc. All other fields are
+// left untouched.
+func setDepositContractAlloc(
+	existingBz []byte,
+	depositAddr gethcommon.Address,
+	genesisAlloc gethtypes.GenesisAlloc,
+	allocsKey string,
+) ([]byte, error) {
 	// Unmarshal existing genesis using json.Number to preserve integer precision
 	var existingGenesis map[string]interface{}
 	decoder := json.NewDecoder(bytes.NewReader(existingBz))
 	decoder.UseNumber()
-	if err = decoder.Decode(&existingGenesis); err != nil {
-		return err
+	if err := decoder.Decode(&existingGenesis); err != nil {
+		return nil, err
 	}
 
 	// Get existing alloc.
 	alloc, ok := existingGenesis[allocsKey].(map[string]interface{})
 	if !ok {
-		return errors.New("invalid alloc format in genesis file")
+		return nil, errors.New("invalid alloc format in genesis file")
 	}
 
 	// Update only the deposit contract entry
@@ -179,16 +202,5 @@ func writeGenesisAllocToFile(
 	}
 
 	// Marshal back to JSON
-	bz, err := json.MarshalIndent(existingGenesis, "", "  ")
-	if err != nil {
-		return err
-	}
-
-	// Write back to file
-	return afero.WriteFile(
-		afero.NewOsFs(),
-		outputDocument,
-		bz,
-		0o644, //nolint:mnd // file permissions.
-	)
+	return json.MarshalIndent(existingGenesis, "", "  ")
 }
@@ -26,6 +26,7 @@ import (
 	"bufio"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"path/filepath"
 
@@ -36,9 +37,13 @@ import (
 	"github.com/berachain/beacon-kit/cli/context"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
 	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/eip2333"
 	"github.com/berachain/beacon-kit/primitives/encoding/json"
 	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/privval"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
@@ -60,9 +65,16 @@ const (
 	// FlagDefaultBondDenom defines the default denom to use in the genesis file.
 	FlagDefaultBondDenom = "default-denom"
 
+	// FlagKeyIndex defines a flag to derive the private validator key recovered
+	// from the mnemonic at the given EIP-2334 validator index.
+	FlagKeyIndex = "key-index"
+
 	// In BeaconKit we use crypto.CometBLSType only so we don't allow to specify
 	// any consensus key.
 	consensusKeyAlgo = crypto.CometBLSType
+
+	// privValDirPerms are the permissions of the private validator directories.
+	privValDirPerms os.FileMode = 0o700
 )
 
 type printInfo struct {
@@ -157,6 +169,22 @@ func InitCmd(creator clitypes.ChainSpecCreator, mm interface {
 				initHeight = 1
 			}
 
+			keyIndex, err := cmd.Flags().GetInt64(FlagKeyIndex)
+			if err != nil {
+				return errors.New("failed to parse FlagKeyIndex")
+			}
+			if keyIndex >= 0 {
+				if !shouldRecover {
+					return errors.New("key-index requires the recover flag")
+				}
+				// Write the EIP-2333 key so that it is loaded below in place of
+				// the CometBFT mnemonic derivation.
+				if err = writeDerivedValidatorKey(config, mnemonic, keyIndex); err != nil {
+					return err
+				}
+				mnemonic = ""
+			}
+
 			nodeID, _, err := genutil.InitializeNodeValidatorFilesFromMnemonic(config, mnemonic, consensusKeyAlgo)
 			if err != nil {
 				return err
@@ -238,5 +266,36 @@ func InitCmd(creator clitypes.ChainSpecCreator, mm interface {
 	cmd.Flags().String(flags.FlagChainID, "", "genesis file chain-id, if left blank will be randomly created")
 	cmd.Flags().String(FlagDefaultBondDenom, "", "genesis file default denomination, if left blank default value is 'stake'")
 	cmd.Flags().Int64(flags.FlagInitHeight, 1, "specify the initial block height at genesis")
+	cmd.Flags().Int64(
+		FlagKeyIndex, -1,
+		"with --recover, derive the validator key at the EIP-2334 path m/12381/3600/<key-index>/0/0 "+
+			"instead of using the CometBFT mnemonic derivation",
+	)
 	return cmd
 }
+
+// writeDerivedValidatorKey derives the validator key at the EIP-2334 signing
+// key path of the given index from the mnemonic, and writes it to the private
+// validator files.
+func writeDerivedValidatorKey(config *cfg.Config, mnemonic string, keyIndex int64) error {
+	if keyIndex > math.MaxUint32 {
+		return fmt.Errorf("key index %d overflows uint32", keyIndex)
+	}
+	secret, err := signer.LegacyKeyFromMnemonic(mnemonic, eip2333.SigningKeyPath(uint32(keyIndex)))
+	if err != nil {
+		return err
+	}
+	privKey, err := bls12381.NewPrivateKeyFromBytes(secret[:])
+	if err != nil {
+		return err
+	}
+
+	keyFile, stateFile := config.PrivValidatorKeyFile(), config.PrivValidatorStateFile()
+	for _, file := range []string{keyFile, stateFile} {
+		if err = os.MkdirAll(filepath.Dir(file), privValDirPerms); err != nil {
+			return err
+		}
+	}
+	privval.NewFilePV(privKey, keyFile, stateFile).Save()
+	return nil
+}
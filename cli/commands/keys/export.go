@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	beaconflags "github.com/berachain/beacon-kit/cli/flags"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const keyFilePerms os.FileMode = 0o600
+
+// NewExportCommand creates a new command decrypting an EIP-2335 keystore back
+// into a privval key file.
+//
+//nolint:lll // reads better if long description is one line
+func NewExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Decrypts an EIP-2335 keystore into a privval key file",
+		Long:  `Decrypts the validator key held by an EIP-2335 keystore, defaulting to the configured keystore-path, and writes it in plaintext to a CometBFT privval key file, defaulting to priv_validator_key_file. An existing key file is only overwritten with --force.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			keystorePath, err := cmd.Flags().GetString(flagKeystore)
+			if err != nil {
+				return err
+			}
+			if keystorePath == "" {
+				keystorePath = cast.ToString(clicontext.GetViperFromCmd(cmd).Get(beaconflags.KeystorePath))
+				if keystorePath == "" {
+					return ErrKeystoreRequired
+				}
+				if !filepath.IsAbs(keystorePath) {
+					keystorePath = filepath.Join(clicontext.GetConfigFromCmd(cmd).RootDir, keystorePath)
+				}
+			}
+			keyFile, err := privValKeyFile(cmd)
+			if err != nil {
+				return err
+			}
+			force, err := cmd.Flags().GetBool(flagForce)
+			if err != nil {
+				return err
+			}
+			pw, err := password(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err = exportKey(keystorePath, pw, keyFile, force); err != nil {
+				return err
+			}
+			cmd.Printf("Exported validator key to key file: %s\n", keyFile)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagKeystore, "", "Optional keystore path, defaults to keystore-path")
+	cmd.Flags().String(flagKeyFile, "", "Optional privval key file path, defaults to priv_validator_key_file")
+	cmd.Flags().String(flagPasswordFile, "", "Optional keystore password file path")
+	cmd.Flags().Bool(flagForce, false, "Overwrite an existing key file")
+	return cmd
+}
+
+// exportKey decrypts the keystore and writes its key to the privval key file.
+func exportKey(keystorePath, password, keyFile string, force bool) error {
+	privKey, err := signer.DecryptKeystoreFile(keystorePath, password)
+	if err != nil {
+		return err
+	}
+	if _, err = os.Stat(keyFile); err == nil && !force {
+		return fmt.Errorf("%w: %s", ErrKeyFileExists, keyFile)
+	}
+
+	filePV := privval.NewFilePV(privKey, keyFile, "")
+	bz, err := cmtjson.MarshalIndent(filePV.Key, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(keyFile), keystoreDirPerms); err != nil {
+		return err
+	}
+	return os.WriteFile(keyFile, bz, keyFilePerms)
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/spf13/cobra"
+)
+
+const keystoreDirPerms os.FileMode = 0o700
+
+// NewImportCommand creates a new command encrypting the privval key file into
+// an EIP-2335 keystore.
+//
+//nolint:lll // reads better if long description is one line
+func NewImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Encrypts the privval key file into an EIP-2335 keystore",
+		Long:  `Encrypts the validator key held by the CometBFT privval key file into an EIP-2335 keystore, written to the keystore directory. The password is read from --password-file, the configured keystore-password-file or the BEACOND_KEYSTORE_PASSWORD environment variable. Set keystore-path in app.toml to the new keystore to sign with it, and pass --delete-key-file to remove the plaintext key file once imported.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			keyFile, err := privValKeyFile(cmd)
+			if err != nil {
+				return err
+			}
+			dir, err := keystoreDir(cmd)
+			if err != nil {
+				return err
+			}
+			kdf, err := cmd.Flags().GetString(flagKDF)
+			if err != nil {
+				return err
+			}
+			deleteKeyFile, err := cmd.Flags().GetBool(flagDeleteKey)
+			if err != nil {
+				return err
+			}
+			pw, err := password(cmd)
+			if err != nil {
+				return err
+			}
+
+			path, err := importKey(keyFile, dir, pw, kdf)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Imported validator key into keystore: %s\n", path)
+			if deleteKeyFile {
+				if err = os.Remove(keyFile); err != nil {
+					return fmt.Errorf("failed removing key file: %w", err)
+				}
+				cmd.Printf("Removed plaintext key file: %s\n", keyFile)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(flagKeyFile, "", "Optional privval key file path, defaults to priv_validator_key_file")
+	cmd.Flags().String(flagKeystoreDir, "", "Optional keystore directory, defaults to "+DefaultKeystoreDir)
+	cmd.Flags().String(flagPasswordFile, "", "Optional keystore password file path")
+	cmd.Flags().String(flagKDF, signer.KDFScrypt, "Key derivation function, scrypt or pbkdf2")
+	cmd.Flags().Bool(flagDeleteKey, false, "Remove the privval key file once imported")
+	return cmd
+}
+
+// importKey encrypts the key of the privval key file into a keystore written
+// to dir, and returns the keystore path.
+func importKey(keyFile, dir, password, kdf string) (string, error) {
+	secret, pubkey, err := readPrivValKey(keyFile)
+	if err != nil {
+		return "", err
+	}
+	ks, err := signer.EncryptKeystore(secret, pubkey, password, kdf)
+	if err != nil {
+		return "", err
+	}
+
+	if err = os.MkdirAll(dir, keystoreDirPerms); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, "keystore-"+ks.Pubkey+".json")
+	if _, err = os.Stat(path); err == nil {
+		return "", fmt.Errorf("%w: %s", ErrKeystoreExists, path)
+	}
+	if err = ks.WriteFile(path); err != nil {
+		return "", err
+	}
+
+	// Make sure the written keystore decrypts back to the same key, before the
+	// key file is possibly removed.
+	if _, err = signer.DecryptKeystoreFile(path, password); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// readPrivValKey reads the secret and public keys from a privval key file.
+func readPrivValKey(keyFile string) ([]byte, crypto.BLSPubkey, error) {
+	bz, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, crypto.BLSPubkey{}, fmt.Errorf("failed reading key file: %w", err)
+	}
+	var key privval.FilePVKey
+	if err = cmtjson.Unmarshal(bz, &key); err != nil {
+		return nil, crypto.BLSPubkey{}, fmt.Errorf("failed decoding key file %s: %w", keyFile, err)
+	}
+
+	secret := key.PrivKey.Bytes()
+	if len(secret) != constants.BLSSecretKeyLength {
+		return nil, crypto.BLSPubkey{}, signer.ErrInvalidValidatorPrivateKeyLength
+	}
+	legacySigner, err := signer.NewLegacySigner(signer.LegacyKey(secret))
+	if err != nil {
+		return nil, crypto.BLSPubkey{}, err
+	}
+	return secret, legacySigner.PublicKey(), nil
+}
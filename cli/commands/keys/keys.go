@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys
+
+import (
+	"path/filepath"
+
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	beaconflags "github.com/berachain/beacon-kit/cli/flags"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// DefaultKeystoreDir is the directory keystores are imported into,
+	// relative to the node home directory.
+	DefaultKeystoreDir = "config/keystores"
+
+	flagKeyFile      = "key-file"
+	flagKeystore     = "keystore"
+	flagKeystoreDir  = "keystore-dir"
+	flagPasswordFile = "password-file"
+	flagKDF          = "kdf"
+	flagDeleteKey    = "delete-key-file"
+	flagForce        = "force"
+)
+
+// Commands creates a new command for managing the validator keys.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "keys",
+		Short:                      "Validator key subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewImportCommand(),
+		NewExportCommand(),
+		NewListCommand(),
+	)
+
+	return cmd
+}
+
+// privValKeyFile returns the privval key file path from the command flags,
+// defaulting to the one configured in the CometBFT config.
+func privValKeyFile(cmd *cobra.Command) (string, error) {
+	keyFile, err := cmd.Flags().GetString(flagKeyFile)
+	if err != nil || keyFile != "" {
+		return keyFile, err
+	}
+	return clicontext.GetConfigFromCmd(cmd).PrivValidatorKeyFile(), nil
+}
+
+// keystoreDir returns the keystore directory from the command flags,
+// defaulting to DefaultKeystoreDir in the node home directory.
+func keystoreDir(cmd *cobra.Command) (string, error) {
+	dir, err := cmd.Flags().GetString(flagKeystoreDir)
+	if err != nil || dir != "" {
+		return dir, err
+	}
+	return filepath.Join(clicontext.GetConfigFromCmd(cmd).RootDir, DefaultKeystoreDir), nil
+}
+
+// password reads the keystore password from the file given in the command
+// flags, falling back to the configured password file and then to the
+// environment.
+func password(cmd *cobra.Command) (string, error) {
+	passwordFile, err := cmd.Flags().GetString(flagPasswordFile)
+	if err != nil {
+		return "", err
+	}
+	if passwordFile == "" {
+		passwordFile = cast.ToString(clicontext.GetViperFromCmd(cmd).Get(beaconflags.KeystorePasswordFile))
+	}
+	return signer.ReadKeystorePassword(passwordFile)
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/keys"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportImportRoundTrip(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	secret, err := hex.DecodeString("000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f")
+	require.NoError(t, err)
+	legacySigner, err := signer.NewLegacySigner(signer.LegacyKey(secret))
+	require.NoError(t, err)
+
+	passwordFile := filepath.Join(dir, "password.txt")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("testpassword\n"), 0o600))
+	ks, err := signer.EncryptKeystore(secret, legacySigner.PublicKey(), "testpassword", signer.KDFPBKDF2)
+	require.NoError(t, err)
+	keystorePath := filepath.Join(dir, "keystore.json")
+	require.NoError(t, ks.WriteFile(keystorePath))
+
+	// Export the keystore to a privval key file.
+	keyFile := filepath.Join(dir, "priv_validator_key.json")
+	cmd := keys.NewExportCommand()
+	cmd.SetArgs([]string{
+		"--keystore", keystorePath, "--key-file", keyFile, "--password-file", passwordFile,
+	})
+	require.NoError(t, cmd.Execute())
+
+	// Exporting again requires the force flag.
+	cmd = keys.NewExportCommand()
+	cmd.SetArgs([]string{
+		"--keystore", keystorePath, "--key-file", keyFile, "--password-file", passwordFile,
+	})
+	require.ErrorIs(t, cmd.Execute(), keys.ErrKeyFileExists)
+
+	// Import the key file back into a new keystore, removing the key file.
+	keystoreDir := filepath.Join(dir, "keystores")
+	cmd = keys.NewImportCommand()
+	cmd.SetArgs([]string{
+		"--key-file", keyFile, "--keystore-dir", keystoreDir, "--password-file", passwordFile, "--delete-key-file",
+	})
+	require.NoError(t, cmd.Execute())
+	_, err = os.Stat(keyFile)
+	require.True(t, os.IsNotExist(err))
+
+	imported := filepath.Join(keystoreDir, "keystore-"+ks.Pubkey+".json")
+	privKey, err := signer.DecryptKeystoreFile(imported, "testpassword")
+	require.NoError(t, err)
+	require.Equal(t, secret, privKey.Bytes())
+
+	// The imported keystore is listed.
+	var out bytes.Buffer
+	cmd = keys.NewListCommand()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"--keystore-dir", keystoreDir})
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, out.String(), legacySigner.PublicKey().String())
+}
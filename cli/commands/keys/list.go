@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/spf13/cobra"
+)
+
+// NewListCommand creates a new command listing the keystores of the keystore
+// directory.
+func NewListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists the EIP-2335 keystores of the keystore directory",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dir, err := keystoreDir(cmd)
+			if err != nil {
+				return err
+			}
+			entries, err := os.ReadDir(dir)
+			if os.IsNotExist(err) {
+				cmd.Printf("No keystore found in %s\n", dir)
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			for _, entry := range entries {
+				if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+					continue
+				}
+				path := filepath.Join(dir, entry.Name())
+				ks, errKs := signer.ReadKeystore(path)
+				if errKs != nil {
+					cmd.Printf("%s: invalid keystore: %v\n", path, errKs)
+					continue
+				}
+				pubkey, errPk := ks.PublicKey()
+				if errPk != nil {
+					cmd.Printf("%s: invalid keystore public key: %v\n", path, errPk)
+					continue
+				}
+				cmd.Printf("%s %s (kdf: %s)\n", pubkey, path, ks.Crypto.KDF.Function)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(flagKeystoreDir, "", "Optional keystore directory, defaults to "+DefaultKeystoreDir)
+	return cmd
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/berachain/beacon-kit/cli/commands/genesis"
 	"github.com/berachain/beacon-kit/cli/commands/initialize"
 	"github.com/berachain/beacon-kit/cli/commands/jwt"
+	"github.com/berachain/beacon-kit/cli/commands/keys"
 	"github.com/berachain/beacon-kit/cli/commands/server"
 	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
 	"github.com/berachain/beacon-kit/cli/flags"
@@ -52,6 +53,8 @@ func DefaultRootCommandSetup(
 		deposit.Commands(chainSpecCreator, appCreator),
 		// `jwt`
 		jwt.Commands(),
+		// `keys`
+		keys.Commands(),
 		// `rollback`
 		server.NewRollbackCmd(appCreator),
 		// `start`
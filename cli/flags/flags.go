@@ -35,12 +35,27 @@ const (
 	// Builder Config.
 	builderRoot           = beaconKitRoot + "payload-builder."
 	SuggestedFeeRecipient = builderRoot + "suggested-fee-recipient"
+	FeeRecipientsFile     = builderRoot + "fee-recipients-file"
 	BuilderEnabled        = builderRoot + "enabled"
 	BuildPayloadTimeout   = builderRoot + "payload-timeout"
+	PayloadBuildTime      = builderRoot + "payload-build-time"
+
+	// Relay Config.
+	relayRoot                 = builderRoot + "relay."
+	RelayEnabled              = relayRoot + "enabled"
+	RelayURLs                 = relayRoot + "urls"
+	RelayBoostFactor          = relayRoot + "boost-factor"
+	RelayGasLimit             = relayRoot + "gas-limit"
+	RelayRegistrationInterval = relayRoot + "registration-interval"
 
 	// Validator Config.
-	validatorRoot = beaconKitRoot + "validator."
-	Graffiti      = validatorRoot + "graffiti"
+	validatorRoot           = beaconKitRoot + "validator."
+	Graffiti                = validatorRoot + "graffiti"
+	GraffitiMode            = validatorRoot + "graffiti-mode"
+	GraffitiFile            = validatorRoot + "graffiti-file"
+	KeystorePath            = validatorRoot + "keystore-path"
+	KeystorePasswordFile    = validatorRoot + "keystore-password-file"
+	FallbackProposalTimeout = validatorRoot + "fallback-proposal-timeout"
 
 	// Engine Config.
 	engineRoot              = beaconKitRoot + "engine."
@@ -53,6 +68,12 @@ const (
 	RPCJWTRefreshInterval   = engineRoot + "rpc-jwt-refresh-interval"
 	JWTSecretPath           = engineRoot + "jwt-secret-path"
 
+	// Optimistic Engine Config.
+	optimisticRoot          = engineRoot + "optimistic."
+	OptimisticEnabled       = optimisticRoot + "enabled"
+	OptimisticOutageTimeout = optimisticRoot + "outage-timeout"
+	OptimisticMaxBacklog    = optimisticRoot + "max-backlog"
+
 	// KZG Config.
 	kzgRoot             = beaconKitRoot + "kzg."
 	KZGTrustedSetupPath = kzgRoot + "trusted-setup-path"
@@ -64,17 +85,58 @@ const (
 	LogLevel   = loggerRoot + "log-level"
 	Style      = loggerRoot + "style"
 
+	ModuleLevels        = loggerRoot + "module-levels"
+	LogFilePath         = loggerRoot + "file.path"
+	LogFileMaxSizeMB    = loggerRoot + "file.max-size-mb"
+	LogFileMaxBackups   = loggerRoot + "file.max-backups"
+	LogFileMaxAge       = loggerRoot + "file.max-age"
+	LogSamplingMessages = loggerRoot + "sampling.messages"
+	LogSamplingEvery    = loggerRoot + "sampling.every"
+
 	// Block Store Service Config.
 	blockStoreServiceRoot               = beaconKitRoot + "block-store-service."
 	BlockStoreServiceAvailabilityWindow = blockStoreServiceRoot +
 		"availability-window"
 
+	// Deposit Store Config.
+	depositStoreRoot         = beaconKitRoot + "deposit-store."
+	DepositStorePruning      = depositStoreRoot + "pruning"
+	DepositStoreSnapshotPath = depositStoreRoot + "snapshot-path"
+
 	// Node API Config.
 	nodeAPIRoot    = beaconKitRoot + "node-api."
 	NodeAPIEnabled = nodeAPIRoot + "enabled"
 	NodeAPIAddress = nodeAPIRoot + "address"
 	NodeAPILogging = nodeAPIRoot + "logging"
 
+	// Tracing Config.
+	tracingRoot        = beaconKitRoot + "tracing."
+	TracingEnabled     = tracingRoot + "enabled"
+	TracingEndpoint    = tracingRoot + "endpoint"
+	TracingInsecure    = tracingRoot + "insecure"
+	TracingSampleRatio = tracingRoot + "sample-ratio"
+
+	// Metrics Config.
+	metricsRoot            = beaconKitRoot + "metrics."
+	MetricsEnabled         = metricsRoot + "enabled"
+	MetricsAddress         = metricsRoot + "address"
+	MetricsDurationBuckets = metricsRoot + "duration-buckets"
+
+	// Health Config.
+	healthRoot                 = beaconKitRoot + "health."
+	HealthCheckTimeout         = healthRoot + "check-timeout"
+	HealthCacheTTL             = healthRoot + "cache-ttl"
+	HealthMaxFinalizedBlockAge = healthRoot + "max-finalized-block-age"
+	HealthMinFreeDiskSpaceMB   = healthRoot + "min-free-disk-space-mb"
+
+	// Debug Config.
+	debugRoot               = beaconKitRoot + "debug."
+	DebugEnabled            = debugRoot + "enabled"
+	DebugAddress            = debugRoot + "address"
+	DebugSlowBlockThreshold = debugRoot + "slow-block-threshold"
+	DebugCPUProfileDuration = debugRoot + "cpu-profile-duration"
+	DebugProfileCooldown    = debugRoot + "profile-cooldown"
+
 	// BLS Config.
 	PrivValidatorKeyFile   = "priv_validator_key_file"
 	PrivValidatorStateFile = "priv_validator_state_file"
@@ -115,6 +177,21 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.RPCJWTRefreshInterval,
 		"rpc jwt refresh interval",
 	)
+	startCmd.Flags().Bool(
+		OptimisticEnabled,
+		defaultCfg.Engine.Optimistic.Enabled,
+		"keep processing blocks while the execution client is unavailable, for non-validator nodes",
+	)
+	startCmd.Flags().Duration(
+		OptimisticOutageTimeout,
+		defaultCfg.Engine.Optimistic.OutageTimeout,
+		"time a request to the execution client is retried before continuing optimistically",
+	)
+	startCmd.Flags().Int(
+		OptimisticMaxBacklog,
+		defaultCfg.Engine.Optimistic.MaxBacklog,
+		"maximum number of payloads held for the execution client",
+	)
 	startCmd.Flags().Bool(
 		BuilderEnabled,
 		defaultCfg.PayloadBuilder.Enabled,
@@ -125,11 +202,71 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.PayloadBuilder.PayloadTimeout,
 		"payload builder timeout",
 	)
+	startCmd.Flags().Duration(
+		PayloadBuildTime,
+		defaultCfg.PayloadBuilder.PayloadBuildTime,
+		"time given to build optimistic payloads before the expected proposal time",
+	)
 	startCmd.Flags().String(
 		SuggestedFeeRecipient,
 		defaultCfg.PayloadBuilder.SuggestedFeeRecipient.Hex(),
 		"suggested fee recipient",
 	)
+	startCmd.Flags().String(
+		FeeRecipientsFile,
+		defaultCfg.PayloadBuilder.FeeRecipientsFile,
+		"path to the validator pubkey to fee recipient mapping file",
+	)
+	startCmd.Flags().Bool(
+		RelayEnabled,
+		defaultCfg.PayloadBuilder.Relay.Enabled,
+		"relay enabled",
+	)
+	startCmd.Flags().StringSlice(
+		RelayURLs,
+		defaultCfg.PayloadBuilder.Relay.URLs,
+		"relay urls",
+	)
+	startCmd.Flags().Uint64(
+		RelayBoostFactor,
+		defaultCfg.PayloadBuilder.Relay.BoostFactor,
+		"relay bid boost factor, in percent",
+	)
+	startCmd.Flags().Uint64(
+		RelayGasLimit,
+		defaultCfg.PayloadBuilder.Relay.GasLimit,
+		"gas limit registered with the relays",
+	)
+	startCmd.Flags().Duration(
+		RelayRegistrationInterval,
+		defaultCfg.PayloadBuilder.Relay.RegistrationInterval,
+		"relay validator registration interval",
+	)
+	startCmd.Flags().String(
+		GraffitiMode,
+		defaultCfg.Validator.GraffitiMode,
+		"graffiti mode, one of static, file or auto",
+	)
+	startCmd.Flags().String(
+		GraffitiFile,
+		defaultCfg.Validator.GraffitiFile,
+		"path to the graffiti file read in file mode",
+	)
+	startCmd.Flags().String(
+		KeystorePath,
+		defaultCfg.Validator.KeystorePath,
+		"path to the EIP-2335 keystore of the validator key",
+	)
+	startCmd.Flags().String(
+		KeystorePasswordFile,
+		defaultCfg.Validator.KeystorePasswordFile,
+		"path to the validator keystore password file",
+	)
+	startCmd.Flags().Duration(
+		FallbackProposalTimeout,
+		defaultCfg.Validator.FallbackProposalTimeout,
+		"deadline for building a fallback proposal, 0 disables fallback proposals",
+	)
 	startCmd.Flags().String(
 		KZGTrustedSetupPath,
 		defaultCfg.KZG.TrustedSetupPath,
@@ -155,11 +292,56 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Logger.Style,
 		"style",
 	)
+	startCmd.Flags().String(
+		ModuleLevels,
+		defaultCfg.Logger.ModuleLevels,
+		"log levels per module, as comma separated module=level pairs",
+	)
+	startCmd.Flags().String(
+		LogFilePath,
+		defaultCfg.Logger.File.Path,
+		"file the logs are also written to, empty disables the file sink",
+	)
+	startCmd.Flags().Int64(
+		LogFileMaxSizeMB,
+		defaultCfg.Logger.File.MaxSizeMB,
+		"size, in MiB, at which the log file is rotated",
+	)
+	startCmd.Flags().Int(
+		LogFileMaxBackups,
+		defaultCfg.Logger.File.MaxBackups,
+		"number of rotated log files retained, 0 retains them all",
+	)
+	startCmd.Flags().Duration(
+		LogFileMaxAge,
+		defaultCfg.Logger.File.MaxAge,
+		"duration rotated log files are retained for, 0 retains them regardless of age",
+	)
+	startCmd.Flags().StringSlice(
+		LogSamplingMessages,
+		defaultCfg.Logger.Sampling.Messages,
+		"messages logged at the info and debug levels which are sampled",
+	)
+	startCmd.Flags().Uint64(
+		LogSamplingEvery,
+		defaultCfg.Logger.Sampling.Every,
+		"sampling rate of the sampled messages, one of every N occurrences is logged",
+	)
 	startCmd.Flags().Int(
 		BlockStoreServiceAvailabilityWindow,
 		defaultCfg.BlockStoreService.AvailabilityWindow,
 		"block service availability window",
 	)
+	startCmd.Flags().Bool(
+		DepositStorePruning,
+		defaultCfg.DepositStore.Pruning,
+		"deposit store pruning",
+	)
+	startCmd.Flags().String(
+		DepositStoreSnapshotPath,
+		defaultCfg.DepositStore.SnapshotPath,
+		"deposit store initial snapshot path",
+	)
 	startCmd.Flags().Bool(
 		NodeAPIEnabled,
 		defaultCfg.NodeAPI.Enabled,
@@ -175,4 +357,84 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.NodeAPI.Logging,
 		"node api logging",
 	)
+	startCmd.Flags().Bool(
+		TracingEnabled,
+		defaultCfg.Tracing.Enabled,
+		"tracing enabled",
+	)
+	startCmd.Flags().String(
+		TracingEndpoint,
+		defaultCfg.Tracing.Endpoint,
+		"OTLP/HTTP collector endpoint traces are exported to",
+	)
+	startCmd.Flags().Bool(
+		TracingInsecure,
+		defaultCfg.Tracing.Insecure,
+		"export traces without TLS",
+	)
+	startCmd.Flags().Float64(
+		TracingSampleRatio,
+		defaultCfg.Tracing.SampleRatio,
+		"fraction of traces sampled",
+	)
+	startCmd.Flags().Bool(
+		MetricsEnabled,
+		defaultCfg.Metrics.Enabled,
+		"metrics enabled",
+	)
+	startCmd.Flags().String(
+		MetricsAddress,
+		defaultCfg.Metrics.Address,
+		"address the /metrics endpoint listens on",
+	)
+	startCmd.Flags().Float64Slice(
+		MetricsDurationBuckets,
+		defaultCfg.Metrics.DurationBuckets,
+		"buckets, in seconds, of the duration histograms",
+	)
+	startCmd.Flags().Duration(
+		HealthCheckTimeout,
+		defaultCfg.Health.CheckTimeout,
+		"time each health check is given to run",
+	)
+	startCmd.Flags().Duration(
+		HealthCacheTTL,
+		defaultCfg.Health.CacheTTL,
+		"time the health report is served before the checks run again, 0 disables caching",
+	)
+	startCmd.Flags().Duration(
+		HealthMaxFinalizedBlockAge,
+		defaultCfg.Health.MaxFinalizedBlockAge,
+		"time without finalized block after which a synced node is unhealthy, 0 disables the check",
+	)
+	startCmd.Flags().Uint64(
+		HealthMinFreeDiskSpaceMB,
+		defaultCfg.Health.MinFreeDiskSpaceMB,
+		"free disk space, in MiB, under which the node is unhealthy, 0 disables the check",
+	)
+	startCmd.Flags().Bool(
+		DebugEnabled,
+		defaultCfg.Debug.Enabled,
+		"debug endpoints enabled",
+	)
+	startCmd.Flags().String(
+		DebugAddress,
+		defaultCfg.Debug.Address,
+		"address the debug endpoints listen on",
+	)
+	startCmd.Flags().Duration(
+		DebugSlowBlockThreshold,
+		defaultCfg.Debug.SlowBlockThreshold,
+		"FinalizeBlock duration above which profiles are captured, 0 disables the captures",
+	)
+	startCmd.Flags().Duration(
+		DebugCPUProfileDuration,
+		defaultCfg.Debug.CPUProfileDuration,
+		"duration of the CPU profiles captured after a slow block",
+	)
+	startCmd.Flags().Duration(
+		DebugProfileCooldown,
+		defaultCfg.Debug.ProfileCooldown,
+		"minimum time between two profile captures",
+	)
 }
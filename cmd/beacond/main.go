@@ -28,6 +28,7 @@ import (
 	clicomponents "github.com/berachain/beacon-kit/cli/components"
 	"github.com/berachain/beacon-kit/config/spec"
 	nodebuilder "github.com/berachain/beacon-kit/node-core/builder"
+	"github.com/berachain/beacon-kit/node-core/components"
 	"go.uber.org/automaxprocs/maxprocs"
 )
 
@@ -42,7 +43,7 @@ func run() error {
 	nb := nodebuilder.New(
 		// Set the Runtime Components to the Default.
 		nodebuilder.WithComponents(
-			DefaultComponents(),
+			components.DefaultComponents(),
 		),
 	)
 
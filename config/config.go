@@ -31,8 +31,14 @@ import (
 	engineclient "github.com/berachain/beacon-kit/execution/client"
 	log "github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-api/server"
+	"github.com/berachain/beacon-kit/node-core/services/health"
+	"github.com/berachain/beacon-kit/observability/debug"
+	"github.com/berachain/beacon-kit/observability/metrics"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/payload/builder"
+	"github.com/berachain/beacon-kit/storage/archive"
 	"github.com/berachain/beacon-kit/storage/block"
+	"github.com/berachain/beacon-kit/storage/deposit"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
@@ -60,7 +66,13 @@ func DefaultConfig() *Config {
 		PayloadBuilder:    builder.DefaultConfig(),
 		Validator:         validator.DefaultConfig(),
 		BlockStoreService: block.DefaultConfig(),
+		StateArchive:      archive.DefaultConfig(),
+		DepositStore:      deposit.DefaultConfig(),
 		NodeAPI:           server.DefaultConfig(),
+		Tracing:           tracing.DefaultConfig(),
+		Metrics:           metrics.DefaultConfig(),
+		Health:            health.DefaultConfig(),
+		Debug:             debug.DefaultConfig(),
 	}
 }
 
@@ -85,8 +97,20 @@ type Config struct {
 	Validator validator.Config `mapstructure:"validator"`
 	// BlockStoreService is the configuration for the block store service.
 	BlockStoreService block.Config `mapstructure:"block-store-service"`
+	// StateArchive is the configuration for the historical beacon state archive.
+	StateArchive archive.Config `mapstructure:"state-archive"`
+	// DepositStore is the configuration for the deposit store.
+	DepositStore deposit.Config `mapstructure:"deposit-store"`
 	// NodeAPI is the configuration for the node API.
 	NodeAPI server.Config `mapstructure:"node-api"`
+	// Tracing is the configuration for OpenTelemetry tracing.
+	Tracing tracing.Config `mapstructure:"tracing"`
+	// Metrics is the configuration for the Prometheus metrics.
+	Metrics metrics.Config `mapstructure:"metrics"`
+	// Health is the configuration for the health checks of the node.
+	Health health.Config `mapstructure:"health"`
+	// Debug is the configuration for the runtime debug endpoints.
+	Debug debug.Config `mapstructure:"debug"`
 }
 
 // GetEngine returns the execution client configuration.
@@ -114,6 +138,16 @@ func (c Config) Template() string {
 	return template.TomlTemplate
 }
 
+// ReadConfigFile reads the configuration from the app.toml file at path.
+func ReadConfigFile(path string) (*Config, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+	return ReadConfigFromAppOpts(v)
+}
+
 // ReadConfigFromAppOpts reads the configuration options from the given
 // application options.
 func ReadConfigFromAppOpts(opts AppOptions) (*Config, error) {
@@ -71,6 +71,8 @@ func DevnetChainSpecData() *chain.SpecData {
 	specData.ElectraForkTime = 0
 	specData.Electra1ForkTime = 0
 	specData.FuluForkTime = 0
+	specData.ProposalCompressionForkTime = 0
+	specData.ValidatorLookupIndexesForkTime = 0
 
 	// EVM inflation is different from mainnet to test.
 	specData.EVMInflationAddressGenesis = common.MustNewExecutionAddressFromHex(devnetEVMInflationAddress)
@@ -21,6 +21,8 @@
 package spec
 
 import (
+	"math"
+
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/consensus/cometbft/service/delay"
 	"github.com/berachain/beacon-kit/primitives/bytes"
@@ -90,6 +92,14 @@ const (
 	// mainnetFuluForkTime is the timestamp at which the Fulu fork occurs.
 	mainnetFuluForkTime = 1_783_526_400
 
+	// mainnetProposalCompressionForkTime is the timestamp from which the proposal transactions are
+	// compressed. It is not scheduled yet.
+	mainnetProposalCompressionForkTime = math.MaxInt64
+
+	// mainnetValidatorLookupIndexesForkTime is the timestamp from which the validators are indexed
+	// by withdrawal credentials, activation epoch and exit epoch. It is not scheduled yet.
+	mainnetValidatorLookupIndexesForkTime = math.MaxInt64
+
 	// mainnetEVMInflationAddressDeneb1 is the address on the EVM which will receive the
 	// inflation amount of native EVM balance through a withdrawal every block in the Deneb1 fork.
 	mainnetEVMInflationAddressDeneb1 = "0x656b95E550C07a9ffe548bd4085c72418Ceb1dba"
@@ -163,6 +173,9 @@ func MainnetChainSpecData() *chain.SpecData {
 		Electra1ForkTime: mainnetElectra1ForkTime,
 		FuluForkTime:     mainnetFuluForkTime,
 
+		ProposalCompressionForkTime:    mainnetProposalCompressionForkTime,
+		ValidatorLookupIndexesForkTime: mainnetValidatorLookupIndexesForkTime,
+
 		// State list length constants.
 		EpochsPerHistoricalVector: defaultEpochsPerHistoricalVector,
 		EpochsPerSlashingsVector:  defaultEpochsPerSlashingsVector,
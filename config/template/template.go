@@ -52,6 +52,24 @@ rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
+[beacon-kit.engine.optimistic]
+# Enabled lets a node outside of the validator set keep processing blocks while
+# the execution client is unavailable. Their payloads are replayed to the
+# execution client once it is back, and the node API reports the responses as
+# execution optimistic until then. The backlog is only held in memory: after a
+# restart the execution client must sync the missed blocks from its peers. If
+# the execution client rejects a payload of the backlog, the replay stops and
+# the node reports unhealthy until the execution client is re-synced.
+enabled = {{ .BeaconKit.Engine.Optimistic.Enabled }}
+
+# How long a request to the execution client is retried before the node
+# continues optimistically.
+outage-timeout = "{{ .BeaconKit.Engine.Optimistic.OutageTimeout }}"
+
+# Maximum number of payloads held for the execution client. Block processing
+# stalls once the backlog is full.
+max-backlog = {{ .BeaconKit.Engine.Optimistic.MaxBacklog }}
+
 [beacon-kit.logger]
 # TimeFormat is a string that defines the format of the time in the logger.
 time-format = "{{.BeaconKit.Logger.TimeFormat}}"
@@ -63,6 +81,37 @@ log-level = "{{.BeaconKit.Logger.LogLevel}}"
 # Style is the style of the logger.
 style = "{{.BeaconKit.Logger.Style}}"
 
+# ModuleLevels overrides log-level for the modules, i.e. the services, listed
+# as comma separated module=level pairs, e.g. "blockchain=debug,engine-client=warn".
+module-levels = "{{.BeaconKit.Logger.ModuleLevels}}"
+
+# The log levels, file sink and sampling are reloaded from this file when the
+# node receives SIGHUP.
+
+[beacon-kit.logger.file]
+# Path is the file the logs are also written to, as JSON lines. Empty disables
+# the file sink.
+path = "{{.BeaconKit.Logger.File.Path}}"
+
+# MaxSizeMB is the size, in MiB, at which the file is rotated.
+max-size-mb = {{.BeaconKit.Logger.File.MaxSizeMB}}
+
+# MaxBackups is the number of rotated files retained. 0 retains them all.
+max-backups = {{.BeaconKit.Logger.File.MaxBackups}}
+
+# MaxAge is the duration rotated files are retained for. 0 retains them
+# regardless of their age.
+max-age = "{{.BeaconKit.Logger.File.MaxAge}}"
+
+[beacon-kit.logger.sampling]
+# Messages are the noisy messages, logged at the info and debug levels, which
+# are sampled, e.g. ["Successfully stored all blob sidecars"].
+messages = [{{ range $i, $m := .BeaconKit.Logger.Sampling.Messages }}{{ if $i }}, {{ end }}"{{ $m }}"{{ end }}]
+
+# Every is the sampling rate: one of every Every occurrences of each message is
+# logged.
+every = {{.BeaconKit.Logger.Sampling.Every}}
+
 [beacon-kit.kzg]
 # Path to the trusted setup path.
 trusted-setup-path = "{{.BeaconKit.KZG.TrustedSetupPath}}"
@@ -81,21 +130,107 @@ enabled = {{ .BeaconKit.PayloadBuilder.Enabled }}
 # from this node.
 suggested-fee-recipient = "{{.BeaconKit.PayloadBuilder.SuggestedFeeRecipient}}"
 
+# Path to a JSON file mapping validator public keys to the fee recipient of the
+# blocks they propose, e.g. {"0x8a2f...": "0x71c7..."}. Validators missing from
+# the file use the recipient registered through the prepare_beacon_proposer API,
+# if any, and then suggested-fee-recipient. Entries of this file take precedence
+# over the API registrations.
+fee-recipients-file = "{{ .BeaconKit.PayloadBuilder.FeeRecipientsFile }}"
+
 # The timeout for local build payload. This should match, or be slightly less
 # than the configured timeout on your execution client. It also must be less than
 # timeout_proposal in the CometBFT configuration.
 payload-timeout = "{{ .BeaconKit.PayloadBuilder.PayloadTimeout }}"
 
+# The time given to the execution client to build optimistic payloads. The build
+# is started this long before the expected proposal time of the next block, as
+# computed by the stable block time logic, so that later transactions are
+# included. Compare the payload_builder gas_used_percent and build_duration
+# metrics to tune it. Set to 0 to start building as soon as possible.
+payload-build-time = "{{ .BeaconKit.PayloadBuilder.PayloadBuildTime }}"
+
+[beacon-kit.payload-builder.relay]
+# Enabled determines if payloads are also requested from external builders
+# through builder-API relays. The local payload is used whenever the relays
+# pay less than the local payload, or do not both offer a bid and reveal its
+# payload within payload-timeout of the start of the proposal.
+enabled = {{ .BeaconKit.PayloadBuilder.Relay.Enabled }}
+
+# URLs of the builder-API relays. Each URL must carry the relay public key as
+# its user, e.g. "https://0xa1b2...@relay.example.com", and only bids signed by
+# that key are accepted.
+urls = [{{ range $i, $url := .BeaconKit.PayloadBuilder.Relay.URLs }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+
+# BoostFactor is the percentage the relay bid value is multiplied by before
+# being compared against the local payload value.
+boost-factor = {{ .BeaconKit.PayloadBuilder.Relay.BoostFactor }}
+
+# GasLimit is the gas limit registered with the relays.
+gas-limit = {{ .BeaconKit.PayloadBuilder.Relay.GasLimit }}
+
+# RegistrationInterval is the interval between two validator registrations.
+registration-interval = "{{ .BeaconKit.PayloadBuilder.Relay.RegistrationInterval }}"
+
 [beacon-kit.validator]
 # Graffiti string that will be included in the graffiti field of the beacon block.
 graffiti = "{{ .BeaconKit.Validator.Graffiti }}"
 
+# GraffitiMode selects how the graffiti is computed:
+#   static: the graffiti string above is used as is.
+#   file: the graffiti file is re-read for every proposal. Each line is either
+#         "default: <graffiti>" or "<0x-prefixed pubkey>: <graffiti>". The
+#         graffiti string above is used if the file is invalid, e.g. if an
+#         entry is longer than 32 bytes.
+#   auto: the graffiti string above is followed by the execution and consensus
+#         client codes and commits, shortened to fit the 32 bytes field.
+graffiti-mode = "{{ .BeaconKit.Validator.GraffitiMode }}"
+
+# Path to the graffiti file used in "file" mode.
+graffiti-file = "{{ .BeaconKit.Validator.GraffitiFile }}"
+
+# Path to the EIP-2335 keystore holding the validator key. When set, the key is
+# decrypted at startup and priv_validator_key_file is not read. Keystores can be
+# created from the privval key file with "beacond keys import".
+keystore-path = "{{ .BeaconKit.Validator.KeystorePath }}"
+
+# Path to the file holding the keystore password. If empty, the password is read
+# from the BEACOND_KEYSTORE_PASSWORD environment variable.
+keystore-password-file = "{{ .BeaconKit.Validator.KeystorePasswordFile }}"
+
+# Deadline for building a fallback proposal when building the block fails, e.g.
+# because the execution client is slow. The fallback reuses the latest verified
+# payload for the slot, which needs no execution client call, or else the payload
+# built optimistically for the slot, or else a new payload holding the
+# withdrawals and few or no transactions. The last two still require the
+# execution client to answer within the deadline. Set to 0 to disable fallback
+# proposals.
+fallback-proposal-timeout = "{{ .BeaconKit.Validator.FallbackProposalTimeout }}"
+
 [beacon-kit.block-store-service]
 # AvailabilityWindow is the number of slots to keep in the store.
 # Setting AvailabilityWindow to 0 disables block store and does not allow the node
 # to serve proof or namespace apis from beacon node-api.
 availability-window = "{{ .BeaconKit.BlockStoreService.AvailabilityWindow }}"
 
+[beacon-kit.state-archive]
+# Enabled determines if the beacon state of every finalized block is archived,
+# so that the node-api can serve historical states pruned from the IAVL store.
+enabled = {{ .BeaconKit.StateArchive.Enabled }}
+
+# CheckpointInterval is the number of slots between two full state checkpoints.
+# States in between are stored as diffs against the previous slot.
+checkpoint-interval = {{ .BeaconKit.StateArchive.CheckpointInterval }}
+
+[beacon-kit.deposit-store]
+# Pruning determines if deposits are replaced by their EIP-4881 snapshot once the
+# deposit queue is exhausted at Fulu. Archive nodes should keep it disabled.
+pruning = {{ .BeaconKit.DepositStore.Pruning }}
+
+# SnapshotPath is the path to an EIP-4881 deposit snapshot, as served by the
+# /eth/v1/beacon/deposit_snapshot endpoint, used to initialize an empty deposit
+# store. Only set it on nodes starting from a state past the first Fulu block.
+snapshot-path = "{{ .BeaconKit.DepositStore.SnapshotPath }}"
+
 [beacon-kit.node-api]
 # Enabled determines if the node API is enabled.
 enabled = "{{ .BeaconKit.NodeAPI.Enabled }}"
@@ -105,4 +240,64 @@ address = "{{ .BeaconKit.NodeAPI.Address }}"
 
 # Logging determines if the node API logging is enabled.
 logging = "{{ .BeaconKit.NodeAPI.Logging }}"
+
+[beacon-kit.tracing]
+# Enabled determines if OpenTelemetry traces are exported.
+enabled = "{{ .BeaconKit.Tracing.Enabled }}"
+
+# Endpoint is the host and port of the OTLP/HTTP collector traces are exported to.
+endpoint = "{{ .BeaconKit.Tracing.Endpoint }}"
+
+# Insecure disables TLS when exporting traces.
+insecure = "{{ .BeaconKit.Tracing.Insecure }}"
+
+# SampleRatio is the fraction of traces sampled, between 0 and 1.
+sample-ratio = "{{ .BeaconKit.Tracing.SampleRatio }}"
+
+[beacon-kit.metrics]
+# Enabled determines if the Prometheus metrics are served.
+enabled = "{{ .BeaconKit.Metrics.Enabled }}"
+
+# Address is the address the /metrics endpoint listens on.
+address = "{{ .BeaconKit.Metrics.Address }}"
+
+# DurationBuckets are the buckets, in seconds, of the duration histograms.
+duration-buckets = [{{ range $i, $b := .BeaconKit.Metrics.DurationBuckets }}{{ if $i }}, {{ end }}{{ $b }}{{ end }}]
+
+[beacon-kit.health]
+# CheckTimeout is the time each health check is given to run.
+check-timeout = "{{ .BeaconKit.Health.CheckTimeout }}"
+
+# CacheTTL is the time a health report is served for before the checks run
+# again, so that frequent health probes do not each hit the disk and the
+# execution client. 0 disables caching.
+cache-ttl = "{{ .BeaconKit.Health.CacheTTL }}"
+
+# MaxFinalizedBlockAge is the time after which a synced node which has not
+# finalized a block is reported unhealthy. 0 disables the check.
+max-finalized-block-age = "{{ .BeaconKit.Health.MaxFinalizedBlockAge }}"
+
+# MinFreeDiskSpaceMB is the free disk space, in MiB, of the node home directory
+# under which the node is reported unhealthy. 0 disables the check.
+min-free-disk-space-mb = {{ .BeaconKit.Health.MinFreeDiskSpaceMB }}
+
+[beacon-kit.debug]
+# Enabled determines if the pprof, goroutine dump and log level endpoints are
+# served. They expose the internals of the node: do not serve them publicly.
+enabled = "{{ .BeaconKit.Debug.Enabled }}"
+
+# Address is the address the debug endpoints listen on.
+address = "{{ .BeaconKit.Debug.Address }}"
+
+# SlowBlockThreshold is the FinalizeBlock duration above which heap and CPU
+# profiles are written to the profiles directory of the node home. 0 disables
+# the captures.
+slow-block-threshold = "{{ .BeaconKit.Debug.SlowBlockThreshold }}"
+
+# CPUProfileDuration is the duration of the CPU profiles captured after a slow
+# block.
+cpu-profile-duration = "{{ .BeaconKit.Debug.CPUProfileDuration }}"
+
+# ProfileCooldown is the minimum time between two captures.
+profile-cooldown = "{{ .BeaconKit.Debug.ProfileCooldown }}"
 `
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/karalabe/ssz"
+)
+
+// Compile-time assertions to ensure the block contents implement necessary interfaces.
+var (
+	_ ssz.DynamicObject = (*BlockContents)(nil)
+	_ ssz.DynamicObject = (*SignedBlockContents)(nil)
+)
+
+// BlockContents is an unsigned block along with the blobs it commits to, as
+// served to validator clients requesting a block to propose.
+//
+// NOTE: This struct is only ever (un)marshalled with SSZ and NOT with JSON.
+type BlockContents struct {
+	Block     *BeaconBlock
+	KZGProofs []eip4844.KZGProof
+	Blobs     []eip4844.Blob
+}
+
+// NewEmptyBlockContentsWithVersion returns empty block contents ready to be
+// decoded for the given fork version.
+func NewEmptyBlockContentsWithVersion(forkVersion common.Version) (*BlockContents, error) {
+	// Reuse the signed block constructor to enforce the supported fork versions.
+	signedBlk, err := NewEmptySignedBeaconBlockWithVersion(forkVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockContents{Block: signedBlk.GetBeaconBlock()}, nil
+}
+
+// SizeSSZ returns the size of the BlockContents in SSZ.
+func (c *BlockContents) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	size := 3 * constants.SSZOffsetSize
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(siz, c.Block)
+	size += ssz.SizeSliceOfStaticBytes(siz, c.KZGProofs)
+	size += ssz.SizeSliceOfStaticBytes(siz, c.Blobs)
+	return size
+}
+
+// DefineSSZ defines the SSZ serialization of the BlockContents.
+func (c *BlockContents) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineDynamicObjectOffset(codec, &c.Block)
+	ssz.DefineSliceOfStaticBytesOffset(codec, &c.KZGProofs, constants.MaxBlobCommitmentsPerBlock)
+	ssz.DefineSliceOfStaticBytesOffset(codec, &c.Blobs, constants.MaxBlobCommitmentsPerBlock)
+
+	ssz.DefineDynamicObjectContent(codec, &c.Block)
+	ssz.DefineSliceOfStaticBytesContent(codec, &c.KZGProofs, constants.MaxBlobCommitmentsPerBlock)
+	ssz.DefineSliceOfStaticBytesContent(codec, &c.Blobs, constants.MaxBlobCommitmentsPerBlock)
+}
+
+// MarshalSSZ serializes the BlockContents to SSZ-encoded bytes.
+func (c *BlockContents) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(c))
+	return buf, ssz.EncodeToBytes(buf, c)
+}
+
+// ValidateAfterDecodingSSZ validates the BlockContents after decoding.
+func (c *BlockContents) ValidateAfterDecodingSSZ() error {
+	return c.Block.ValidateAfterDecodingSSZ()
+}
+
+// SignedBlockContents is a signed block along with the blobs it commits to,
+// as published back by validator clients.
+//
+// NOTE: This struct is only ever (un)marshalled with SSZ and NOT with JSON.
+type SignedBlockContents struct {
+	SignedBlock *SignedBeaconBlock
+	KZGProofs   []eip4844.KZGProof
+	Blobs       []eip4844.Blob
+}
+
+// NewEmptySignedBlockContentsWithVersion returns empty signed block contents
+// ready to be decoded for the given fork version.
+func NewEmptySignedBlockContentsWithVersion(forkVersion common.Version) (*SignedBlockContents, error) {
+	signedBlk, err := NewEmptySignedBeaconBlockWithVersion(forkVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &SignedBlockContents{SignedBlock: signedBlk}, nil
+}
+
+// SizeSSZ returns the size of the SignedBlockContents in SSZ.
+func (c *SignedBlockContents) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	size := 3 * constants.SSZOffsetSize
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(siz, c.SignedBlock)
+	size += ssz.SizeSliceOfStaticBytes(siz, c.KZGProofs)
+	size += ssz.SizeSliceOfStaticBytes(siz, c.Blobs)
+	return size
+}
+
+// DefineSSZ defines the SSZ serialization of the SignedBlockContents.
+func (c *SignedBlockContents) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineDynamicObjectOffset(codec, &c.SignedBlock)
+	ssz.DefineSliceOfStaticBytesOffset(codec, &c.KZGProofs, constants.MaxBlobCommitmentsPerBlock)
+	ssz.DefineSliceOfStaticBytesOffset(codec, &c.Blobs, constants.MaxBlobCommitmentsPerBlock)
+
+	ssz.DefineDynamicObjectContent(codec, &c.SignedBlock)
+	ssz.DefineSliceOfStaticBytesContent(codec, &c.KZGProofs, constants.MaxBlobCommitmentsPerBlock)
+	ssz.DefineSliceOfStaticBytesContent(codec, &c.Blobs, constants.MaxBlobCommitmentsPerBlock)
+}
+
+// MarshalSSZ serializes the SignedBlockContents to SSZ-encoded bytes.
+func (c *SignedBlockContents) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(c))
+	return buf, ssz.EncodeToBytes(buf, c)
+}
+
+// ValidateAfterDecodingSSZ validates the SignedBlockContents after decoding.
+func (c *SignedBlockContents) ValidateAfterDecodingSSZ() error {
+	if len(c.KZGProofs) != len(c.Blobs) {
+		return errors.Wrapf(
+			ErrBlobsProofsMismatch, "%d proofs, %d blobs", len(c.KZGProofs), len(c.Blobs),
+		)
+	}
+	return c.SignedBlock.ValidateAfterDecodingSSZ()
+}
+
+// GetCommitments returns the KZG commitments of the block body.
+func (c *SignedBlockContents) GetCommitments() []eip4844.KZGCommitment {
+	return c.SignedBlock.GetBody().GetBlobKzgCommitments()
+}
+
+// GetProofs returns the KZG proofs of the blobs.
+func (c *SignedBlockContents) GetProofs() []eip4844.KZGProof {
+	return c.KZGProofs
+}
+
+// GetBlobs returns the blobs committed to by the block.
+func (c *SignedBlockContents) GetBlobs() []*eip4844.Blob {
+	blobs := make([]*eip4844.Blob, len(c.Blobs))
+	for i := range c.Blobs {
+		blobs[i] = &c.Blobs[i]
+	}
+	return blobs
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	sszutil "github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	"github.com/berachain/beacon-kit/testing/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockContents_MarshalUnmarshalSSZ(t *testing.T) {
+	t.Parallel()
+	runForAllSupportedVersions(t, func(t *testing.T, v common.Version) {
+		contents := &types.BlockContents{
+			Block:     utils.GenerateValidBeaconBlock(t, v),
+			KZGProofs: []eip4844.KZGProof{{0x01}, {0x02}},
+			Blobs:     []eip4844.Blob{{0x03}, {0x04}},
+		}
+		data, err := contents.MarshalSSZ()
+		require.NoError(t, err)
+
+		decoded, err := types.NewEmptyBlockContentsWithVersion(v)
+		require.NoError(t, err)
+		require.NoError(t, sszutil.Unmarshal(data, decoded))
+		require.Equal(t, contents.Block.HashTreeRoot(), decoded.Block.HashTreeRoot())
+		require.Equal(t, contents.KZGProofs, decoded.KZGProofs)
+		require.Equal(t, contents.Blobs, decoded.Blobs)
+	})
+}
+
+func TestSignedBlockContents_MarshalUnmarshalSSZ(t *testing.T) {
+	t.Parallel()
+	runForAllSupportedVersions(t, func(t *testing.T, v common.Version) {
+		contents := &types.SignedBlockContents{
+			SignedBlock: generateFakeSignedBeaconBlock(t, v),
+			KZGProofs:   []eip4844.KZGProof{{0x01}},
+			Blobs:       []eip4844.Blob{{0x02}},
+		}
+		contents.SignedBlock.Signature = [96]byte{0x05}
+		data, err := contents.MarshalSSZ()
+		require.NoError(t, err)
+
+		decoded, err := types.NewEmptySignedBlockContentsWithVersion(v)
+		require.NoError(t, err)
+		require.NoError(t, sszutil.Unmarshal(data, decoded))
+		require.Equal(t, contents.SignedBlock.HashTreeRoot(), decoded.SignedBlock.HashTreeRoot())
+		require.Equal(t, contents.GetProofs(), decoded.GetProofs())
+		require.Equal(t, contents.GetBlobs(), decoded.GetBlobs())
+	})
+}
+
+func TestSignedBlockContents_ProofsBlobsMismatch(t *testing.T) {
+	t.Parallel()
+	runForAllSupportedVersions(t, func(t *testing.T, v common.Version) {
+		contents := &types.SignedBlockContents{
+			SignedBlock: generateFakeSignedBeaconBlock(t, v),
+			KZGProofs:   []eip4844.KZGProof{{0x01}, {0x02}},
+			Blobs:       []eip4844.Blob{{0x03}},
+		}
+		data, err := contents.MarshalSSZ()
+		require.NoError(t, err)
+
+		decoded, err := types.NewEmptySignedBlockContentsWithVersion(v)
+		require.NoError(t, err)
+		require.ErrorIs(t, sszutil.Unmarshal(data, decoded), types.ErrBlobsProofsMismatch)
+	})
+}
+
+func TestNewEmptyBlockContentsWithVersionInvalidForkVersion(t *testing.T) {
+	t.Parallel()
+	_, err := types.NewEmptyBlockContentsWithVersion(common.Version{0xFF})
+	require.ErrorIs(t, err, types.ErrForkVersionNotSupported)
+	_, err = types.NewEmptySignedBlockContentsWithVersion(common.Version{0xFF})
+	require.ErrorIs(t, err, types.ErrForkVersionNotSupported)
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/karalabe/ssz"
+)
+
+// depositDataSize is the size of the SSZ encoding of a DepositData.
+const depositDataSize = 184 // 48 + 32 + 8 + 96
+
+// Compile-time assertion to ensure DepositData implements ssz.StaticObject.
+var _ ssz.StaticObject = (*DepositData)(nil)
+
+// DepositData is the signed deposit message submitted to the deposit
+// contract, whose hash tree root is the contract's deposit_data_root.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#depositdata
+type DepositData struct {
+	// Public key of the validator specified in the deposit.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+	// A staking credentials with
+	// 1 byte prefix + 11 bytes padding + 20 bytes address = 32 bytes.
+	Credentials WithdrawalCredentials `json:"credentials"`
+	// Deposit amount in gwei.
+	Amount math.Gwei `json:"amount"`
+	// Signature of the deposit message.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// NewDepositData creates the deposit data of a signed deposit message.
+func NewDepositData(msg *DepositMessage, signature crypto.BLSSignature) *DepositData {
+	return &DepositData{
+		Pubkey:      msg.Pubkey,
+		Credentials: msg.Credentials,
+		Amount:      msg.Amount,
+		Signature:   signature,
+	}
+}
+
+// SizeSSZ returns the SSZ encoded size of the DepositData object.
+func (*DepositData) SizeSSZ(*ssz.Sizer) uint32 {
+	return depositDataSize
+}
+
+// DefineSSZ defines the SSZ encoding for the DepositData object.
+func (d *DepositData) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &d.Pubkey)
+	ssz.DefineStaticBytes(c, &d.Credentials)
+	ssz.DefineUint64(c, &d.Amount)
+	ssz.DefineStaticBytes(c, &d.Signature)
+}
+
+// HashTreeRoot computes the Merkleization of the DepositData object.
+func (d *DepositData) HashTreeRoot() common.Root {
+	return ssz.HashSequential(d)
+}
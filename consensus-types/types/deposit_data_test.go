@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	types "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDepositDataHashTreeRoot checks the root against the deposit_data_root
+// computation of the deposit contract.
+func TestDepositDataHashTreeRoot(t *testing.T) {
+	t.Parallel()
+	var (
+		pubkey    crypto.BLSPubkey
+		signature crypto.BLSSignature
+	)
+	for i := range pubkey {
+		pubkey[i] = byte(i)
+	}
+	for i := range signature {
+		signature[i] = byte(0xff - i)
+	}
+	msg := &types.DepositMessage{
+		Pubkey:      pubkey,
+		Credentials: types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x01, 0x02}),
+		Amount:      math.Gwei(32e9),
+	}
+	data := types.NewDepositData(msg, signature)
+
+	hash := func(chunks ...[]byte) []byte {
+		h := sha256.New()
+		for _, c := range chunks {
+			h.Write(c)
+		}
+		return h.Sum(nil)
+	}
+	amount := make([]byte, 32)
+	binary.LittleEndian.PutUint64(amount, uint64(msg.Amount))
+	pubkeyRoot := hash(pubkey[:], make([]byte, 16))
+	signatureRoot := hash(hash(signature[:64]), hash(signature[64:], make([]byte, 32)))
+	expected := hash(
+		hash(pubkeyRoot, msg.Credentials[:]),
+		hash(amount, signatureRoot),
+	)
+
+	root := data.HashTreeRoot()
+	require.Equal(t, expected, root[:])
+}
@@ -52,4 +52,8 @@ var (
 
 	// ErrFieldNotSupportedOnFork occurs when attempting to retrieve a field on a fork on which it is not supported
 	ErrFieldNotSupportedOnFork = errors.New("field not supported on fork")
+
+	// ErrBlobsProofsMismatch is an error for when block contents do not carry
+	// one KZG proof per blob.
+	ErrBlobsProofsMismatch = errors.New("number of blobs and KZG proofs mismatch")
 )
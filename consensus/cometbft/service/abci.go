@@ -28,13 +28,26 @@ import (
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdkversion "github.com/cosmos/cosmos-sdk/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
-var errInvalidHeight = errors.New("invalid height")
+var (
+	errInvalidHeight = errors.New("invalid height")
+
+	tracer = tracing.Tracer("github.com/berachain/beacon-kit/consensus/cometbft/service")
+)
+
+// startABCISpan starts the span of the ABCI method with the given name, as
+// a child of the service context.
+func (s *Service) startABCISpan(name string, height int64) (context.Context, trace.Span) {
+	return tracer.Start(s.ctx, name, trace.WithAttributes(attribute.Int64("height", height)))
+}
 
 func (s *Service) InitChain(
 	_ context.Context,
@@ -70,8 +83,11 @@ func (s *Service) PrepareProposal(
 		//nolint:nilerr // explicitly allowing this case
 		return &cmtabci.PrepareProposalResponse{Txs: req.Txs}, nil
 	}
+	ctx, span := s.startABCISpan("PrepareProposal", req.Height)
 	//nolint:contextcheck // see s.ctx comment for more details
-	return s.prepareProposal(s.ctx, req)
+	resp, err := s.prepareProposal(ctx, req)
+	tracing.End(span, err)
+	return resp, err
 }
 
 func (s *Service) Info(context.Context,
@@ -117,8 +133,14 @@ func (s *Service) ProcessProposal(
 		// reject a proposal based on incomplete data.
 		return nil, s.ctx.Err()
 	}
+	ctx, span := s.startABCISpan("ProcessProposal", req.Height)
 	//nolint:contextcheck // see s.ctx comment for more details
-	return s.processProposal(s.ctx, req)
+	resp, err := s.processProposal(ctx, req)
+	if err == nil {
+		span.SetAttributes(attribute.String("status", resp.GetStatus().String()))
+	}
+	tracing.End(span, err)
+	return resp, err
 }
 
 func (s *Service) FinalizeBlock(
@@ -141,8 +163,15 @@ func (s *Service) FinalizeBlock(
 		// We expect this to happen and do not want to finalize any incomplete or invalid state.
 		return nil, s.ctx.Err()
 	}
+	ctx, span := s.startABCISpan("FinalizeBlock", req.Height)
+	startTime := time.Now()
 	//nolint:contextcheck // see s.ctx comment for more details
-	return s.finalizeBlock(s.ctx, req)
+	resp, err := s.finalizeBlock(ctx, req)
+	tracing.End(span, err)
+	if err == nil && s.blockProfiler != nil {
+		s.blockProfiler.ObserveFinalizeBlock(req.Height, time.Since(startTime))
+	}
+	return resp, err
 }
 
 // Commit implements the ABCI interface. It will commit all state that exists in
@@ -161,7 +190,10 @@ func (s *Service) Commit(
 		return nil, s.ctx.Err()
 	}
 
-	return s.commit(req)
+	_, span := s.startABCISpan("Commit", s.finalizedHeight)
+	resp, err := s.commit(req)
+	tracing.End(span, err)
+	return resp, err
 }
 
 // NOTE: Partially copied from https://github.com/cosmos/cosmos-sdk/blob/960d44842b9e313cbe762068a67a894ac82060ab/baseapp/abci.go#L168
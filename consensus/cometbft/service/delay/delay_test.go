@@ -21,6 +21,7 @@
 package delay_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -169,3 +170,26 @@ func TestBlockDelayNext_Catchup(t *testing.T) {
 
 	assert.Equal(t, 1*time.Second, delay)
 }
+
+func TestScheduleWait(t *testing.T) {
+	t.Parallel()
+
+	s := delay.NewSchedule()
+	next := time.Now().Add(time.Second)
+	go s.Set(10, next)
+
+	got, ok := s.Wait(t.Context(), 10)
+	require.True(t, ok)
+	require.Equal(t, next, got)
+
+	// A height already superseded is never scheduled.
+	s.Set(11, next)
+	_, ok = s.Wait(t.Context(), 10)
+	require.False(t, ok)
+
+	// Waiting stops with the context.
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	_, ok = s.Wait(ctx, 12)
+	require.False(t, ok)
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package delay
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Schedule publishes the expected proposal time of the next block, as
+// computed by the stable block time logic, so that payload building can be
+// timed against it.
+type Schedule struct {
+	mu sync.Mutex
+	// height is the height of the next block.
+	height int64
+	// next is the expected proposal time of the next block.
+	next time.Time
+	// updated is closed, and replaced, whenever the schedule is updated.
+	updated chan struct{}
+}
+
+// NewSchedule returns an empty schedule.
+func NewSchedule() *Schedule {
+	return &Schedule{updated: make(chan struct{})}
+}
+
+// Set records that the block at the given height is expected to be proposed
+// at the given time.
+func (s *Schedule) Set(height int64, next time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.height = height
+	s.next = next
+	close(s.updated)
+	s.updated = make(chan struct{})
+}
+
+// Wait blocks until the expected proposal time of the block at the given
+// height is known and returns it. It returns false if the context is done
+// first or if a later height has been scheduled already.
+func (s *Schedule) Wait(ctx context.Context, height int64) (time.Time, bool) {
+	for {
+		s.mu.Lock()
+		scheduled, next, updated := s.height, s.next, s.updated
+		s.mu.Unlock()
+		switch {
+		case scheduled == height:
+			return next, true
+		case scheduled > height:
+			return time.Time{}, false
+		}
+		select {
+		case <-updated:
+		case <-ctx.Done():
+			return time.Time{}, false
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encoding
+
+import (
+	"fmt"
+
+	"github.com/golang/snappy"
+)
+
+const (
+	// compressionSnappy is the prefix of the proposal transactions compressed
+	// with the snappy block format.
+	compressionSnappy byte = 0x01
+
+	// MaxDecompressedTxSize is the maximum size of a decompressed proposal
+	// transaction. It is the CometBFT maximum block size, which bounds the
+	// uncompressed transactions, so that a small transaction cannot be
+	// decompressed into an arbitrarily large buffer.
+	MaxDecompressedTxSize = 100 << 20
+)
+
+// CompressTx compresses a proposal transaction. The compressed transaction is
+// the compression prefix followed by the snappy encoded bytes.
+func CompressTx(tx []byte) []byte {
+	compressed := make([]byte, 1+snappy.MaxEncodedLen(len(tx)))
+	compressed[0] = compressionSnappy
+	n := len(snappy.Encode(compressed[1:], tx))
+	return compressed[:1+n]
+}
+
+// DecompressTx decompresses a proposal transaction compressed with CompressTx.
+// The decompressed size is checked against MaxDecompressedTxSize before any
+// allocation.
+func DecompressTx(tx []byte) ([]byte, error) {
+	if len(tx) == 0 {
+		return nil, ErrInvalidCompressedTx
+	}
+	if tx[0] != compressionSnappy {
+		return nil, fmt.Errorf("%w: unknown compression prefix %#x", ErrInvalidCompressedTx, tx[0])
+	}
+	size, err := snappy.DecodedLen(tx[1:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCompressedTx, err)
+	}
+	if size > MaxDecompressedTxSize {
+		return nil, fmt.Errorf(
+			"%w: decompressed size %d exceeds %d", ErrCompressedTxTooLarge, size, MaxDecompressedTxSize,
+		)
+	}
+	decompressed, err := snappy.Decode(nil, tx[1:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCompressedTx, err)
+	}
+	return decompressed, nil
+}
+
+// DecompressTxs returns a copy of txs with the transactions at the given
+// indexes decompressed. Missing and nil transactions are left as is, for the
+// unmarshalling to report them.
+func DecompressTxs(txs [][]byte, indexes ...uint) ([][]byte, error) {
+	decompressed := make([][]byte, len(txs))
+	copy(decompressed, txs)
+	for _, index := range indexes {
+		if index >= uint(len(txs)) || txs[index] == nil {
+			continue
+		}
+		tx, err := DecompressTx(txs[index])
+		if err != nil {
+			return nil, fmt.Errorf("tx %d: %w", index, err)
+		}
+		decompressed[index] = tx
+	}
+	return decompressed, nil
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encoding_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressTxRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tx := bytes.Repeat([]byte{0xab, 0xcd}, 64<<10)
+	compressed := encoding.CompressTx(tx)
+	require.Less(t, len(compressed), len(tx))
+
+	decompressed, err := encoding.DecompressTx(compressed)
+	require.NoError(t, err)
+	require.Equal(t, tx, decompressed)
+}
+
+func TestDecompressTxInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := encoding.DecompressTx(nil)
+	require.ErrorIs(t, err, encoding.ErrInvalidCompressedTx)
+
+	// raw SSZ bytes, as in the proposals before the compression fork
+	_, err = encoding.DecompressTx([]byte{0x64, 0x00, 0x00, 0x00})
+	require.ErrorIs(t, err, encoding.ErrInvalidCompressedTx)
+
+	// truncated snappy data
+	compressed := encoding.CompressTx(bytes.Repeat([]byte{0x01}, 1024))
+	_, err = encoding.DecompressTx(compressed[:len(compressed)-1])
+	require.ErrorIs(t, err, encoding.ErrInvalidCompressedTx)
+}
+
+func TestDecompressTxTooLarge(t *testing.T) {
+	t.Parallel()
+
+	// A snappy header claiming a decompressed size past the limit is
+	// rejected before the decompression buffer is allocated.
+	tx := binary.AppendUvarint([]byte{0x01}, encoding.MaxDecompressedTxSize+1)
+	_, err := encoding.DecompressTx(tx)
+	require.ErrorIs(t, err, encoding.ErrCompressedTxTooLarge)
+
+	tx = encoding.CompressTx(make([]byte, encoding.MaxDecompressedTxSize+1))
+	_, err = encoding.DecompressTx(tx)
+	require.ErrorIs(t, err, encoding.ErrCompressedTxTooLarge)
+}
+
+func TestDecompressTxs(t *testing.T) {
+	t.Parallel()
+
+	blk, sidecars := []byte("block"), []byte("sidecars")
+	txs := [][]byte{encoding.CompressTx(blk), encoding.CompressTx(sidecars), []byte("other")}
+
+	decompressed, err := encoding.DecompressTxs(txs, 0, 1, 5)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{blk, sidecars, []byte("other")}, decompressed)
+	// the request transactions are left untouched
+	require.Equal(t, encoding.CompressTx(blk), txs[0])
+
+	_, err = encoding.DecompressTxs(txs, 2)
+	require.ErrorIs(t, err, encoding.ErrInvalidCompressedTx)
+}
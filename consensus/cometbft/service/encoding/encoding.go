@@ -30,19 +30,31 @@ import (
 )
 
 // ExtractBlobsAndBlockFromRequest extracts the blobs and block from an ABCI
-// request.
+// request. The block and blobs transactions are decompressed first if the
+// request is compressed, i.e. past the proposal compression fork; earlier
+// requests carry the raw SSZ bytes.
 func ExtractBlobsAndBlockFromRequest(
 	req ABCIRequest,
 	beaconBlkIndex uint,
 	blobSidecarsIndex uint,
 	forkVersion common.Version,
+	compressed bool,
 ) (*ctypes.SignedBeaconBlock, datypes.BlobSidecars, error) {
 	if req == nil {
 		return nil, nil, ErrNilABCIRequest
 	}
 
+	txs := req.GetTxs()
+	if compressed {
+		var err error
+		txs, err = DecompressTxs(txs, beaconBlkIndex, blobSidecarsIndex)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	blk, err := UnmarshalBeaconBlockFromABCIRequest(
-		req.GetTxs(),
+		txs,
 		beaconBlkIndex,
 		forkVersion,
 	)
@@ -51,7 +63,7 @@ func ExtractBlobsAndBlockFromRequest(
 	}
 
 	blobs, err := UnmarshalBlobSidecarsFromABCIRequest(
-		req.GetTxs(),
+		txs,
 		blobSidecarsIndex,
 	)
 
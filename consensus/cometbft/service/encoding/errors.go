@@ -49,4 +49,12 @@ var (
 
 	// ErrInvalidType is an error for when the type is invalid.
 	ErrInvalidType = errors.New("invalid type")
+
+	// ErrInvalidCompressedTx is an error for when a compressed transaction
+	// in an abci request cannot be decompressed.
+	ErrInvalidCompressedTx = errors.New("invalid compressed tx in abci request")
+
+	// ErrCompressedTxTooLarge is an error for when a compressed transaction
+	// in an abci request decompresses past MaxDecompressedTxSize.
+	ErrCompressedTxTooLarge = errors.New("compressed tx in abci request too large")
 )
@@ -257,6 +257,9 @@ func (s *Service) calculateFinalizeBlockResponse(
 		s.cmtConsensusParams.Feature.SBTEnableHeight = s.delayCfg.SbtConsensusEnableHeight()
 	}
 	nextBlockTime := s.nextBlockDelay(req)
+	if s.blockSchedule != nil {
+		s.blockSchedule.Set(req.Height+1, time.Now().Add(nextBlockTime))
+	}
 
 	// This result format is expected by Comet. That actual execution will happen as part of the state transition.
 	txsLen := len(req.Txs)
@@ -296,6 +299,7 @@ func (s *Service) calculateFinalizeBlockResponse(
 	// a failed attempt at the halt point could be mistaken for an already committed block on a subsequent call.
 	s.finalizedHeight = req.Height
 	s.finalizedTime = req.Time
+	s.lastFinalizedAt.Store(time.Now().UnixNano())
 
 	return response, nil
 }
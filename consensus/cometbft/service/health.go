@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/berachain/beacon-kit/node-core/services/health"
+)
+
+// HealthChecks returns the checks of the sync state of the node and of the
+// time since it last finalized a block.
+func (s *Service) HealthChecks() []health.Check {
+	checks := []health.Check{{Name: "consensus-sync", Run: s.checkSync}}
+	if s.maxFinalizedBlockAge > 0 {
+		checks = append(checks, health.Check{
+			Name: "finalized-block-age",
+			Run:  s.checkFinalizedBlockAge,
+		})
+	}
+	return checks
+}
+
+// checkSync fails until the first block is committed and reports the node
+// syncing while it catches up with the chain.
+func (s *Service) checkSync(context.Context) error {
+	if err := s.IsAppReady(); err != nil {
+		return err
+	}
+	latestHeight, syncToHeight := s.GetSyncData()
+	if syncToHeight > latestHeight {
+		return fmt.Errorf("%w: %d blocks behind", health.ErrSyncing, syncToHeight-latestHeight)
+	}
+	return nil
+}
+
+// checkFinalizedBlockAge fails if a synced node has not finalized a block
+// within the configured age. The sync state is reported by checkSync.
+func (s *Service) checkFinalizedBlockAge(context.Context) error {
+	if s.IsAppReady() != nil {
+		return nil
+	}
+	if latestHeight, syncToHeight := s.GetSyncData(); syncToHeight > latestHeight {
+		return nil
+	}
+	age := time.Since(time.Unix(0, s.lastFinalizedAt.Load()))
+	if age > s.maxFinalizedBlockAge {
+		return fmt.Errorf(
+			"no block finalized for %s, more than %s",
+			age.Truncate(time.Second), s.maxFinalizedBlockAge,
+		)
+	}
+	return nil
+}
@@ -33,3 +33,11 @@ type TelemetrySink interface {
 	// SetGauge sets a gauge metric to the specified value.
 	SetGauge(key string, value int64, args ...string)
 }
+
+// BlockProfiler observes the duration of FinalizeBlock, e.g. to profile the
+// node when blocks are slow.
+type BlockProfiler interface {
+	// ObserveFinalizeBlock observes the time taken to finalize the block at
+	// height.
+	ObserveFinalizeBlock(height int64, elapsed time.Duration)
+}
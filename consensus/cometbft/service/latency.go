@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"time"
+
+	p2pproto "github.com/cometbft/cometbft/api/cometbft/p2p/v1"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/conn"
+)
+
+const (
+	// latencyReactorName is the name the latency reactor is registered with.
+	latencyReactorName = "LATENCY"
+
+	// latencyPingChannel and latencyPongChannel are the p2p channels the
+	// latency probes are sent and answered on.
+	latencyPingChannel = byte(0xb0)
+	latencyPongChannel = byte(0xb1)
+
+	// latencyProbeInterval is the interval peers are probed at.
+	latencyProbeInterval = 10 * time.Second
+
+	// pingSentKey and latencyKey are the peer data keys holding the time the
+	// pending probe was sent at and the latency last measured.
+	pingSentKey = "latency.ping_sent"
+	latencyKey  = "latency.rtt"
+)
+
+// latencyReactor measures the round trip time to the peers. CometBFT only
+// uses its connection ping/pong as a keepalive and does not expose the round
+// trip time, hence peers are probed on a dedicated channel. Peers which do not
+// run the reactor do not advertise its channels and are not probed.
+type latencyReactor struct {
+	p2p.BaseReactor
+}
+
+// newLatencyReactor creates a new latency reactor.
+func newLatencyReactor() *latencyReactor {
+	r := &latencyReactor{}
+	r.BaseReactor = *p2p.NewBaseReactor("Latency", r)
+	return r
+}
+
+// GetChannels implements p2p.Reactor.
+func (r *latencyReactor) GetChannels() []*conn.ChannelDescriptor {
+	return []*conn.ChannelDescriptor{
+		{ID: latencyPingChannel, Priority: 1, MessageType: &p2pproto.PacketPing{}},
+		{ID: latencyPongChannel, Priority: 1, MessageType: &p2pproto.PacketPong{}},
+	}
+}
+
+// OnStart implements service.Service.
+func (r *latencyReactor) OnStart() error {
+	go r.probeLoop()
+	return nil
+}
+
+// AddPeer implements p2p.Reactor.
+func (r *latencyReactor) AddPeer(peer p2p.Peer) {
+	ping(peer)
+}
+
+// Receive implements p2p.Reactor.
+func (r *latencyReactor) Receive(e p2p.Envelope) {
+	switch e.ChannelID {
+	case latencyPingChannel:
+		e.Src.TrySend(p2p.Envelope{ChannelID: latencyPongChannel, Message: &p2pproto.PacketPong{}})
+	case latencyPongChannel:
+		// Unsolicited pongs are ignored.
+		sent, ok := e.Src.Get(pingSentKey).(time.Time)
+		if !ok {
+			return
+		}
+		e.Src.Set(pingSentKey, nil)
+		e.Src.Set(latencyKey, time.Since(sent))
+	}
+}
+
+// probeLoop probes every peer each latencyProbeInterval until the reactor
+// is stopped.
+func (r *latencyReactor) probeLoop() {
+	ticker := time.NewTicker(latencyProbeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.Quit():
+			return
+		case <-ticker.C:
+			r.Switch.Peers().ForEach(ping)
+		}
+	}
+}
+
+// ping sends a probe to the peer, unless one sent less than a probe interval
+// ago is still pending. Older probes are considered lost.
+func ping(peer p2p.Peer) {
+	if sent, pending := peer.Get(pingSentKey).(time.Time); pending && time.Since(sent) < latencyProbeInterval {
+		return
+	}
+	// The send time is recorded first, as the pong may be received before
+	// TrySend returns.
+	peer.Set(pingSentKey, time.Now())
+	if !peer.TrySend(p2p.Envelope{ChannelID: latencyPingChannel, Message: &p2pproto.PacketPing{}}) {
+		peer.Set(pingSentKey, nil)
+	}
+}
+
+// peerLatency returns the latency last measured to the peer, if any.
+func peerLatency(peer p2p.Peer) (time.Duration, bool) {
+	latency, ok := peer.Get(latencyKey).(time.Duration)
+	return latency, ok
+}
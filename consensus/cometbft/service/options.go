@@ -22,9 +22,12 @@ package cometbft
 
 import (
 	"fmt"
+	"time"
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/delay"
+	cmttypes "github.com/cometbft/cometbft/types"
 )
 
 // File for storing in-package cometbft optional functions,
@@ -105,3 +108,26 @@ func SetInterBlockCache(cache storetypes.MultiStorePersistentCache) func(*Servic
 func SetChainID(chainID string) func(*Service) {
 	return func(s *Service) { s.chainID = chainID }
 }
+
+// SetPrivValidator sets the private validator CometBFT signs consensus
+// messages with, in place of the one loaded from the privval key file.
+func SetPrivValidator(privVal cmttypes.PrivValidator) func(*Service) {
+	return func(s *Service) { s.privVal = privVal }
+}
+
+// SetMaxFinalizedBlockAge sets the time after which a synced node which has
+// not finalized a block is reported unhealthy.
+func SetMaxFinalizedBlockAge(age time.Duration) func(*Service) {
+	return func(s *Service) { s.maxFinalizedBlockAge = age }
+}
+
+// SetBlockProfiler sets the profiler observing the duration of FinalizeBlock.
+func SetBlockProfiler(profiler BlockProfiler) func(*Service) {
+	return func(s *Service) { s.blockProfiler = profiler }
+}
+
+// SetBlockSchedule sets the schedule the expected proposal time of the next
+// block is published to.
+func SetBlockSchedule(schedule *delay.Schedule) func(*Service) {
+	return func(s *Service) { s.blockSchedule = schedule }
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/node-core/types/network"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+// ErrNodeNotStarted is returned when querying the p2p network of a node which
+// has not been started yet.
+var ErrNodeNotStarted = errors.New("cometbft node is not started")
+
+// NodeIdentity returns the identity of the node on the CometBFT p2p network.
+func (s *Service) NodeIdentity() (*network.NodeIdentity, error) {
+	if s.node == nil {
+		return nil, ErrNodeNotStarted
+	}
+	info, ok := s.node.NodeInfo().(p2p.DefaultNodeInfo)
+	if !ok {
+		return nil, fmt.Errorf("unexpected node info type %T", s.node.NodeInfo())
+	}
+	return &network.NodeIdentity{
+		ID:            string(info.ID()),
+		ListenAddress: info.ListenAddr,
+		Moniker:       info.Moniker,
+		Network:       info.Network,
+		Version:       info.Version,
+	}, nil
+}
+
+// Peers returns the peers the node is connected to.
+func (s *Service) Peers() []*network.PeerInfo {
+	if s.node == nil {
+		return nil
+	}
+	peerSet := s.node.Switch().Peers()
+	peers := make([]*network.PeerInfo, 0, peerSet.Size())
+	peerSet.ForEach(func(peer p2p.Peer) {
+		peers = append(peers, peerInfo(peer))
+	})
+	return peers
+}
+
+// peerInfo maps a CometBFT peer to its description.
+func peerInfo(peer p2p.Peer) *network.PeerInfo {
+	status := peer.Status()
+	info := &network.PeerInfo{
+		ID:            string(peer.ID()),
+		RemoteAddress: peer.RemoteAddr().String(),
+		Outbound:      peer.IsOutbound(),
+		Persistent:    peer.IsPersistent(),
+		ConnectedFor:  status.Duration,
+		BytesSent:     status.SendMonitor.Bytes,
+		BytesReceived: status.RecvMonitor.Bytes,
+		SendRate:      status.SendMonitor.AvgRate,
+		ReceiveRate:   status.RecvMonitor.AvgRate,
+	}
+	if latency, ok := peerLatency(peer); ok {
+		info.Latency = latency
+	}
+	if nodeInfo, ok := peer.NodeInfo().(p2p.DefaultNodeInfo); ok {
+		info.ListenAddress = nodeInfo.ListenAddr
+		info.Moniker = nodeInfo.Moniker
+	}
+	return info
+}
@@ -25,11 +25,18 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
 	"github.com/berachain/beacon-kit/consensus/types"
 	"github.com/berachain/beacon-kit/primitives/math"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 )
 
+// proposalTxNames are the names of the proposal transactions in the metrics,
+// by index.
+//
+//nolint:gochecknoglobals // read-only.
+var proposalTxNames = [...]string{"beacon_block", "blob_sidecars"}
+
 func (s *Service) prepareProposal(
 	ctx context.Context,
 	req *cmtabci.PrepareProposalRequest,
@@ -84,7 +91,33 @@ func (s *Service) prepareProposal(
 		return &cmtabci.PrepareProposalResponse{Txs: [][]byte{}}, nil
 	}
 
-	return &cmtabci.PrepareProposalResponse{
-		Txs: [][]byte{blkBz, sidecarsBz},
-	}, nil
+	txs := [][]byte{blkBz, sidecarsBz}
+	if s.chainSpec.IsProposalCompressed(math.U64(req.GetTime().Unix())) { //#nosec: G115
+		txs = s.compressProposal(txs)
+	}
+	return &cmtabci.PrepareProposalResponse{Txs: txs}, nil
+}
+
+// compressProposal compresses the beacon block and blob sidecars transactions
+// of a proposal, reporting their sizes and compression ratios.
+func (s *Service) compressProposal(txs [][]byte) [][]byte {
+	compressed := make([][]byte, len(txs))
+	for i, tx := range txs {
+		compressed[i] = encoding.CompressTx(tx)
+
+		name := proposalTxNames[i]
+		s.telemetrySink.SetGauge(
+			"beacon_kit.runtime.proposal_tx_size_bytes", int64(len(tx)), "tx", name, "encoding", "ssz",
+		)
+		s.telemetrySink.SetGauge(
+			"beacon_kit.runtime.proposal_tx_size_bytes", int64(len(compressed[i])), "tx", name, "encoding", "snappy",
+		)
+		if len(tx) > 0 {
+			s.telemetrySink.SetGauge(
+				"beacon_kit.runtime.proposal_tx_compression_ratio_percent",
+				int64(len(compressed[i])*100/len(tx)), "tx", name,
+			)
+		}
+	}
+	return compressed
 }
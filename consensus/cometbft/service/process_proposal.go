@@ -64,6 +64,10 @@ func (s *Service) processProposal(
 	// errors to consensus indicate that the node was not able to understand
 	// whether the block was valid or not. Viceversa, we signal that a block
 	// is invalid by its status, but we do return nil error in such a case.
+	// A proposal whose payload is not verified by the execution client is not
+	// cached, so that FinalizeBlock processes it again and hands its payload
+	// over to the execution client once the block is finalized.
+	verifiesPayloads := s.Blockchain.VerifiesProposalPayloads(processProposalState.Context())
 	valUpdates, err := s.Blockchain.ProcessProposal(
 		processProposalState.Context(),
 		req,
@@ -86,7 +90,7 @@ func (s *Service) processProposal(
 	// TODO: before Stable block time activation we keep caching off
 	// to make sure chain does not get faster. Once activated, we can
 	// active as if cache was always active.
-	if cache.IsStateCachingActive(s.delayCfg, math.Slot(req.Height)) {
+	if verifiesPayloads && cache.IsStateCachingActive(s.delayCfg, math.Slot(req.Height)) {
 		stateHash := string(req.Hash)
 		toCache := &cache.Element{
 			State:      processProposalState,
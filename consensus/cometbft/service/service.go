@@ -24,6 +24,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"cosmossdk.io/store/rootmulti"
@@ -61,8 +62,15 @@ type Service struct {
 	node        *node.Node
 	nodeAddress cmtcrypto.Address
 
+	// privVal, if set, is used in place of the private validator loaded
+	// from the privval key file.
+	privVal cmttypes.PrivValidator
+
 	delayCfg delay.ConfigGetter
 
+	// chainSpec gates the compression of the proposal transactions.
+	chainSpec chain.Spec
+
 	// cmtConsensusParams are part of the blockchain state and
 	// are agreed upon by all validators in the network.
 	cmtConsensusParams *cmttypes.ConsensusParams
@@ -116,8 +124,22 @@ type Service struct {
 	// NOTE: may be nil until either InitChain or FinalizeBlock is called.
 	blockDelay *delay.BlockDelay
 
+	// blockSchedule, if set, is updated with the expected proposal time of
+	// the next block once it is computed in FinalizeBlock.
+	blockSchedule *delay.Schedule
+
+	// blockProfiler, if set, observes the duration of FinalizeBlock.
+	blockProfiler BlockProfiler
+
 	// syncingToHeight is a helper to track node sync state and support node-apis.
 	syncingToHeight int64
+
+	// maxFinalizedBlockAge is the time after which a synced node which has
+	// not finalized a block is reported unhealthy. Zero disables the check.
+	maxFinalizedBlockAge time.Duration
+	// lastFinalizedAt is the unix time, in nanoseconds, at which the node
+	// last finalized a block, or started. It is read by the health checks.
+	lastFinalizedAt atomic.Int64
 }
 
 func NewService(
@@ -149,6 +171,7 @@ func NewService(
 		Blockchain:         blockchain,
 		BlockBuilder:       blockBuilder,
 		delayCfg:           cs,
+		chainSpec:          cs,
 		cmtConsensusParams: cmtConsensusParams,
 		cmtCfg:             cmtCfg,
 		telemetrySink:      telemetrySink,
@@ -202,6 +225,14 @@ func NewService(
 		panic(fmt.Errorf("failed pruning orphaned blobs: %w", err))
 	}
 
+	// Rebuild the block store indices that may be missing after a restart.
+	if lastBlockHeight > 0 {
+		ctx := sdk.NewContext(s.sm.GetCommitMultiStore().CacheMultiStore(), true, servercmtlog.WrapSDKLogger(s.logger))
+		if err = s.Blockchain.BackfillBlockStore(ctx); err != nil {
+			panic(fmt.Errorf("failed backfilling block store: %w", err))
+		}
+	}
+
 	return s
 }
 
@@ -246,13 +277,16 @@ func (s *Service) Start(
 		return err
 	}
 
-	privVal, err := pvm.LoadOrGenFilePV(
-		cfg.PrivValidatorKeyFile(),
-		cfg.PrivValidatorStateFile(),
-		nil,
-	)
-	if err != nil {
-		return err
+	privVal := s.privVal
+	if privVal == nil {
+		privVal, err = pvm.LoadOrGenFilePV(
+			cfg.PrivValidatorKeyFile(),
+			cfg.PrivValidatorStateFile(),
+			nil,
+		)
+		if err != nil {
+			return err
+		}
 	}
 
 	s.ResetAppCtx(ctx)
@@ -266,6 +300,7 @@ func (s *Service) Start(
 		cmtcfg.DefaultDBProvider,
 		node.DefaultMetricsProvider(cfg.Instrumentation),
 		servercmtlog.WrapCometLogger(s.logger),
+		node.CustomReactors(map[string]p2p.Reactor{latencyReactorName: newLatencyReactor()}),
 	)
 	if err != nil {
 		return err
@@ -277,6 +312,7 @@ func (s *Service) Start(
 	}
 	s.nodeAddress = pubKey.Address()
 
+	s.lastFinalizedAt.Store(time.Now().UnixNano())
 	started := make(chan struct{})
 
 	// we start the node in a goroutine since calling Start() can block if genesis
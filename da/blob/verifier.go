@@ -28,12 +28,17 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/da/kzg"
 	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 )
 
+var tracer = tracing.Tracer("github.com/berachain/beacon-kit/da/blob")
+
 // verifier is responsible for verifying blobs, including their
 // inclusion and KZG proofs.
 type verifier struct {
@@ -61,12 +66,16 @@ func (bv *verifier) verifySidecars(
 	sidecars datypes.BlobSidecars,
 	blkHeader *ctypes.BeaconBlockHeader,
 	kzgCommitments eip4844.KZGCommitments[common.ExecutionHash],
-) error {
+) (err error) {
 	numSidecars := uint64(len(sidecars))
 	defer bv.metrics.measureVerifySidecarsDuration(
 		time.Now(), math.U64(numSidecars),
 		bv.proofVerifier.GetImplementation(),
 	)
+	ctx, span := tracer.Start(ctx, "verifySidecars",
+		trace.WithAttributes(attribute.Int("num_sidecars", len(sidecars))),
+	)
+	defer func() { tracing.End(span, err) }()
 
 	g, _ := errgroup.WithContext(ctx)
 
@@ -106,12 +115,12 @@ func (bv *verifier) verifySidecars(
 
 	// Verify the inclusion proofs on the blobs concurrently.
 	g.Go(func() error {
-		return bv.verifyInclusionProofs(sidecars)
+		return bv.verifyInclusionProofs(ctx, sidecars)
 	})
 
 	// Verify the KZG proofs on the blobs concurrently.
 	g.Go(func() error {
-		return bv.verifyKZGProofs(sidecars)
+		return bv.verifyKZGProofs(ctx, sidecars)
 	})
 
 	// Wait for all goroutines to finish and return the result.
@@ -119,25 +128,34 @@ func (bv *verifier) verifySidecars(
 }
 
 func (bv *verifier) verifyInclusionProofs(
+	ctx context.Context,
 	scs datypes.BlobSidecars,
 ) error {
 	startTime := time.Now()
 	defer bv.metrics.measureVerifyInclusionProofsDuration(
 		startTime, math.U64(len(scs)),
 	)
+	_, span := tracer.Start(ctx, "verifyInclusionProofs")
 
-	return scs.VerifyInclusionProofs()
+	err := scs.VerifyInclusionProofs()
+	tracing.End(span, err)
+	return err
 }
 
 // verifyKZGProofs verifies the sidecars.
 func (bv *verifier) verifyKZGProofs(
+	ctx context.Context,
 	scs datypes.BlobSidecars,
-) error {
+) (err error) {
 	start := time.Now()
 	defer bv.metrics.measureVerifyKZGProofsDuration(
 		start, math.U64(len(scs)),
 		bv.proofVerifier.GetImplementation(),
 	)
+	_, span := tracer.Start(ctx, "verifyKZGProofs",
+		trace.WithAttributes(attribute.String("implementation", bv.proofVerifier.GetImplementation())),
+	)
+	defer func() { tracing.End(span, err) }()
 
 	switch len(scs) {
 	case 0:
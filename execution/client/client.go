@@ -32,6 +32,7 @@ import (
 	ethclient "github.com/berachain/beacon-kit/execution/client/ethclient"
 	ethclientrpc "github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/services/health"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/net/jwt"
 )
@@ -165,6 +166,19 @@ func (s *EngineClient) IsConnected() bool {
 	return s.connected
 }
 
+// HealthChecks returns the check of the connection to the execution client.
+func (s *EngineClient) HealthChecks() []health.Check {
+	return []health.Check{{
+		Name: "execution-client",
+		Run: func(context.Context) error {
+			if !s.IsConnected() {
+				return ErrNotConnected
+			}
+			return nil
+		},
+	}}
+}
+
 func (s *EngineClient) HasCapability(capability string) bool {
 	_, ok := s.capabilities[capability]
 	return ok
@@ -246,3 +260,7 @@ func (s *EngineClient) GetRPCRetryInterval() time.Duration {
 func (s *EngineClient) GetRPCMaxRetryInterval() time.Duration {
 	return s.cfg.RPCMaxRetryInterval
 }
+
+func (s *EngineClient) GetOptimistic() OptimisticConfig {
+	return s.cfg.Optimistic
+}
@@ -34,6 +34,8 @@ const (
 	defaultRPCMaxRetryInterval     = 10 * time.Second
 	defaultRPCStartupCheckInterval = 3 * time.Second
 	defaultRPCJWTRefreshInterval   = 30 * time.Second
+	defaultOptimisticOutageTimeout = 30 * time.Second
+	defaultOptimisticMaxBacklog    = 1024
 	//#nosec:G101 // false positive.
 	defaultJWTSecretPath = "./jwt.hex"
 )
@@ -50,6 +52,11 @@ func DefaultConfig() Config {
 		RPCStartupCheckInterval: defaultRPCStartupCheckInterval,
 		RPCJWTRefreshInterval:   defaultRPCJWTRefreshInterval,
 		JWTSecretPath:           defaultJWTSecretPath,
+		Optimistic: OptimisticConfig{
+			Enabled:       false,
+			OutageTimeout: defaultOptimisticOutageTimeout,
+			MaxBacklog:    defaultOptimisticMaxBacklog,
+		},
 	}
 }
 
@@ -71,4 +78,21 @@ type Config struct {
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// Optimistic is the configuration of the optimistic mode of the engine.
+	Optimistic OptimisticConfig `mapstructure:"optimistic"`
+}
+
+// OptimisticConfig is the configuration of the optimistic mode, in which a
+// non-validator node keeps processing blocks while the execution client is
+// unavailable and replays their payloads to it once it is back.
+type OptimisticConfig struct {
+	// Enabled enables the optimistic mode. It is never used by the nodes in
+	// the validator set, which must verify the payloads they vote for.
+	Enabled bool `mapstructure:"enabled"`
+	// OutageTimeout is how long a request to the execution client is retried
+	// before the node continues optimistically.
+	OutageTimeout time.Duration `mapstructure:"outage-timeout"`
+	// MaxBacklog is the maximum number of payloads held for the execution
+	// client. Block processing stalls once the backlog is full.
+	MaxBacklog int `mapstructure:"max-backlog"`
 }
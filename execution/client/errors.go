@@ -48,6 +48,10 @@ var (
 	// The request itself is rejected (oversized body, bad request, etc.) and
 	// retrying with the same payload will never succeed. Classified as fatal.
 	ErrHTTPClientError = errors.New("http client error")
+
+	// ErrNotConnected is reported by the health check of the client while it
+	// is not connected to the execution client.
+	ErrNotConnected = errors.New("not connected to the execution client")
 )
 
 // Handles errors received from the RPC server according to the specification.
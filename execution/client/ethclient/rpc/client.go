@@ -30,10 +30,17 @@ import (
 	"time"
 
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/encoding/json"
 	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = tracing.Tracer("github.com/berachain/beacon-kit/execution/client/ethclient/rpc")
+
 var _ Client = (*client)(nil)
 
 type Client interface {
@@ -135,7 +142,16 @@ func (rpc *client) Call(
 	target any,
 	method string,
 	params ...any,
-) error {
+) (err error) {
+	ctx, span := tracer.Start(ctx, method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "jsonrpc"),
+			attribute.String("rpc.method", method),
+		),
+	)
+	defer func() { tracing.End(span, err) }()
+
 	result, err := rpc.callRaw(ctx, method, params...)
 	if err != nil {
 		return err
@@ -183,6 +199,9 @@ func (rpc *client) callRaw(
 	req.Header = rpc.header.Clone()
 	rpc.mu.RUnlock()
 
+	// Propagate the trace context to the execution client.
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
 	response, err := rpc.client.Do(req) //#nosec:G704 // URL is operator-configured RPC endpoint.
 	if err != nil {
 		// handle error as a transport level failure (connection refused, DNS, TLS, body EOF, ctx cancellation, etc)
@@ -77,39 +77,38 @@ func (cm *clientMetrics) measureGetPayloadDuration(startTime time.Time) {
 // general engine api timeouts.
 func (cm *clientMetrics) incrementEngineAPITimeout() {
 	cm.incrementTimeoutCounter(
-		"beacon_kit.execution.client.engine_api")
+		"beacon_kit.execution.client.engine_api_timeout")
 }
 
 // incrementForkchoiceUpdateTimeout increments the timeout counter
 // for forkchoice update.
 func (cm *clientMetrics) incrementForkchoiceUpdateTimeout() {
 	cm.incrementTimeoutCounter(
-		"beacon_kit.execution.client.forkchoice_update_duration")
+		"beacon_kit.execution.client.forkchoice_update_duration_timeout")
 }
 
 // incrementNewPayloadTimeout increments the timeout counter for
 // new payload.
 func (cm *clientMetrics) incrementNewPayloadTimeout() {
 	cm.incrementTimeoutCounter(
-		"beacon_kit.execution.client.new_payload_duration")
+		"beacon_kit.execution.client.new_payload_duration_timeout")
 }
 
 // incrementGetPayloadTimeout increments the timeout counter for
 // get payload.
 func (cm *clientMetrics) incrementGetPayloadTimeout() {
 	cm.incrementTimeoutCounter(
-		"beacon_kit.execution.client.get_payload_duration")
+		"beacon_kit.execution.client.get_payload_duration_timeout")
 }
 
 // incrementHTTPTimeout increments the timeout counter for HTTP.
 func (cm *clientMetrics) incrementHTTPTimeoutCounter() {
-	cm.incrementTimeoutCounter("beacon_kit.execution.client.http")
+	cm.incrementTimeoutCounter("beacon_kit.execution.client.http_timeout")
 }
 
-// incrementTimeoutCounter increments the timeout counter for
-// the given metric.
+// incrementTimeoutCounter increments the given timeout counter.
 func (cm *clientMetrics) incrementTimeoutCounter(metricName string) {
-	cm.sink.IncrementCounter(metricName + "_timeout")
+	cm.sink.IncrementCounter(metricName)
 }
 
 // incrementParseErrorCounter increments the parse error counter
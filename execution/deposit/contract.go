@@ -71,11 +71,12 @@ func (dc *WrappedDepositContract) LastBlockNumber() math.U64 {
 	return math.U64(dc.lastBlockNumber.Load())
 }
 
-// ReadDeposits reads deposits from the deposit contract.
+// ReadDeposits reads deposits from the deposit contract, along with the hash
+// of the block they were read from. The hash is empty if there are none.
 func (dc *WrappedDepositContract) ReadDeposits(
 	ctx context.Context,
 	blockNumber math.U64,
-) ([]*ctypes.Deposit, error) {
+) ([]*ctypes.Deposit, common.ExecutionHash, error) {
 	logs, err := dc.FilterDeposit(
 		&bind.FilterOpts{
 			Context: ctx,
@@ -84,12 +85,15 @@ func (dc *WrappedDepositContract) ReadDeposits(
 		},
 	)
 	if err != nil {
-		return nil, err
+		return nil, common.ExecutionHash{}, err
 	}
 
 	defer logs.Close()
 
-	deposits := make([]*ctypes.Deposit, 0)
+	var (
+		deposits  = make([]*ctypes.Deposit, 0)
+		blockHash common.ExecutionHash
+	)
 	for logs.Next() {
 		var (
 			cred   bytes.B32
@@ -98,15 +102,15 @@ func (dc *WrappedDepositContract) ReadDeposits(
 		)
 		pubKey, err = bytes.ToBytes48(logs.Event.Pubkey)
 		if err != nil {
-			return nil, fmt.Errorf("failed reading pub key: %w", err)
+			return nil, common.ExecutionHash{}, fmt.Errorf("failed reading pub key: %w", err)
 		}
 		cred, err = bytes.ToBytes32(logs.Event.Credentials)
 		if err != nil {
-			return nil, fmt.Errorf("failed reading credentials: %w", err)
+			return nil, common.ExecutionHash{}, fmt.Errorf("failed reading credentials: %w", err)
 		}
 		sign, err = bytes.ToBytes96(logs.Event.Signature)
 		if err != nil {
-			return nil, fmt.Errorf("failed reading signature: %w", err)
+			return nil, common.ExecutionHash{}, fmt.Errorf("failed reading signature: %w", err)
 		}
 		deposit := &ctypes.Deposit{
 			Pubkey:      pubKey,
@@ -116,13 +120,14 @@ func (dc *WrappedDepositContract) ReadDeposits(
 			Index:       logs.Event.Index,
 		}
 		deposits = append(deposits, deposit)
+		blockHash = common.ExecutionHash(logs.Event.Raw.BlockHash)
 	}
 
 	if err = logs.Error(); err != nil {
-		return nil, fmt.Errorf("deposit log iterator: %w", err)
+		return nil, common.ExecutionHash{}, fmt.Errorf("deposit log iterator: %w", err)
 	}
 
-	return deposits, nil
+	return deposits, blockHash, nil
 }
 
 // SetLastBlockNumber sets the last block number that was successfully
@@ -24,12 +24,13 @@ import (
 	"context"
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
 // Contract is the ABI for the deposit contract.
 type Contract interface {
 	LastBlockNumber() math.U64
-	ReadDeposits(ctx context.Context, blockNumber math.U64) ([]*ctypes.Deposit, error)
+	ReadDeposits(ctx context.Context, blockNumber math.U64) ([]*ctypes.Deposit, common.ExecutionHash, error)
 	SetLastBlockNumber(blockNumber math.U64)
 }
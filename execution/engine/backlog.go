@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine
+
+import (
+	"sync"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// backlog holds, in order, the requests the execution client missed while the
+// engine was optimistic. It is only held in memory: the requests missed before
+// a restart are lost, and the execution client must then sync those blocks
+// from its peers once the node sends it the forkchoice of its head.
+type backlog struct {
+	mu sync.Mutex
+	// payloads are the new payload requests, oldest first.
+	payloads []ctypes.NewPayloadRequest
+	// hashes are the block hashes of the payloads, to not queue a payload
+	// twice, e.g. if a block is finalized again after a failed attempt.
+	hashes map[common.ExecutionHash]struct{}
+	// forkchoice is the latest forkchoice update, sent once the payloads are.
+	forkchoice *ctypes.ForkchoiceUpdateRequest
+	// maxPayloads is the maximum number of payloads held.
+	maxPayloads int
+	// halted is set once the execution client rejected a request of the
+	// backlog, after which the backlog is no longer replayed.
+	halted error
+}
+
+// newBacklog creates a backlog holding up to maxPayloads payloads.
+func newBacklog(maxPayloads int) *backlog {
+	return &backlog{
+		hashes:      make(map[common.ExecutionHash]struct{}),
+		maxPayloads: maxPayloads,
+	}
+}
+
+// pending returns true if the backlog holds requests.
+func (b *backlog) pending() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.payloads) > 0 || b.forkchoice != nil
+}
+
+// len returns the number of payloads held.
+func (b *backlog) len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.payloads)
+}
+
+// pushPayload queues the payload request. It returns false if the backlog is
+// full.
+func (b *backlog) pushPayload(req ctypes.NewPayloadRequest) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	hash := req.GetExecutionPayload().GetBlockHash()
+	if _, ok := b.hashes[hash]; ok {
+		return true
+	}
+	if len(b.payloads) >= b.maxPayloads {
+		return false
+	}
+	b.payloads = append(b.payloads, req)
+	b.hashes[hash] = struct{}{}
+	return true
+}
+
+// peekPayload returns the oldest payload request, if any.
+func (b *backlog) peekPayload() (ctypes.NewPayloadRequest, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.payloads) == 0 {
+		return nil, false
+	}
+	return b.payloads[0], true
+}
+
+// popPayload removes the oldest payload request, which must be req.
+func (b *backlog) popPayload(req ctypes.NewPayloadRequest) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.payloads) == 0 || b.payloads[0] != req {
+		return
+	}
+	b.payloads[0] = nil
+	b.payloads = b.payloads[1:]
+	delete(b.hashes, req.GetExecutionPayload().GetBlockHash())
+}
+
+// setForkchoice replaces the forkchoice update to send.
+func (b *backlog) setForkchoice(req *ctypes.ForkchoiceUpdateRequest) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.forkchoice = req
+}
+
+// peekForkchoice returns the forkchoice update to send once the payloads are,
+// if any.
+func (b *backlog) peekForkchoice() (*ctypes.ForkchoiceUpdateRequest, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.payloads) > 0 || b.forkchoice == nil {
+		return nil, false
+	}
+	return b.forkchoice, true
+}
+
+// popForkchoice removes the forkchoice update, unless it was replaced by a
+// newer one since req was sent.
+func (b *backlog) popForkchoice(req *ctypes.ForkchoiceUpdateRequest) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.forkchoice == req {
+		b.forkchoice = nil
+	}
+}
+
+// halt stops the replay of the backlog because of err.
+func (b *backlog) halt(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.halted = err
+}
+
+// haltedBy returns the error which stopped the replay of the backlog, if any.
+func (b *backlog) haltedBy() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.halted
+}
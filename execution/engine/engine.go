@@ -43,6 +43,9 @@ type Engine struct {
 	logger log.Logger
 	// metrics is the metrics for the engine.
 	metrics *engineMetrics
+	// backlog holds the requests the execution client missed while the
+	// engine was optimistic. It is nil unless the optimistic mode is enabled.
+	backlog *backlog
 }
 
 // New creates a new Engine.
@@ -51,10 +54,20 @@ func New(
 	logger log.Logger,
 	telemtrySink TelemetrySink,
 ) *Engine {
+	var requests *backlog
+	if cfg := engineClient.GetOptimistic(); cfg.Enabled {
+		requests = newBacklog(max(cfg.MaxBacklog, 1))
+		logger.Info(
+			"Optimistic mode enabled, blocks are processed while the execution client is unavailable",
+			"outage_timeout", cfg.OutageTimeout,
+			"max_backlog", cfg.MaxBacklog,
+		)
+	}
 	return &Engine{
 		ec:      engineClient,
 		logger:  logger,
 		metrics: newEngineMetrics(telemtrySink, logger),
+		backlog: requests,
 	}
 }
 
@@ -79,7 +92,13 @@ func (ee *Engine) NotifyForkchoiceUpdate(
 		hasPayloadAttributes = req.PayloadAttributes != nil
 	)
 
-	return backoff.Retry(
+	// While optimistic, the forkchoice update would point the execution
+	// client to payloads it misses, so it is sent once they are.
+	if ee.optimistic(ctx) && ee.backlog.pending() {
+		return ee.queueForkchoiceUpdate(req)
+	}
+
+	payloadID, err := backoff.Retry(
 		ctx,
 		func() (*engineprimitives.PayloadID, error) {
 			// Log and call the forkchoice update.
@@ -151,9 +170,14 @@ func (ee *Engine) NotifyForkchoiceUpdate(
 			}
 		},
 		backoff.WithBackOff(engineAPIBackoff),
-		backoff.WithMaxTries(0),       // 0 for infinite retries.
-		backoff.WithMaxElapsedTime(0), // 0 for infinite max elapsed time.
+		backoff.WithMaxTries(0), // 0 for infinite retries.
+		// Infinite max elapsed time, unless the engine may continue optimistically.
+		backoff.WithMaxElapsedTime(ee.maxElapsedTime(ctx)),
 	)
+	if ee.optimistic(ctx) && isOutage(ctx, err) {
+		return ee.queueForkchoiceUpdate(req)
+	}
+	return payloadID, err
 }
 
 // NotifyNewPayload notifies the execution client of the new payload.
@@ -170,6 +194,12 @@ func (ee *Engine) NotifyNewPayload(
 		payloadParentHash = req.GetExecutionPayload().GetParentHash()
 	)
 
+	// While optimistic, the execution client misses the parents of the
+	// payload, so it joins the backlog.
+	if ee.optimistic(ctx) && ee.backlog.pending() {
+		return ee.queuePayload(ctx, req)
+	}
+
 	_, err := backoff.Retry(
 		ctx,
 		func() (*common.ExecutionHash, error) {
@@ -257,9 +287,13 @@ func (ee *Engine) NotifyNewPayload(
 			}
 		},
 		backoff.WithBackOff(engineAPIBackoff),
-		backoff.WithMaxTries(0),       // 0 for infinite retries.
-		backoff.WithMaxElapsedTime(0), // 0 for infinite max elapsed time.
+		backoff.WithMaxTries(0), // 0 for infinite retries.
+		// Infinite max elapsed time, unless the engine may continue optimistically.
+		backoff.WithMaxElapsedTime(ee.maxElapsedTime(ctx)),
 	)
+	if ee.optimistic(ctx) && isOutage(ctx, err) {
+		return ee.queuePayload(ctx, req)
+	}
 	return err
 }
 
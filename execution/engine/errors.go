@@ -35,4 +35,14 @@ var (
 	ErrNilPayloadOnValidResponse = errors.New(
 		"received nil payload ID on VALID engine response",
 	)
+
+	// ErrExecutionOptimistic is returned when a payload is requested while
+	// the execution client is behind the backlog of the optimistic mode.
+	ErrExecutionOptimistic = errors.New(
+		"execution client is behind, cannot build payloads while optimistic",
+	)
+
+	// errBacklogFull is returned while the backlog of the optimistic mode
+	// cannot take more payloads.
+	errBacklogFull = errors.New("optimistic backlog is full")
 )
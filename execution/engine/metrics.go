@@ -85,9 +85,9 @@ func (em *engineMetrics) markNewPayloadAcceptedSyncingPayloadStatus(
 	payloadHash common.ExecutionHash,
 	parentHash common.ExecutionHash,
 ) {
-	status := "accepted"
+	status, metricName := "accepted", "beacon_kit.execution.engine.new_payload_accepted_payload_status"
 	if errors.Is(errStatus, engineerrors.ErrSyncingPayloadStatus) {
-		status = "syncing"
+		status, metricName = "syncing", "beacon_kit.execution.engine.new_payload_syncing_payload_status"
 	}
 	em.logger.Warn(
 		fmt.Sprintf("Received %s payload status during new payload. Awaiting execution client to finish sync.", status),
@@ -95,9 +95,7 @@ func (em *engineMetrics) markNewPayloadAcceptedSyncingPayloadStatus(
 		"parent_hash", parentHash,
 	)
 
-	em.sink.IncrementCounter(
-		fmt.Sprintf("beacon_kit.execution.engine.new_payload_%s_payload_status", status),
-	)
+	em.sink.IncrementCounter(metricName)
 }
 
 // markNewPayloadInvalidPayloadStatus increments the counter
@@ -294,3 +292,17 @@ func (em *engineMetrics) markForkchoiceUpdateUndefinedError(err error) {
 		"error", err.Error(),
 	)
 }
+
+// markOptimisticPayloadQueued increments the counter for payloads queued
+// while the engine is optimistic.
+func (em *engineMetrics) markOptimisticPayloadQueued(backlog int) {
+	em.sink.IncrementCounter("beacon_kit.execution.engine.optimistic_payload_queued")
+	em.sink.SetGauge("beacon_kit.execution.engine.optimistic_backlog", int64(backlog))
+}
+
+// markOptimisticPayloadReplayed increments the counter for queued payloads
+// replayed to the execution client.
+func (em *engineMetrics) markOptimisticPayloadReplayed(backlog int) {
+	em.sink.IncrementCounter("beacon_kit.execution.engine.optimistic_payload_replayed")
+	em.sink.SetGauge("beacon_kit.execution.engine.optimistic_backlog", int64(backlog))
+}
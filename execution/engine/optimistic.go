@@ -0,0 +1,268 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/node-core/services/health"
+	"github.com/cenkalti/backoff/v5"
+)
+
+// optimisticKey is the key of the contexts allowing the engine to continue
+// optimistically.
+type optimisticKey struct{}
+
+// WithOptimistic returns a copy of ctx which allows the engine, if its
+// optimistic mode is enabled, to queue the requests the execution client
+// cannot serve instead of retrying them forever. It must never be used for the
+// nodes in the validator set, which must verify the payloads they vote for.
+func WithOptimistic(ctx context.Context) context.Context {
+	return context.WithValue(ctx, optimisticKey{}, true)
+}
+
+// IsOptimistic returns true while the execution client misses payloads of
+// the blocks processed by the node, i.e. until the backlog is replayed.
+func (ee *Engine) IsOptimistic() bool {
+	return ee.backlog != nil && ee.backlog.pending()
+}
+
+// optimistic returns true if the engine may continue optimistically for ctx.
+// It may not once the execution client rejected the backlog, as the node
+// would otherwise keep finalizing blocks the execution client never applies.
+func (ee *Engine) optimistic(ctx context.Context) bool {
+	allowed, _ := ctx.Value(optimisticKey{}).(bool)
+	return allowed && ee.backlog != nil && ee.backlog.haltedBy() == nil
+}
+
+// HealthChecks returns the health check of the backlog of the optimistic
+// mode, if enabled. The node is syncing while the backlog is replayed and
+// unhealthy once the execution client rejected it.
+func (ee *Engine) HealthChecks() []health.Check {
+	if ee.backlog == nil {
+		return nil
+	}
+	return []health.Check{{
+		Name: "execution-backlog",
+		Run: func(context.Context) error {
+			if err := ee.backlog.haltedBy(); err != nil {
+				return err
+			}
+			if ee.backlog.pending() {
+				return fmt.Errorf(
+					"%d payloads to replay to the execution client: %w",
+					ee.backlog.len(), health.ErrSyncing,
+				)
+			}
+			return nil
+		},
+	}}
+}
+
+// maxElapsedTime returns how long the requests for ctx are retried, 0 for
+// forever.
+func (ee *Engine) maxElapsedTime(ctx context.Context) time.Duration {
+	if ee.optimistic(ctx) {
+		return ee.ec.GetOptimistic().OutageTimeout
+	}
+	return 0
+}
+
+// isOutage returns true if err, returned once the retries of a request are
+// exhausted, shows that the execution client is unavailable or syncing rather
+// than that the request is invalid.
+func isOutage(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	return client.IsNonFatalError(err) || errors.IsAny(
+		err, engineerrors.ErrSyncingPayloadStatus, engineerrors.ErrAcceptedPayloadStatus,
+	)
+}
+
+// queuePayload adds the payload request to the backlog, waiting for the
+// execution client to catch up if the backlog is full.
+func (ee *Engine) queuePayload(ctx context.Context, req ctypes.NewPayloadRequest) error {
+	_, err := backoff.Retry(
+		ctx,
+		func() (bool, error) {
+			if !ee.backlog.pushPayload(req) {
+				ee.logger.Warn(
+					"Optimistic backlog is full, waiting for the execution client",
+					"max_backlog", ee.ec.GetOptimistic().MaxBacklog,
+				)
+				return false, errBacklogFull
+			}
+			return true, nil
+		},
+		backoff.WithBackOff(ee.newBackoff()),
+		backoff.WithMaxTries(0),       // 0 for infinite retries.
+		backoff.WithMaxElapsedTime(0), // 0 for infinite max elapsed time.
+	)
+	if err != nil {
+		return err
+	}
+
+	payload := req.GetExecutionPayload()
+	size := ee.backlog.len()
+	ee.logger.Warn(
+		"Execution client unavailable, continuing optimistically",
+		"payload_block_hash", payload.GetBlockHash(),
+		"payload_number", payload.GetNumber(),
+		"backlog", size,
+	)
+	ee.metrics.markOptimisticPayloadQueued(size)
+	return nil
+}
+
+// queueForkchoiceUpdate keeps the forkchoice update to send once the payloads
+// of the backlog are. Payloads cannot be built until then.
+func (ee *Engine) queueForkchoiceUpdate(
+	req *ctypes.ForkchoiceUpdateRequest,
+) (*engineprimitives.PayloadID, error) {
+	if req.PayloadAttributes != nil {
+		return nil, ErrExecutionOptimistic
+	}
+	ee.backlog.setForkchoice(req)
+	return nil, nil
+}
+
+// Start starts replaying the backlog to the execution client, if the
+// optimistic mode is enabled.
+func (ee *Engine) Start(ctx context.Context) error {
+	if ee.backlog == nil {
+		return nil
+	}
+	go ee.replayLoop(ctx)
+	return nil
+}
+
+// Stop stops the engine.
+func (ee *Engine) Stop() error {
+	return nil
+}
+
+// Name returns the name of the engine.
+func (ee *Engine) Name() string {
+	return "execution-engine"
+}
+
+// replayLoop replays the backlog until ctx is done.
+func (ee *Engine) replayLoop(ctx context.Context) {
+	ticker := time.NewTicker(ee.ec.GetRPCMaxRetryInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ee.replayBacklog(ctx)
+		}
+	}
+}
+
+// replayBacklog sends the backlog to the execution client, oldest payload
+// first and the forkchoice update last, until it is empty or a request fails.
+// The replay stops for good if the execution client rejects a request, as the
+// chain finalized by the node then diverges from the execution chain.
+func (ee *Engine) replayBacklog(ctx context.Context) {
+	if !ee.backlog.pending() || ee.backlog.haltedBy() != nil {
+		return
+	}
+
+	for {
+		req, ok := ee.backlog.peekPayload()
+		if !ok {
+			break
+		}
+		payload := req.GetExecutionPayload()
+		ee.metrics.markNewPayloadCalled(payload.GetBlockHash(), payload.GetParentHash())
+		_, err := ee.ec.NewPayload(ctx, req)
+		switch {
+		case err == nil, errors.IsAny(
+			err, engineerrors.ErrSyncingPayloadStatus, engineerrors.ErrAcceptedPayloadStatus,
+		):
+		case errors.Is(err, engineerrors.ErrInvalidPayloadStatus):
+			ee.haltReplay(
+				fmt.Errorf("execution client rejected payload %d: %w", payload.GetNumber(), err),
+				"payload_block_hash", payload.GetBlockHash(),
+				"payload_number", payload.GetNumber(),
+			)
+			return
+		default:
+			ee.logger.Info(
+				"Execution client still unavailable, retrying the backlog later",
+				"backlog", ee.backlog.len(),
+				"err", err,
+			)
+			return
+		}
+		ee.backlog.popPayload(req)
+		ee.metrics.markOptimisticPayloadReplayed(ee.backlog.len())
+	}
+
+	req, ok := ee.backlog.peekForkchoice()
+	if !ok {
+		return
+	}
+	_, err := ee.ec.ForkchoiceUpdated(ctx, req.State, req.PayloadAttributes, req.ForkVersion)
+	switch {
+	case err == nil, errors.Is(err, engineerrors.ErrSyncingPayloadStatus):
+	case errors.Is(err, engineerrors.ErrInvalidPayloadStatus):
+		ee.haltReplay(
+			fmt.Errorf("execution client rejected the forkchoice update: %w", err),
+			"head_eth1_hash", req.State.HeadBlockHash,
+		)
+		return
+	default:
+		ee.logger.Info(
+			"Execution client still unavailable, retrying the backlog later",
+			"err", err,
+		)
+		return
+	}
+	ee.backlog.popForkchoice(req)
+	if !ee.backlog.pending() {
+		ee.logger.Info(
+			"Execution client caught up with the backlog, leaving optimistic mode",
+			"head_eth1_hash", req.State.HeadBlockHash,
+		)
+	}
+}
+
+// haltReplay stops the replay of the backlog because the execution client
+// rejected one of its requests. The engine stops continuing optimistically and
+// the node reports unhealthy, since the execution client must be re-synced.
+func (ee *Engine) haltReplay(err error, keyVals ...any) {
+	ee.backlog.halt(err)
+	ee.logger.Error(
+		"Execution client rejected the backlog, stopping its replay; "+
+			"the execution client must be re-synced",
+		append(keyVals, "err", err)...,
+	)
+}
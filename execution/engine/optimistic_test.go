@@ -0,0 +1,273 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/execution/engine"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/services/health"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/berachain/beacon-kit/primitives/net/url"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimisticBacklogReplay(t *testing.T) {
+	t.Parallel()
+
+	el := &fakeExecutionClient{}
+	el.setDown(true)
+	eng := newOptimisticEngine(t, el, true)
+
+	// The execution client is down: the payloads are queued once the outage
+	// timeout elapses, instead of being retried forever.
+	ctx := engine.WithOptimistic(t.Context())
+	require.False(t, eng.IsOptimistic())
+	require.NoError(t, eng.NotifyNewPayload(ctx, newPayloadRequest(0x01), true))
+	require.True(t, eng.IsOptimistic())
+	require.NoError(t, eng.NotifyNewPayload(ctx, newPayloadRequest(0x02), true))
+	// a payload already queued, e.g. finalized twice, is not queued twice
+	require.NoError(t, eng.NotifyNewPayload(ctx, newPayloadRequest(0x02), true))
+
+	// The forkchoice update is kept for later, but payloads cannot be built.
+	fcu := ctypes.BuildForkchoiceUpdateRequestNoAttrs(
+		&engineprimitives.ForkchoiceStateV1{HeadBlockHash: common.ExecutionHash{0x02}},
+		version.Deneb1(),
+	)
+	payloadID, err := eng.NotifyForkchoiceUpdate(ctx, fcu)
+	require.NoError(t, err)
+	require.Nil(t, payloadID)
+	_, err = eng.NotifyForkchoiceUpdate(ctx, &ctypes.ForkchoiceUpdateRequest{
+		State:             fcu.State,
+		PayloadAttributes: &engineprimitives.PayloadAttributes{},
+		ForkVersion:       version.Deneb1(),
+	})
+	require.ErrorIs(t, err, engine.ErrExecutionOptimistic)
+	require.Empty(t, el.received())
+
+	// Once the execution client is back, the backlog is replayed in order.
+	el.setDown(false)
+	require.NoError(t, eng.Start(t.Context()))
+	require.Eventually(t, func() bool { return !eng.IsOptimistic() }, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{
+		"engine_newPayloadV3", "engine_newPayloadV3", "engine_forkchoiceUpdatedV3",
+	}, el.received())
+	require.Equal(t, []common.ExecutionHash{{0x01}, {0x02}}, el.receivedPayloads())
+}
+
+func TestOptimisticBacklogReplayHaltsOnInvalidPayload(t *testing.T) {
+	t.Parallel()
+
+	el := &fakeExecutionClient{}
+	el.setDown(true)
+	eng := newOptimisticEngine(t, el, true)
+	checks := eng.HealthChecks()
+	require.Len(t, checks, 1)
+
+	ctx := engine.WithOptimistic(t.Context())
+	require.NoError(t, eng.NotifyNewPayload(ctx, newPayloadRequest(0x01), true))
+	require.NoError(t, eng.NotifyNewPayload(ctx, newPayloadRequest(0x02), true))
+	require.ErrorIs(t, checks[0].Run(t.Context()), health.ErrSyncing)
+
+	// The execution client rejects the oldest payload: the replay stops there
+	// and the node reports unhealthy rather than syncing.
+	el.setInvalid(common.ExecutionHash{0x01})
+	el.setDown(false)
+	require.NoError(t, eng.Start(t.Context()))
+	require.Eventually(t, func() bool {
+		err := checks[0].Run(t.Context())
+		return err != nil && !errors.Is(err, health.ErrSyncing)
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []common.ExecutionHash{{0x01}}, el.receivedPayloads())
+	require.True(t, eng.IsOptimistic())
+
+	// The engine no longer continues optimistically.
+	el.setDown(true)
+	ctx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, eng.NotifyNewPayload(ctx, newPayloadRequest(0x03), true), context.DeadlineExceeded)
+}
+
+func TestOptimisticRequiresContext(t *testing.T) {
+	t.Parallel()
+
+	el := &fakeExecutionClient{}
+	el.setDown(true)
+	eng := newOptimisticEngine(t, el, true)
+
+	// Without WithOptimistic, e.g. for a validator, the payload is retried
+	// until the execution client is back.
+	ctx, cancel := context.WithTimeout(t.Context(), 200*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, eng.NotifyNewPayload(ctx, newPayloadRequest(0x01), true), context.DeadlineExceeded)
+	require.False(t, eng.IsOptimistic())
+}
+
+func TestOptimisticDisabled(t *testing.T) {
+	t.Parallel()
+
+	el := &fakeExecutionClient{}
+	el.setDown(true)
+	eng := newOptimisticEngine(t, el, false)
+
+	ctx, cancel := context.WithTimeout(engine.WithOptimistic(t.Context()), 200*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, eng.NotifyNewPayload(ctx, newPayloadRequest(0x01), true), context.DeadlineExceeded)
+	require.False(t, eng.IsOptimistic())
+	require.NoError(t, eng.Start(t.Context()))
+}
+
+func newOptimisticEngine(t *testing.T, el *fakeExecutionClient, enabled bool) *engine.Engine {
+	t.Helper()
+
+	server := httptest.NewServer(el)
+	t.Cleanup(server.Close)
+	dialURL, err := url.NewFromRaw(server.URL)
+	require.NoError(t, err)
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+
+	cfg := client.DefaultConfig()
+	cfg.RPCDialURL = dialURL
+	cfg.RPCRetryInterval = time.Millisecond
+	cfg.RPCMaxRetryInterval = 10 * time.Millisecond
+	cfg.Optimistic.Enabled = enabled
+	cfg.Optimistic.OutageTimeout = 50 * time.Millisecond
+
+	logger := noop.NewLogger[any]()
+	ec := client.New(&cfg, logger, secret, noopTelemetrySink{}, big.NewInt(1))
+	require.NoError(t, ec.Initialize())
+	return engine.New(ec, logger, noopTelemetrySink{})
+}
+
+// newPayloadRequest returns a Deneb new payload request for the payload with
+// the given block hash.
+func newPayloadRequest(hash byte) ctypes.NewPayloadRequest {
+	payload := ctypes.NewEmptyExecutionPayloadWithVersion(version.Deneb1())
+	payload.BlockHash = common.ExecutionHash{hash}
+	return &payloadRequest{payload: payload}
+}
+
+type payloadRequest struct {
+	payload *ctypes.ExecutionPayload
+}
+
+func (r *payloadRequest) GetForkVersion() common.Version                { return version.Deneb1() }
+func (r *payloadRequest) HasValidVersionedAndBlockHashes() error        { return nil }
+func (r *payloadRequest) GetExecutionPayload() *ctypes.ExecutionPayload { return r.payload }
+func (r *payloadRequest) GetVersionedHashes() []common.ExecutionHash    { return nil }
+func (r *payloadRequest) GetParentBeaconBlockRoot() common.Root         { return common.Root{} }
+func (r *payloadRequest) GetParentProposerPubkey() *crypto.BLSPubkey    { return nil }
+func (r *payloadRequest) GetEncodedExecutionRequests() ([]ctypes.EncodedExecutionRequest, error) {
+	return nil, nil
+}
+
+// fakeExecutionClient is an execution client reporting every request VALID,
+// unless it is down or the payload is the invalid one.
+type fakeExecutionClient struct {
+	mu       sync.Mutex
+	down     bool
+	invalid  *common.ExecutionHash
+	methods  []string
+	payloads []common.ExecutionHash
+}
+
+func (f *fakeExecutionClient) setDown(down bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.down = down
+}
+
+func (f *fakeExecutionClient) setInvalid(hash common.ExecutionHash) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.invalid = &hash
+}
+
+func (f *fakeExecutionClient) received() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.methods...)
+}
+
+func (f *fakeExecutionClient) receivedPayloads() []common.ExecutionHash {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]common.ExecutionHash(nil), f.payloads...)
+}
+
+func (f *fakeExecutionClient) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.down {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	var req struct {
+		ID     json.RawMessage   `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	f.methods = append(f.methods, req.Method)
+
+	valid := engineprimitives.PayloadStatusV1{Status: engineprimitives.PayloadStatusValid}
+	var result any = valid
+	if strings.HasPrefix(req.Method, "engine_newPayload") {
+		var payload ctypes.ExecutionPayload
+		if err := json.Unmarshal(req.Params[0], &payload); err == nil {
+			f.payloads = append(f.payloads, payload.BlockHash)
+			if f.invalid != nil && *f.invalid == payload.BlockHash {
+				result = engineprimitives.PayloadStatusV1{Status: engineprimitives.PayloadStatusInvalid}
+			}
+		}
+	} else {
+		result = map[string]any{"payloadStatus": valid, "payloadId": nil}
+	}
+	//nolint:errchkjson // test server
+	_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result})
+}
+
+type noopTelemetrySink struct{}
+
+func (noopTelemetrySink) IncrementCounter(string, ...string)        {}
+func (noopTelemetrySink) SetGauge(string, int64, ...string)         {}
+func (noopTelemetrySink) MeasureSince(string, time.Time, ...string) {}
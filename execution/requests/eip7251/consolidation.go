@@ -36,7 +36,6 @@ type feeOpts struct {
 }
 
 // GetConsolidationFee returns the consolidation fee in wei. See https://eips.ethereum.org/EIPS/eip-7251 for more.
-// This is only expected to be used in tests to form test scenarios.
 func GetConsolidationFee(ctx context.Context, client rpcClient) (*big.Int, error) {
 	var result string
 	feeInput := &feeOpts{
@@ -54,7 +53,6 @@ func GetConsolidationFee(ctx context.Context, client rpcClient) (*big.Int, error
 }
 
 // CreateConsolidationRequestData returns the request body formatted as defined by the EIP-7251 specification.
-// This is only expected to be used in tests to form test scenarios.
 func CreateConsolidationRequestData(sourcePubKey, targetPubKey crypto.BLSPubkey) (beaconbytes.Bytes, error) {
 	// Create a buffer to hold the packed encoding.
 	var packed bytes.Buffer
@@ -20,6 +20,20 @@
 
 package phuslu
 
+import "time"
+
+const (
+	// defaultFileMaxSizeMB is the default size, in MiB, at which the log
+	// file is rotated.
+	defaultFileMaxSizeMB = 100
+	// defaultFileMaxBackups is the default number of rotated log files
+	// retained.
+	defaultFileMaxBackups = 10
+	// defaultFileMaxAge is the default duration rotated log files are
+	// retained for.
+	defaultFileMaxAge = 7 * 24 * time.Hour
+)
+
 // Config is a structure that defines the configuration for the logger.
 type Config struct {
 	// TimeFormat is a string that defines the format of the time in
@@ -29,13 +43,58 @@ type Config struct {
 	LogLevel string `mapstructure:"log-level"`
 	// pretty or json.
 	Style string `mapstructure:"style"`
+	// ModuleLevels overrides LogLevel for the modules, i.e. the services,
+	// listed as comma separated module=level pairs, e.g.
+	// "blockchain=debug,engine-client=warn".
+	ModuleLevels string `mapstructure:"module-levels"`
+	// File is the configuration of the file the logs are also written to.
+	File FileConfig `mapstructure:"file"`
+	// Sampling is the configuration of the sampling of noisy messages.
+	Sampling SamplingConfig `mapstructure:"sampling"`
+}
+
+// FileConfig is the configuration of the file sink, which writes the logs as
+// JSON lines to a file rotated by size.
+type FileConfig struct {
+	// Path is the file the logs are written to. Empty disables the sink.
+	Path string `mapstructure:"path"`
+	// MaxSizeMB is the size, in MiB, at which the file is rotated. 0
+	// disables the rotation.
+	MaxSizeMB int64 `mapstructure:"max-size-mb"`
+	// MaxBackups is the number of rotated files retained. 0 retains them
+	// all.
+	MaxBackups int `mapstructure:"max-backups"`
+	// MaxAge is the duration rotated files are retained for. 0 retains them
+	// regardless of their age.
+	MaxAge time.Duration `mapstructure:"max-age"`
+}
+
+// SamplingConfig is the configuration of the sampling of noisy messages.
+type SamplingConfig struct {
+	// Messages are the messages sampled. Only the messages logged at the
+	// info and debug levels are sampled.
+	Messages []string `mapstructure:"messages"`
+	// Every is the sampling rate: one of every Every occurrences of each
+	// message is logged. 0 and 1 log every occurrence.
+	Every uint64 `mapstructure:"every"`
 }
 
 // DefaultConfig is a function that returns a new Config with default values.
 func DefaultConfig() Config {
 	return Config{
-		TimeFormat: "RFC3339",
-		LogLevel:   "info",
-		Style:      StylePretty,
+		TimeFormat:   "RFC3339",
+		LogLevel:     "info",
+		Style:        StylePretty,
+		ModuleLevels: "",
+		File: FileConfig{
+			Path:       "",
+			MaxSizeMB:  defaultFileMaxSizeMB,
+			MaxBackups: defaultFileMaxBackups,
+			MaxAge:     defaultFileMaxAge,
+		},
+		Sampling: SamplingConfig{
+			Messages: []string{},
+			Every:    1,
+		},
 	}
 }
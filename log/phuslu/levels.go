@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/phuslu/log"
+)
+
+// ModuleKey is the context key naming the module, i.e. the service, a logger
+// logs for. Loggers derived with With from this key log at the level of the
+// module, if any is set.
+const ModuleKey = "service"
+
+// ErrInvalidLevel is returned when a log level cannot be parsed.
+var ErrInvalidLevel = errors.New("invalid log level")
+
+// levels holds the global and per-module log levels of a logger. It is shared
+// by all the loggers derived from it with With, so levels can be changed at
+// runtime.
+type levels struct {
+	// global is the level of the loggers whose module has no level set.
+	global atomic.Uint32
+	// modules maps a module to its level. The map is replaced, never
+	// mutated, so it can be read without holding mu.
+	modules atomic.Pointer[map[string]log.Level]
+	// mu serializes the updates of modules.
+	mu sync.Mutex
+}
+
+// newLevels returns levels logging at level info.
+func newLevels() *levels {
+	lv := &levels{}
+	lv.global.Store(uint32(log.InfoLevel))
+	lv.modules.Store(&map[string]log.Level{})
+	return lv
+}
+
+// enabled returns whether entries at level are logged for module.
+func (lv *levels) enabled(module string, level log.Level) bool {
+	if module != "" {
+		if moduleLevel, ok := (*lv.modules.Load())[module]; ok {
+			return level >= moduleLevel
+		}
+	}
+	return level >= log.Level(lv.global.Load())
+}
+
+// setModule sets the level of module. A nil level unsets it, so that the
+// module logs at the global level again.
+func (lv *levels) setModule(module string, level *log.Level) {
+	lv.mu.Lock()
+	defer lv.mu.Unlock()
+	modules := maps.Clone(*lv.modules.Load())
+	if level == nil {
+		delete(modules, module)
+	} else {
+		modules[module] = *level
+	}
+	lv.modules.Store(&modules)
+}
+
+// setModules replaces the levels of the modules.
+func (lv *levels) setModules(modules map[string]log.Level) {
+	lv.mu.Lock()
+	defer lv.mu.Unlock()
+	lv.modules.Store(&modules)
+}
+
+// parseModuleLevels parses comma separated module=level pairs, e.g.
+// "blockchain=debug,engine-client=warn".
+func parseModuleLevels(s string) (map[string]log.Level, error) {
+	modules := make(map[string]log.Level)
+	for pair := range strings.SplitSeq(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		module, level, ok := strings.Cut(pair, "=")
+		module = strings.TrimSpace(module)
+		if !ok || module == "" {
+			return nil, fmt.Errorf("%w: expected module=level, got %q", ErrInvalidLevel, pair)
+		}
+		parsed, err := parseLevel(strings.TrimSpace(level))
+		if err != nil {
+			return nil, fmt.Errorf("module %s: %w", module, err)
+		}
+		modules[module] = parsed
+	}
+	return modules, nil
+}
+
+// parseLevel parses a log level, rejecting the unknown ones which phuslu
+// would otherwise silently treat as disabling the logs.
+func parseLevel(level string) (log.Level, error) {
+	parsed := log.ParseLevel(level)
+	if parsed < log.TraceLevel || parsed > log.PanicLevel {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidLevel, level)
+	}
+	return parsed, nil
+}
+
+// Level returns the global log level.
+func (l *Logger) Level() string {
+	return log.Level(l.levels.global.Load()).String()
+}
+
+// SetLevel sets the global log level, used by the modules with no level of
+// their own.
+func (l *Logger) SetLevel(level string) error {
+	parsed, err := parseLevel(level)
+	if err != nil {
+		return err
+	}
+	l.levels.global.Store(uint32(parsed))
+	return nil
+}
+
+// ModuleLevels returns the log levels set per module.
+func (l *Logger) ModuleLevels() map[string]string {
+	modules := *l.levels.modules.Load()
+	res := make(map[string]string, len(modules))
+	for module, level := range modules {
+		res[module] = level.String()
+	}
+	return res
+}
+
+// SetModuleLevel sets the log level of module. An empty level unsets it, so
+// that the module logs at the global level again.
+func (l *Logger) SetModuleLevel(module, level string) error {
+	if level == "" {
+		l.levels.setModule(module, nil)
+		return nil
+	}
+	parsed, err := parseLevel(level)
+	if err != nil {
+		return err
+	}
+	l.levels.setModule(module, &parsed)
+	return nil
+}
+
+// enabled returns whether entries at level are logged by the logger.
+func (l *Logger) enabled(level log.Level) bool {
+	return l.levels.enabled(l.module, level)
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleLevels(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	logger := newLoggerForStyle(&out, phuslu.StyleJSON)
+	chain := logger.With(phuslu.ModuleKey, "blockchain")
+	engine := logger.With(phuslu.ModuleKey, "engine-client")
+
+	chain.Debug("chain debug")
+	require.NotContains(t, out.String(), "chain debug")
+
+	require.NoError(t, logger.SetModuleLevel("blockchain", "debug"))
+	require.NoError(t, logger.SetModuleLevel("engine-client", "warn"))
+	chain.Debug("chain debug")
+	engine.Info("engine info")
+	logger.Info("global info")
+	require.Contains(t, out.String(), "chain debug")
+	require.NotContains(t, out.String(), "engine info")
+	require.Contains(t, out.String(), "global info")
+	require.Equal(t, map[string]string{"blockchain": "debug", "engine-client": "warn"}, logger.ModuleLevels())
+
+	// Loggers derived from a module logger keep its module.
+	chain.With("height", 1).Debug("derived debug")
+	require.Contains(t, out.String(), "derived debug")
+
+	// Unsetting a module level falls back to the global one.
+	require.NoError(t, logger.SetModuleLevel("engine-client", ""))
+	engine.Info("engine info")
+	require.Contains(t, out.String(), "engine info")
+
+	require.NoError(t, logger.SetLevel("error"))
+	require.Equal(t, "error", logger.Level())
+	engine.Warn("engine warn")
+	require.NotContains(t, out.String(), "engine warn")
+}
+
+func TestInvalidLevel(t *testing.T) {
+	t.Parallel()
+
+	logger := newLoggerForStyle(&bytes.Buffer{}, phuslu.StyleJSON)
+	require.ErrorIs(t, logger.SetLevel("verbose"), phuslu.ErrInvalidLevel)
+	require.ErrorIs(t, logger.SetModuleLevel("blockchain", "loud"), phuslu.ErrInvalidLevel)
+	require.Equal(t, "info", logger.Level())
+	require.Empty(t, logger.ModuleLevels())
+}
+
+func TestReloadModuleLevels(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	logger := newLoggerForStyle(&out, phuslu.StyleJSON)
+	cfg := phuslu.DefaultConfig()
+	cfg.ModuleLevels = " blockchain=debug, engine-client=warn ,"
+	require.NoError(t, logger.Reload(&cfg))
+	require.Equal(t, map[string]string{"blockchain": "debug", "engine-client": "warn"}, logger.ModuleLevels())
+
+	// Levels set at runtime are overridden on reload.
+	require.NoError(t, logger.SetModuleLevel("da-store", "error"))
+	cfg.ModuleLevels = "blockchain=info"
+	require.NoError(t, logger.Reload(&cfg))
+	require.Equal(t, map[string]string{"blockchain": "info"}, logger.ModuleLevels())
+
+	// Invalid module levels leave the levels untouched.
+	for _, moduleLevels := range []string{"blockchain", "=debug", "blockchain=loud"} {
+		cfg.ModuleLevels = moduleLevels
+		require.ErrorIs(t, logger.Reload(&cfg), phuslu.ErrInvalidLevel, moduleLevels)
+		require.Equal(t, map[string]string{"blockchain": "info"}, logger.ModuleLevels())
+	}
+}
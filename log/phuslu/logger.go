@@ -36,6 +36,16 @@ type Logger struct {
 	out io.Writer
 	// formatter is the formatter to use for the logger.
 	formatter *Formatter
+	// levels are the log levels, shared with the loggers derived with With.
+	levels *levels
+	// sinks are the writers of the entries, shared with the loggers derived
+	// with With.
+	sinks *sinks
+	// sampler samples the noisy messages, shared with the loggers derived
+	// with With.
+	sampler *sampler
+	// module is the module the logger logs for, set from ModuleKey.
+	module string
 }
 
 // NewLogger initializes a new wrapped phuslogger with the provided config.
@@ -44,18 +54,24 @@ func NewLogger(
 	cfg *Config,
 ) *Logger {
 	logger := &Logger{
-		logger:    &log.Logger{},
+		// Levels are filtered by the wrapper, per module, so the underlying
+		// logger lets every entry through.
+		logger:    &log.Logger{Level: log.TraceLevel},
 		context:   make(log.Fields),
 		out:       out,
 		formatter: NewFormatter(),
+		levels:    newLevels(),
+		sinks:     &sinks{},
+		sampler:   newSampler(),
 	}
+	logger.logger.Writer = logger.sinks
 	logger.WithConfig(cfg)
 	return logger
 }
 
 // Info logs a message at level Info.
 func (l *Logger) Info(msg string, keyVals ...any) {
-	if l.logger.Level > log.InfoLevel {
+	if !l.enabled(log.InfoLevel) || !l.sampler.allow(msg) {
 		return
 	}
 	l.msgWithContext(msg, l.logger.Info(), keyVals...)
@@ -63,7 +79,7 @@ func (l *Logger) Info(msg string, keyVals ...any) {
 
 // Warn logs a message at level Warn.
 func (l *Logger) Warn(msg string, keyVals ...any) {
-	if l.logger.Level > log.WarnLevel {
+	if !l.enabled(log.WarnLevel) {
 		return
 	}
 	l.msgWithContext(msg, l.logger.Warn(), keyVals...)
@@ -71,7 +87,7 @@ func (l *Logger) Warn(msg string, keyVals ...any) {
 
 // Error logs a message at level Error.
 func (l *Logger) Error(msg string, keyVals ...any) {
-	if l.logger.Level > log.ErrorLevel {
+	if !l.enabled(log.ErrorLevel) {
 		return
 	}
 	l.msgWithContext(msg, l.logger.Error(), keyVals...)
@@ -79,7 +95,7 @@ func (l *Logger) Error(msg string, keyVals ...any) {
 
 // Debug logs a message at level Debug.
 func (l *Logger) Debug(msg string, keyVals ...any) {
-	if l.logger.Level > log.DebugLevel {
+	if !l.enabled(log.DebugLevel) || !l.sampler.allow(msg) {
 		return
 	}
 	l.msgWithContext(msg, l.logger.Debug(), keyVals...)
@@ -107,6 +123,9 @@ func (l Logger) With(keyVals ...any) *Logger {
 			continue
 		}
 		newLogger.context[key] = keyVals[i+1]
+		if module, isString := keyVals[i+1].(string); isString && key == ModuleKey {
+			newLogger.module = module
+		}
 	}
 
 	return &newLogger
@@ -138,10 +157,29 @@ func (l *Logger) WithConfig(cfg *Config) *Logger {
 	}
 	l.withTimeFormat(cfg.TimeFormat)
 	l.withStyle(cfg.Style)
-	l.withLogLevel(cfg.LogLevel)
+	if err := l.Reload(cfg); err != nil {
+		l.Error("Invalid logger configuration", "error", err)
+	}
 	return l
 }
 
+// Reload applies the log levels, file sink and sampling of cfg while the
+// logger is in use. The levels set at runtime are overridden. The time format
+// and style are only set by WithConfig.
+func (l *Logger) Reload(cfg *Config) error {
+	modules, err := parseModuleLevels(cfg.ModuleLevels)
+	if err != nil {
+		return err
+	}
+	if err = l.sinks.setFile(cfg.File); err != nil {
+		return err
+	}
+	l.withLogLevel(cfg.LogLevel)
+	l.levels.setModules(modules)
+	l.sampler.configure(cfg.Sampling)
+	return nil
+}
+
 // AddKeyColor applies a color to log entries based on their keys.
 func (l *Logger) AddKeyColor(key any, color Color) {
 	//nolint:errcheck // should be safe
@@ -164,9 +202,10 @@ func (l *Logger) withStyle(style string) {
 	}
 }
 
-// SetLevel sets the log level of the logger.
+// withLogLevel sets the global log level of the logger. An unknown level
+// disables the logs, as phuslu does.
 func (l *Logger) withLogLevel(level string) {
-	l.logger.Level = log.ParseLevel(level)
+	l.levels.global.Store(uint32(log.ParseLevel(level)))
 }
 
 // useConsoleWriter sets the logger to use a console writer.
@@ -182,7 +221,7 @@ func (l *Logger) useJSONWriter() {
 	l.setWriter(log.IOWriter{Writer: truncatingWriter{out: l.out}})
 }
 
-// setWriter sets the writer of the logger.
+// setWriter sets the writer of the output of the logger.
 func (l *Logger) setWriter(writer log.Writer) {
-	l.logger.Writer = writer
+	l.sinks.setOut(writer)
 }
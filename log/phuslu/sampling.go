@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu
+
+import (
+	"sync/atomic"
+)
+
+// sampler samples the noisy messages configured, logging one of every few
+// of their occurrences. It is shared by all the loggers derived with With.
+type sampler struct {
+	// every is the sampling rate of the messages.
+	every atomic.Uint64
+	// counts maps each sampled message to its number of occurrences. The map
+	// is replaced, never mutated.
+	counts atomic.Pointer[map[string]*atomic.Uint64]
+}
+
+// newSampler returns a sampler sampling no message.
+func newSampler() *sampler {
+	s := &sampler{}
+	s.counts.Store(&map[string]*atomic.Uint64{})
+	return s
+}
+
+// configure sets the messages sampled and their sampling rate.
+func (s *sampler) configure(cfg SamplingConfig) {
+	counts := make(map[string]*atomic.Uint64, len(cfg.Messages))
+	for _, msg := range cfg.Messages {
+		counts[msg] = &atomic.Uint64{}
+	}
+	s.every.Store(cfg.Every)
+	s.counts.Store(&counts)
+}
+
+// allow returns whether this occurrence of msg is logged. The first
+// occurrence of a sampled message is always logged.
+func (s *sampler) allow(msg string) bool {
+	count, ok := (*s.counts.Load())[msg]
+	if !ok {
+		return true
+	}
+	every := s.every.Load()
+	if every <= 1 {
+		return true
+	}
+	return (count.Add(1)-1)%every == 0
+}
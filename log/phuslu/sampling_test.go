@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSampling(t *testing.T) {
+	t.Parallel()
+
+	const noisy = "Successfully stored all blob sidecars"
+	var out bytes.Buffer
+	logger := newLoggerForStyle(&out, phuslu.StyleJSON)
+	cfg := phuslu.DefaultConfig()
+	cfg.Sampling = phuslu.SamplingConfig{Messages: []string{noisy}, Every: 5}
+	require.NoError(t, logger.Reload(&cfg))
+
+	for range 10 {
+		logger.With(phuslu.ModuleKey, "da-store").Info(noisy)
+		logger.Info("Processed block")
+		logger.Warn(noisy)
+	}
+	// Sampling only applies to the configured messages at info and debug.
+	var info, warn int
+	for line := range strings.Lines(out.String()) {
+		switch {
+		case !strings.Contains(line, noisy):
+		case strings.Contains(line, `"level":"info"`):
+			info++
+		case strings.Contains(line, `"level":"warn"`):
+			warn++
+		}
+	}
+	require.Equal(t, 2, info)
+	require.Equal(t, 10, warn)
+	require.Equal(t, 10, strings.Count(out.String(), "Processed block"))
+}
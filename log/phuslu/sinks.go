@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/phuslu/log"
+)
+
+// bytesPerMB is the number of bytes in a MiB.
+const bytesPerMB = 1 << 20
+
+// sinks writes the log entries to the output of the logger and, if one is
+// configured, to the file sink. Both can be swapped while logging.
+type sinks struct {
+	mu sync.RWMutex
+	// out writes the entries to the output of the logger, in its style.
+	out log.Writer
+	// file is the file sink, nil if disabled.
+	file *log.FileWriter
+	// fileCfg is the configuration the file sink was opened with.
+	fileCfg FileConfig
+}
+
+// WriteEntry implements log.Writer.
+func (s *sinks) WriteEntry(e *log.Entry) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var fileErr error
+	if s.file != nil {
+		// The file sink always receives the entries as JSON lines.
+		_, fileErr = log.IOWriter{Writer: truncatingWriter{out: s.file}}.WriteEntry(e)
+	}
+	if s.out == nil {
+		return 0, fileErr
+	}
+	n, err := s.out.WriteEntry(e)
+	if err == nil {
+		err = fileErr
+	}
+	return n, err
+}
+
+// setOut sets the writer of the output of the logger.
+func (s *sinks) setOut(out log.Writer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.out = out
+}
+
+// setFile opens the file sink configured by cfg, closing the previous one.
+// The file sink is left untouched if its configuration did not change.
+func (s *sinks) setFile(cfg FileConfig) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file != nil && s.fileCfg == cfg {
+		return nil
+	}
+
+	var file *log.FileWriter
+	if cfg.Path != "" {
+		file = &log.FileWriter{
+			Filename:     cfg.Path,
+			MaxSize:      cfg.MaxSizeMB * bytesPerMB,
+			MaxBackups:   cfg.MaxBackups,
+			FileMode:     0o600,
+			EnsureFolder: true,
+			Cleaner:      cfg.clean,
+		}
+	}
+	if s.file != nil {
+		if err := s.file.Close(); err != nil {
+			return err
+		}
+	}
+	s.file, s.fileCfg = file, cfg
+	return nil
+}
+
+// clean removes the rotated log files beyond the retention limits. matches
+// are the log files of filename, oldest first.
+func (c FileConfig) clean(filename string, _ int, matches []os.FileInfo) {
+	dir := filepath.Dir(filename)
+	// filename links to the file being written to, which is never removed.
+	current, err := os.Readlink(filename)
+	if err != nil && len(matches) > 0 {
+		current = matches[len(matches)-1].Name()
+	}
+	backups := make([]os.FileInfo, 0, len(matches))
+	for _, info := range matches {
+		if info.Name() != filepath.Base(current) {
+			backups = append(backups, info)
+		}
+	}
+	for i, info := range backups {
+		tooMany := c.MaxBackups > 0 && i < len(backups)-c.MaxBackups
+		tooOld := c.MaxAge > 0 && time.Since(info.ModTime()) > c.MaxAge
+		if tooMany || tooOld {
+			_ = os.Remove(filepath.Join(dir, info.Name()))
+		}
+	}
+}
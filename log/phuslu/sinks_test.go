@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSink(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	logger := newLoggerForStyle(&out, phuslu.StylePretty)
+	path := filepath.Join(t.TempDir(), "logs", "beacond.log")
+	cfg := phuslu.DefaultConfig()
+	cfg.File.Path = path
+	require.NoError(t, logger.Reload(&cfg))
+
+	logger.Info("to both sinks", "height", 1)
+	require.Contains(t, out.String(), "to both sinks")
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(content), `"message":"to both sinks"`)
+	require.Contains(t, string(content), `"height":1`)
+
+	// Disabling the sink stops writing to the file.
+	cfg.File.Path = ""
+	require.NoError(t, logger.Reload(&cfg))
+	logger.Info("console only")
+	content, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(content), "console only")
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "beacond.log")
+	// Stale rotated files are removed on rotation once past MaxAge.
+	stale := filepath.Join(dir, "beacond.2020-01-01T00-00-00.log")
+	require.NoError(t, os.WriteFile(stale, []byte("stale\n"), 0o600))
+	old := time.Now().Add(-48 * time.Hour)
+	require.NoError(t, os.Chtimes(stale, old, old))
+
+	logger := newLoggerForStyle(&bytes.Buffer{}, phuslu.StyleJSON)
+	cfg := phuslu.DefaultConfig()
+	cfg.File = phuslu.FileConfig{
+		Path:       path,
+		MaxSizeMB:  1,
+		MaxBackups: 2,
+		MaxAge:     24 * time.Hour,
+	}
+	require.NoError(t, logger.Reload(&cfg))
+
+	// Each line is bounded, so log enough lines to rotate a few times.
+	line := strings.Repeat("x", 32*1024)
+	for range 4 * 1024 * 1024 / len(line) {
+		logger.Info(line)
+		// Rotated files are named after the second they are created in.
+		time.Sleep(time.Millisecond)
+	}
+
+	require.Eventually(t, func() bool {
+		if _, err := os.Stat(stale); !os.IsNotExist(err) {
+			return false
+		}
+		files, err := filepath.Glob(filepath.Join(dir, "beacond.*.log"))
+		require.NoError(t, err)
+		// The file being written to, plus MaxBackups rotated files.
+		return len(files) <= 3
+	}, 5*time.Second, 10*time.Millisecond)
+}
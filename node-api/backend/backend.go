@@ -56,6 +56,10 @@ type Backend struct {
 	cs     chain.Spec
 	cmtCfg *cmtcfg.Config // used to fetch genesis data upon LoadData
 	node   types.ConsensusService
+	bp     BlockProducer        // builds blocks for external validator clients
+	fr     FeeRecipientRegistry // stores fee recipients registered by validator clients
+	hm     HealthMonitor        // runs the health checks of the node
+	es     ExecutionStatus      // reports whether the execution client is behind
 
 	// Genesis related data
 	sp           GenesisStateProcessor // only needed to recreate genesis state upon API loading
@@ -72,6 +76,10 @@ func New(
 	cs chain.Spec,
 	cmtCfg *cmtcfg.Config,
 	consensusService types.ConsensusService,
+	blockProducer BlockProducer,
+	feeRecipients FeeRecipientRegistry,
+	healthMonitor HealthMonitor,
+	executionStatus ExecutionStatus,
 ) *Backend {
 	b := &Backend{
 		sb:     storageBackend,
@@ -79,6 +87,10 @@ func New(
 		cs:     cs,
 		cmtCfg: cmtCfg,
 		node:   consensusService,
+		bp:     blockProducer,
+		fr:     feeRecipients,
+		hm:     healthMonitor,
+		es:     executionStatus,
 	}
 
 	// genesis data will be cached in LoadData
@@ -182,34 +194,40 @@ func (b *Backend) parseGenesisBytes() (ctypes.Genesis, error) {
 
 func (b *Backend) initGenesisState() error {
 	var err error
-	b.db, err = db.OpenDB("", dbm.MemDBBackend)
+	b.genesisState, b.cms, b.db, err = newInMemoryState(b.cs)
+	return err
+}
+
+// newInMemoryState creates an empty beacon state backed by an in-memory store.
+func newInMemoryState(cs chain.Spec) (*state.StateDB, storetypes.CommitMultiStore, dbm.DB, error) {
+	memDB, err := db.OpenDB("", dbm.MemDBBackend)
 	if err != nil {
-		return fmt.Errorf("failed opening mem db: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed opening mem db: %w", err)
 	}
 	var (
 		nopLog     = log.NewNopLogger()
 		nopMetrics = sdkmetrics.NewNoOpMetrics()
 	)
 
-	b.cms = store.NewCommitMultiStore(b.db, nopLog, nopMetrics)
+	cms := store.NewCommitMultiStore(memDB, nopLog, nopMetrics)
 
-	b.cms.MountStoreWithDB(backendStoreKey, storetypes.StoreTypeIAVL, nil)
-	if err = b.cms.LoadLatestVersion(); err != nil {
-		return fmt.Errorf("backend data loading: failed to load latest version: %w", err)
+	cms.MountStoreWithDB(backendStoreKey, storetypes.StoreTypeIAVL, nil)
+	if err = cms.LoadLatestVersion(); err != nil {
+		return nil, nil, nil, fmt.Errorf("backend data loading: failed to load latest version: %w", err)
 	}
 
-	ctx := sdk.NewContext(b.cms, true, nopLog)
+	ctx := sdk.NewContext(cms, true, nopLog)
 	backendStoreService := &backendKVStoreService{
 		ctx: ctx,
 	}
 	kvStore := beacondb.New(backendStoreService)
 
-	sdkCtx := sdk.NewContext(b.cms.CacheMultiStore(), true, log.NewNopLogger())
-	b.genesisState = state.NewBeaconStateFromDB(
+	sdkCtx := sdk.NewContext(cms.CacheMultiStore(), true, log.NewNopLogger())
+	st := state.NewBeaconStateFromDB(
 		kvStore.WithContext(sdkCtx),
-		b.cs,
+		cs,
 		sdkCtx.Logger(),
 		metrics.NewNoOpTelemetrySink(),
 	)
-	return nil
+	return st, cms, memDB, nil
 }
@@ -26,6 +26,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/config/spec"
 	"github.com/berachain/beacon-kit/consensus-types/types"
@@ -38,8 +39,12 @@ import (
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/archive"
 	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
 	cmtcfg "github.com/cometbft/cometbft/config"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -158,13 +163,13 @@ func TestBackendLoadData(t *testing.T) {
 			_, kvStore, depositStore, err := statetransition.BuildTestStores()
 			require.NoError(t, err)
 			sb := storage.NewBackend(
-				cs, nil, kvStore, depositStore, nil, log.NewNopLogger(), metrics.NewNoOpTelemetrySink(),
+				cs, nil, kvStore, depositStore, nil, nil, log.NewNopLogger(), metrics.NewNoOpTelemetrySink(),
 			)
 
 			tcs := coremocks.NewConsensusService(t)
 			sp := mocks.NewGenesisStateProcessor(t)
 
-			b := backend.New(sb, sp, cs, cmtCfg, tcs)
+			b := backend.New(sb, sp, cs, cmtCfg, tcs, nil, nil, nil, nil)
 			defer func() {
 				require.NoError(t, b.Close())
 			}()
@@ -181,6 +186,41 @@ func TestBackendLoadData(t *testing.T) {
 	}
 }
 
+func TestStateAndSlotFromHeightFutureHeight(t *testing.T) {
+	t.Parallel()
+
+	cs, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+	_, kvStore, depositStore, err := statetransition.BuildTestStores()
+	require.NoError(t, err)
+	stateArchive := archive.NewStore(
+		dbm.NewMemDB(), log.NewNopLogger(), archive.Config{Enabled: true, CheckpointInterval: 1},
+	)
+	sb := storage.NewBackend(
+		cs, nil, kvStore, depositStore, nil, stateArchive, log.NewNopLogger(), metrics.NewNoOpTelemetrySink(),
+	)
+
+	tcs := coremocks.NewConsensusService(t)
+	b := backend.New(sb, mocks.NewGenesisStateProcessor(t), cs, buildTestCometConfig(t), tcs, nil, nil, nil, nil)
+	defer func() {
+		require.NoError(t, b.Close())
+	}()
+
+	const latestHeight = int64(10)
+	futureErr := errorsmod.Wrap(sdkerrors.ErrInvalidHeight, "cannot query with height in the future")
+	tcs.EXPECT().GetSyncData().Return(latestHeight, latestHeight)
+	tcs.EXPECT().CreateQueryContext(latestHeight+1, false).Return(sdk.Context{}, futureErr)
+	tcs.EXPECT().CreateQueryContext(latestHeight-1, false).Return(sdk.Context{}, errors.New("version pruned"))
+
+	// A future height is not looked up in the archive, its error is returned as is.
+	_, _, err = b.StateAndSlotFromHeight(latestHeight + 1)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidHeight)
+
+	// A pruned height falls back to the archive, which does not hold it in this test.
+	_, _, err = b.StateAndSlotFromHeight(latestHeight - 1)
+	require.ErrorIs(t, err, archive.ErrStateNotArchived)
+}
+
 //nolint:lll // adapted genesis from mainnet
 func buildTestCometConfig(t *testing.T) *cmtcfg.Config {
 	t.Helper()
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"fmt"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ProduceBlock builds the unsigned block for the given slot and proposer on top
+// of the tip state, for an external validator client to sign it. A nil proposer
+// defaults to the node's own key.
+func (b *Backend) ProduceBlock(
+	slot math.Slot,
+	proposer *crypto.BLSPubkey,
+	randaoReveal crypto.BLSSignature,
+	graffiti *common.Bytes32,
+	skipRandaoVerification bool,
+) (*ctypes.BlockContents, *math.U256, error) {
+	// The query context wraps a cached multistore, hence the block producer
+	// can freely modify the tip state.
+	queryCtx, err := b.node.CreateQueryContext(0, false)
+	if err != nil {
+		return nil, nil, fmt.Errorf("CreateQueryContext failed: %w", err)
+	}
+	return b.bp.ProduceBlock(queryCtx, slot, proposer, randaoReveal, graffiti, skipRandaoVerification)
+}
+
+// SubmitSignedBlock verifies the block signed by an external validator client
+// on top of the tip state, and keeps it for proposal.
+func (b *Backend) SubmitSignedBlock(contents *ctypes.SignedBlockContents) error {
+	queryCtx, err := b.node.CreateQueryContext(0, false)
+	if err != nil {
+		return fmt.Errorf("CreateQueryContext failed: %w", err)
+	}
+	return b.bp.SubmitSignedBlock(queryCtx, contents)
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// SetFeeRecipients registers the fee recipients of the given validators,
+// resolving their public keys from the tip state. Validators unknown to the
+// tip state are skipped and returned.
+func (b *Backend) SetFeeRecipients(
+	recipients map[math.ValidatorIndex]common.ExecutionAddress,
+) ([]math.ValidatorIndex, error) {
+	st, _, err := b.StateAndSlotFromHeight(-1)
+	if err != nil {
+		return nil, err
+	}
+
+	var skipped []math.ValidatorIndex
+	for index, recipient := range recipients {
+		validator, errVal := st.ValidatorByIndex(index)
+		if errVal != nil {
+			skipped = append(skipped, index)
+			continue
+		}
+		if err = b.fr.SetFeeRecipient(validator.GetPubkey(), recipient); err != nil {
+			return nil, err
+		}
+	}
+	return skipped, nil
+}
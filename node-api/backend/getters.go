@@ -21,17 +21,22 @@
 package backend
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"runtime"
 
 	"cosmossdk.io/log"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
 	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
 	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/node-core/services/health"
+	"github.com/berachain/beacon-kit/node-core/types/network"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/deposit/snapshot"
 	cmttypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -74,6 +79,13 @@ func (b *Backend) StateAndSlotFromHeight(height int64) (ReadOnlyBeaconState, mat
 	height = max(0, height) // CreateQueryContext uses 0 to pick latest height.
 	queryCtx, err := b.node.CreateQueryContext(height, false)
 	if err != nil {
+		// A committed height may have been pruned from the IAVL store, fall back to the state
+		// archive if enabled. Heights not committed yet are not archived either, so their error
+		// is returned as is.
+		latestHeight, _ := b.node.GetSyncData()
+		if height > 0 && height <= latestHeight && b.sb.StateArchive().Enabled() {
+			return b.archivedStateAtSlot(math.Slot(height))
+		}
 		return nil, 0, fmt.Errorf("CreateQueryContext failed: %w", err)
 	}
 	st := b.sb.StateFromContext(queryCtx)
@@ -91,6 +103,24 @@ func (b *Backend) StateAndSlotFromHeight(height int64) (ReadOnlyBeaconState, mat
 	return st, slot, nil
 }
 
+// archivedStateAtSlot reconstructs the beacon state at the given slot from the state archive,
+// loading it into an ephemeral in-memory store.
+func (b *Backend) archivedStateAtSlot(slot math.Slot) (ReadOnlyBeaconState, math.Slot, error) {
+	archived, err := b.sb.StateArchive().StateAt(slot)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed loading archived state at slot %d: %w", slot, err)
+	}
+	st, _, _, err := newInMemoryState(b.cs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err = st.SetMarshallable(archived); err != nil {
+		return nil, 0, fmt.Errorf("failed loading archived state at slot %d: %w", slot, err)
+	}
+	return st, slot, nil
+}
+
 // GetSlotByBlockRoot retrieves the slot by a block root from the block store.
 func (b *Backend) GetSlotByBlockRoot(root common.Root) (math.Slot, error) {
 	return b.sb.BlockStore().GetSlotByBlockRoot(root)
@@ -121,7 +151,15 @@ func (b *Backend) GetSignatureBySlot(slot math.Slot) (crypto.BLSSignature, error
 		txs[i] = tx
 	}
 
-	forkVersion := b.cs.ActiveForkVersionForTimestamp(math.U64(block.Header.Time.Unix())) //#nosec:G115
+	timestamp := math.U64(block.Header.Time.Unix()) //#nosec:G115
+	if b.cs.IsProposalCompressed(timestamp) {
+		var err error
+		if txs, err = encoding.DecompressTxs(txs, 0); err != nil {
+			return crypto.BLSSignature{}, fmt.Errorf("failed to decompress block at slot %d: %w", slot, err)
+		}
+	}
+
+	forkVersion := b.cs.ActiveForkVersionForTimestamp(timestamp)
 	signedBlock, err := encoding.UnmarshalBeaconBlockFromABCIRequest(txs, 0, forkVersion)
 	if err != nil {
 		return crypto.BLSSignature{}, fmt.Errorf("failed to unmarshal block at slot %d: %w", slot, err)
@@ -130,6 +168,26 @@ func (b *Backend) GetSignatureBySlot(slot math.Slot) (crypto.BLSSignature, error
 	return signedBlock.Signature, nil
 }
 
+// GetDepositsByIndex retrieves up to count deposits from the deposit store,
+// starting at the given deposit index.
+func (b *Backend) GetDepositsByIndex(startIndex, count uint64) (ctypes.Deposits, error) {
+	deposits, _, err := b.sb.DepositStore().GetDepositsByIndex(context.Background(), startIndex, count)
+	return deposits, err
+}
+
+// GetDepositBlockNumber retrieves the number of the execution block the deposit
+// with the given index was read from.
+func (b *Backend) GetDepositBlockNumber(index uint64) (math.U64, error) {
+	blockNumber, err := b.sb.DepositStore().GetDepositBlockNumber(context.Background(), index)
+	return math.U64(blockNumber), err
+}
+
+// GetDepositSnapshot retrieves the EIP-4881 snapshot of the deposit tree made of
+// the first maxCount deposits, or of all the deposits in store if there are fewer.
+func (b *Backend) GetDepositSnapshot(maxCount uint64) (*snapshot.Snapshot, error) {
+	return b.sb.DepositStore().GetSnapshot(context.Background(), maxCount)
+}
+
 func (b *Backend) GetBlobSidecarsAtSlot(slot math.Slot) (datypes.BlobSidecars, error) {
 	return b.sb.AvailabilityStore().GetBlobSidecars(slot)
 }
@@ -138,6 +196,30 @@ func (b *Backend) GetSyncData() (int64 /*latestHeight*/, int64 /*syncToHeight*/)
 	return b.node.GetSyncData()
 }
 
+func (b *Backend) GetNodeIdentity() (*network.NodeIdentity, error) {
+	return b.node.NodeIdentity()
+}
+
+func (b *Backend) GetPeers() []*network.PeerInfo {
+	return b.node.Peers()
+}
+
+// GetHealth runs the health checks of the node. A node without health monitor
+// is reported healthy.
+func (b *Backend) GetHealth(ctx context.Context) health.Report {
+	if b.hm == nil {
+		return health.Report{Status: health.StatusHealthy}
+	}
+	return b.hm.Check(ctx)
+}
+
+// ExecutionOptimistic returns true while the execution client has not
+// verified the payloads of the latest blocks, i.e. while the node runs in
+// optimistic mode.
+func (b *Backend) ExecutionOptimistic() bool {
+	return b.es != nil && b.es.IsOptimistic()
+}
+
 func (b *Backend) GetVersionData() (
 	string, // appName
 	string, // cometVersion
@@ -21,7 +21,10 @@
 package backend
 
 import (
+	"context"
+
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-core/services/health"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -38,6 +41,38 @@ type GenesisStateProcessor interface {
 	) (transition.ValidatorUpdates, error)
 }
 
+// BlockProducer builds unsigned blocks for external validator clients and
+// accepts the blocks they sign back. Both methods modify the state carried by
+// the given context.
+type BlockProducer interface {
+	ProduceBlock(
+		ctx context.Context,
+		slot math.Slot,
+		proposer *crypto.BLSPubkey,
+		randaoReveal crypto.BLSSignature,
+		graffiti *common.Bytes32,
+		skipRandaoVerification bool,
+	) (*ctypes.BlockContents, *math.U256, error)
+	SubmitSignedBlock(ctx context.Context, contents *ctypes.SignedBlockContents) error
+}
+
+// FeeRecipientRegistry stores the fee recipients validator clients register
+// for the blocks their validators propose.
+type FeeRecipientRegistry interface {
+	SetFeeRecipient(pubkey crypto.BLSPubkey, recipient common.ExecutionAddress) error
+}
+
+// HealthMonitor runs the health checks of the node.
+type HealthMonitor interface {
+	Check(ctx context.Context) health.Report
+}
+
+// ExecutionStatus reports whether the execution client is behind the blocks
+// processed by the node.
+type ExecutionStatus interface {
+	IsOptimistic() bool
+}
+
 // Keep just getters currently used. To be expanded as we increase API endpoints available
 type ReadOnlyBeaconState interface {
 	GetGenesisValidatorsRoot() (common.Root, error)
@@ -55,8 +90,13 @@ type ReadOnlyBeaconState interface {
 
 	GetValidators() (ctypes.Validators, error)
 	ValidatorByIndex(math.ValidatorIndex) (*ctypes.Validator, error)
+	ValidatorIndicesByWithdrawalCredentials(ctypes.WithdrawalCredentials) ([]math.ValidatorIndex, error)
+	ValidatorIndicesByStatus(math.Epoch, ...string) ([]math.ValidatorIndex, error)
 
 	GetPendingPartialWithdrawals() ([]*ctypes.PendingPartialWithdrawal, error)
 
+	GetEth1Data() (*ctypes.Eth1Data, error)
+	GetEth1DepositIndex() (uint64, error)
+
 	GetMarshallable() (*ctypes.BeaconState, error)
 }
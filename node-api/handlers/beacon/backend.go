@@ -21,11 +21,13 @@
 package beacon
 
 import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	datypes "github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/node-api/backend"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/deposit/snapshot"
 )
 
 // Backend is the interface for backend of the beacon API.
@@ -46,4 +48,17 @@ type Backend interface {
 
 	// GetSignatureBySlot retrieves the block signature for a given slot.
 	GetSignatureBySlot(slot math.Slot) (crypto.BLSSignature, error)
+
+	// Deposit store related methods
+	GetDepositsByIndex(startIndex, count uint64) (ctypes.Deposits, error)
+	GetDepositBlockNumber(index uint64) (math.U64, error)
+	GetDepositSnapshot(maxCount uint64) (*snapshot.Snapshot, error)
+
+	// SubmitSignedBlock keeps the block signed by an external validator
+	// client to be proposed for its slot.
+	SubmitSignedBlock(contents *ctypes.SignedBlockContents) error
+
+	// ExecutionOptimistic returns true while the execution client has not
+	// verified the payloads of the latest blocks.
+	ExecutionOptimistic() bool
 }
@@ -21,9 +21,18 @@
 package beacon
 
 import (
+	"fmt"
+	"io"
+	"strings"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	sszenc "github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/labstack/echo/v4"
 )
 
 func (h *Handler) GetBlockRewards(c handlers.Context) (any, error) {
@@ -43,5 +52,38 @@ func (h *Handler) GetBlockRewards(c handlers.Context) (any, error) {
 		ProposerSlashings: 1,
 		AttesterSlashings: 1,
 	}
-	return beacontypes.NewResponse(rewards), nil
+	return beacontypes.NewResponse(rewards, h.backend.ExecutionOptimistic()), nil
+}
+
+// PublishBlockV2 accepts a block signed by an external validator client, along
+// with the blobs it commits to. The block is verified against the tip state and
+// proposed once consensus requests a proposal for its slot from this node.
+//
+// Blocks are only accepted SSZ-encoded, as SignedBlockContents, with the fork
+// name set in the Eth-Consensus-Version header.
+func (h *Handler) PublishBlockV2(c handlers.Context) (any, error) {
+	if !strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEOctetStream) {
+		return nil, fmt.Errorf("%w: only SSZ-encoded blocks are supported", types.ErrInvalidRequest)
+	}
+	forkName := c.Request().Header.Get(utils.HeaderConsensusVersion)
+	forkVersion, ok := version.FromName(forkName)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown consensus version %q", types.ErrInvalidRequest, forkName)
+	}
+	contents, err := ctypes.NewEmptySignedBlockContentsWithVersion(forkVersion)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", types.ErrInvalidRequest, err.Error())
+	}
+
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = sszenc.Unmarshal(body, contents); err != nil {
+		return nil, fmt.Errorf("%w: %s", types.ErrInvalidRequest, err.Error())
+	}
+	if err = h.backend.SubmitSignedBlock(contents); err != nil {
+		return nil, err
+	}
+	return nil, nil
 }
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/handlers/beacon"
+	"github.com/berachain/beacon-kit/node-api/handlers/beacon/mocks"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/version"
+	testutils "github.com/berachain/beacon-kit/testing/utils"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishBlockV2(t *testing.T) {
+	t.Parallel()
+
+	cs, errSpec := spec.MainnetChainSpec()
+	require.NoError(t, errSpec)
+
+	errSubmit := errors.New("submit failed")
+	contents := &ctypes.SignedBlockContents{
+		SignedBlock: &ctypes.SignedBeaconBlock{
+			BeaconBlock: testutils.GenerateValidBeaconBlock(t, version.Electra()),
+			Signature:   [96]byte{0x01},
+		},
+		KZGProofs: []eip4844.KZGProof{{0x02}},
+		Blobs:     []eip4844.Blob{{0x03}},
+	}
+	encoded, err := contents.MarshalSSZ()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name                string
+		contentType         string
+		consensusVersion    string
+		body                []byte
+		setMockExpectations func(*mocks.Backend)
+		check               func(t *testing.T, err error)
+	}{
+		{
+			name:             "block submitted",
+			contentType:      echo.MIMEOctetStream,
+			consensusVersion: version.Name(version.Electra()),
+			body:             encoded,
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().SubmitSignedBlock(mock.Anything).Run(func(submitted *ctypes.SignedBlockContents) {
+					require.Equal(t, contents.SignedBlock.HashTreeRoot(), submitted.SignedBlock.HashTreeRoot())
+					require.Equal(t, contents.Blobs, submitted.Blobs)
+				}).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:                "json blocks are not supported",
+			contentType:         echo.MIMEApplicationJSON,
+			consensusVersion:    version.Name(version.Electra()),
+			body:                []byte("{}"),
+			setMockExpectations: func(*mocks.Backend) {},
+			check: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, handlertypes.ErrInvalidRequest)
+			},
+		},
+		{
+			name:                "unknown consensus version",
+			contentType:         echo.MIMEOctetStream,
+			consensusVersion:    "bogus",
+			body:                encoded,
+			setMockExpectations: func(*mocks.Backend) {},
+			check: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, handlertypes.ErrInvalidRequest)
+			},
+		},
+		{
+			name:                "malformed block",
+			contentType:         echo.MIMEOctetStream,
+			consensusVersion:    version.Name(version.Electra()),
+			body:                encoded[:len(encoded)/2],
+			setMockExpectations: func(*mocks.Backend) {},
+			check: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, handlertypes.ErrInvalidRequest)
+			},
+		},
+		{
+			name:             "rejected block",
+			contentType:      echo.MIMEOctetStream,
+			consensusVersion: version.Name(version.Electra()),
+			body:             encoded,
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().SubmitSignedBlock(mock.Anything).Return(errSubmit)
+			},
+			check: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, errSubmit)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// setup test
+			backend := mocks.NewBackend(t)
+			backend.EXPECT().ExecutionOptimistic().Return(false).Maybe()
+			h := beacon.NewHandler(backend, cs, noop.NewLogger[log.Logger]())
+			e := echo.New()
+
+			// set expectations
+			tc.setMockExpectations(backend)
+
+			// create input
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(tc.body))
+			req.Header.Set(echo.HeaderContentType, tc.contentType)
+			req.Header.Set(utils.HeaderConsensusVersion, tc.consensusVersion)
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			// test
+			res, err := h.PublishBlockV2(c)
+
+			// check
+			require.Nil(t, res)
+			tc.check(t, err)
+		})
+	}
+}
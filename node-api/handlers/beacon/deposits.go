@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"errors"
+	"fmt"
+	stdmath "math"
+
+	"cosmossdk.io/collections"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// defaultDepositsLimit is the number of deposits returned by GetDeposits
+// when no limit is requested.
+const defaultDepositsLimit = 100
+
+// GetPendingDeposits returns the deposits read from the deposit contract which
+// have not been included in a block as of the requested state.
+// Note that the deposit store only grows, so for historical states the deposits
+// read from the deposit contract after that state are reported as well.
+func (h *Handler) GetPendingDeposits(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetPendingDepositsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	height, err := utils.StateIDToHeight(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	st, _, err := h.backend.StateAndSlotFromHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	forkVersion, err := st.GetFork()
+	if err != nil {
+		return nil, err
+	}
+
+	// Deposits are included in order, so every deposit from the state
+	// eth1 deposit index onwards is still pending.
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get eth1 deposit index from state: %w", err)
+	}
+	deposits, err := h.backend.GetDepositsByIndex(depositIndex, stdmath.MaxUint64-depositIndex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deposits from index %d: %w", depositIndex, err)
+	}
+	data, err := h.toDepositsData(deposits)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewPendingDepositsResponse(forkVersion.CurrentVersion, data, h.backend.ExecutionOptimistic()), nil
+}
+
+// GetDeposits returns the content of the deposit store, starting at the
+// requested deposit index.
+func (h *Handler) GetDeposits(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetDepositsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultDepositsLimit
+	}
+	deposits, err := h.backend.GetDepositsByIndex(req.StartIndex, min(limit, stdmath.MaxUint64-req.StartIndex))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deposits from index %d: %w", req.StartIndex, err)
+	}
+	data, err := h.toDepositsData(deposits)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewResponse(data, h.backend.ExecutionOptimistic()), nil
+}
+
+// GetDepositRoot returns the deposit root and the number of deposits included
+// in the beacon chain as of the requested state.
+func (h *Handler) GetDepositRoot(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetDepositRootRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	height, err := utils.StateIDToHeight(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	st, _, err := h.backend.StateAndSlotFromHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	eth1Data, err := st.GetEth1Data()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get eth1 data from state: %w", err)
+	}
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get eth1 deposit index from state: %w", err)
+	}
+	return beacontypes.NewResponse(&beacontypes.DepositRootData{
+		DepositRoot:  eth1Data.DepositRoot,
+		DepositCount: depositIndex,
+	}, h.backend.ExecutionOptimistic()), nil
+}
+
+// GetDepositSnapshot returns the EIP-4881 snapshot of the deposit tree made of
+// the deposits included in the beacon chain as of the head state.
+// Starting in Fulu, deposits are processed from EIP-6110 deposit requests, which
+// the deposit store does not track, so the snapshot stops at the deposits
+// included by the first Fulu block.
+func (h *Handler) GetDepositSnapshot(c handlers.Context) (any, error) {
+	if _, err := utils.BindAndValidate[beacontypes.GetDepositTreeSnapshotRequest](
+		c, h.Logger(),
+	); err != nil {
+		return nil, err
+	}
+
+	st, _, err := h.backend.StateAndSlotFromHeight(utils.Head)
+	if err != nil {
+		return nil, err
+	}
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get eth1 deposit index from state: %w", err)
+	}
+	s, err := h.backend.GetDepositSnapshot(depositIndex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deposit snapshot: %w", err)
+	}
+	return beacontypes.NewResponse(s, h.backend.ExecutionOptimistic()), nil
+}
+
+func (h *Handler) toDepositsData(deposits ctypes.Deposits) ([]*beacontypes.DepositData, error) {
+	data := make([]*beacontypes.DepositData, len(deposits))
+	for i, dep := range deposits {
+		data[i] = &beacontypes.DepositData{
+			Index:                 dep.GetIndex().Unwrap(),
+			Pubkey:                dep.GetPubkey().String(),
+			WithdrawalCredentials: dep.GetWithdrawalCredentials().String(),
+			Amount:                dep.GetAmount().Unwrap(),
+			Signature:             dep.Signature.String(),
+		}
+
+		blockNumber, err := h.backend.GetDepositBlockNumber(dep.GetIndex().Unwrap())
+		switch {
+		case err == nil:
+			data[i].ExecutionBlockNumber = blockNumber.Base10()
+		case errors.Is(err, collections.ErrNotFound):
+			// Genesis deposits are not read from an execution block.
+		default:
+			return nil, fmt.Errorf("failed to get block number of deposit %d: %w", dep.GetIndex(), err)
+		}
+	}
+	return data, nil
+}
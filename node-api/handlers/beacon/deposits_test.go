@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/handlers/beacon"
+	"github.com/berachain/beacon-kit/node-api/handlers/beacon/mocks"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/node-api/middleware"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/storage/deposit/snapshot"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPendingDeposits(t *testing.T) {
+	t.Parallel()
+
+	cs, errSpec := spec.MainnetChainSpec()
+	require.NoError(t, errSpec)
+
+	fork := &ctypes.Fork{
+		PreviousVersion: version.Deneb1(),
+		CurrentVersion:  version.Electra(),
+		Epoch:           math.Epoch(200),
+	}
+	pendingDeposits := ctypes.Deposits{
+		{
+			Pubkey:      [48]byte{0x01},
+			Credentials: ctypes.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x01}),
+			Amount:      math.Gwei(32e9),
+			Index:       3,
+		},
+		{
+			Pubkey:      [48]byte{0x02},
+			Credentials: ctypes.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x02}),
+			Amount:      math.Gwei(1e9),
+			Index:       4,
+		},
+	}
+
+	testCases := []struct {
+		name                string
+		setMockExpectations func(*mocks.Backend)
+		check               func(t *testing.T, res any, err error)
+	}{
+		{
+			name: "deposits past the state deposit index are pending",
+			setMockExpectations: func(b *mocks.Backend) {
+				st := makeTestState(t, cs)
+				require.NoError(t, st.SetFork(fork))
+				require.NoError(t, st.SetEth1DepositIndex(3))
+
+				// slot is not really tested here, we just return zero
+				b.EXPECT().StateAndSlotFromHeight(mock.Anything).Return(st, math.Slot(0), nil)
+				b.EXPECT().GetDepositsByIndex(uint64(3), mock.Anything).Return(pendingDeposits, nil)
+				b.EXPECT().GetDepositBlockNumber(uint64(3)).Return(math.U64(100), nil)
+				b.EXPECT().GetDepositBlockNumber(uint64(4)).Return(math.U64(0), collections.ErrNotFound)
+			},
+			check: func(t *testing.T, res any, err error) {
+				t.Helper()
+
+				require.NoError(t, err)
+				require.IsType(t, beacontypes.PendingDepositsResponse{}, res)
+				resp, _ := res.(beacontypes.PendingDepositsResponse)
+				require.Equal(t, version.Name(fork.CurrentVersion), resp.Version)
+
+				require.IsType(t, []*beacontypes.DepositData{}, resp.GenericResponse.Data)
+				data, _ := resp.GenericResponse.Data.([]*beacontypes.DepositData)
+				require.Len(t, data, len(pendingDeposits))
+				for i, dep := range pendingDeposits {
+					require.Equal(t, dep.Index.Unwrap(), data[i].Index)
+					require.Equal(t, dep.Pubkey.String(), data[i].Pubkey)
+					require.Equal(t, dep.Amount.Unwrap(), data[i].Amount)
+				}
+				require.Equal(t, "100", data[0].ExecutionBlockNumber)
+				require.Empty(t, data[1].ExecutionBlockNumber)
+			},
+		},
+		{
+			name: "no pending deposits",
+			setMockExpectations: func(b *mocks.Backend) {
+				st := makeTestState(t, cs)
+				require.NoError(t, st.SetFork(fork))
+				require.NoError(t, st.SetEth1DepositIndex(5))
+
+				// slot is not really tested here, we just return zero
+				b.EXPECT().StateAndSlotFromHeight(mock.Anything).Return(st, math.Slot(0), nil)
+				b.EXPECT().GetDepositsByIndex(uint64(5), mock.Anything).Return(ctypes.Deposits{}, nil)
+			},
+			check: func(t *testing.T, res any, err error) {
+				t.Helper()
+
+				require.NoError(t, err)
+				require.IsType(t, beacontypes.PendingDepositsResponse{}, res)
+				resp, _ := res.(beacontypes.PendingDepositsResponse)
+				data, _ := resp.GenericResponse.Data.([]*beacontypes.DepositData)
+				require.Empty(t, data)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// setup test
+			backend := mocks.NewBackend(t)
+			backend.EXPECT().ExecutionOptimistic().Return(false).Maybe()
+			h := beacon.NewHandler(backend, cs, noop.NewLogger[log.Logger]())
+			e := echo.New()
+			e.Validator = &middleware.CustomValidator{
+				Validator: middleware.ConstructValidator(),
+			}
+
+			// set expectations
+			tc.setMockExpectations(backend)
+
+			// create input
+			input := beacontypes.GetPendingDepositsRequest{
+				StateIDRequest: handlertypes.StateIDRequest{
+					StateID: utils.StateIDHead,
+				},
+			}
+			inputBytes, err := json.Marshal(input) //nolint:musttag //  TODO:fix
+			require.NoError(t, err)
+			body := strings.NewReader(string(inputBytes))
+			req := httptest.NewRequest(http.MethodGet, "/", body)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON) // otherwise code=415, message=Unsupported Media Type
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			// test
+			res, err := h.GetPendingDeposits(c)
+
+			// check
+			tc.check(t, res, err)
+		})
+	}
+}
+
+func TestGetDepositSnapshot(t *testing.T) {
+	t.Parallel()
+
+	cs, errSpec := spec.MainnetChainSpec()
+	require.NoError(t, errSpec)
+
+	backend := mocks.NewBackend(t)
+	backend.EXPECT().ExecutionOptimistic().Return(false).Maybe()
+	h := beacon.NewHandler(backend, cs, noop.NewLogger[log.Logger]())
+	e := echo.New()
+	e.Validator = &middleware.CustomValidator{
+		Validator: middleware.ConstructValidator(),
+	}
+
+	// The snapshot is requested up to the deposits included as of the head state.
+	st := makeTestState(t, cs)
+	require.NoError(t, st.SetEth1DepositIndex(7))
+	s := &snapshot.Snapshot{
+		Finalized:            []common.Root{{0x01}, {0x02}, {0x03}},
+		DepositRoot:          common.Root{0x04},
+		DepositCount:         7,
+		ExecutionBlockHeight: 100,
+	}
+	backend.EXPECT().StateAndSlotFromHeight(utils.Head).Return(st, math.Slot(0), nil)
+	backend.EXPECT().GetDepositSnapshot(uint64(7)).Return(s, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	c := e.NewContext(req, httptest.NewRecorder())
+	res, err := h.GetDepositSnapshot(c)
+	require.NoError(t, err)
+	require.IsType(t, beacontypes.GenericResponse{}, res)
+	resp, _ := res.(beacontypes.GenericResponse)
+	require.Equal(t, s, resp.Data)
+}
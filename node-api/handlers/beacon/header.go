@@ -137,11 +137,11 @@ func (h *Handler) makeBlockHeaderResponse(height int64, resultsInList bool) (any
 	}
 
 	if !resultsInList {
-		return beacontypes.NewResponse(&headerResp), nil
+		return beacontypes.NewResponse(&headerResp, h.backend.ExecutionOptimistic()), nil
 	}
 
 	res := []beacontypes.BlockHeaderResponse{
 		headerResp,
 	}
-	return beacontypes.NewResponse(res), nil
+	return beacontypes.NewResponse(res, h.backend.ExecutionOptimistic()), nil
 }
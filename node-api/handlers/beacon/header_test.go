@@ -341,6 +341,7 @@ func TestGetBlockHeaders(t *testing.T) {
 
 			// setup test
 			backend := mocks.NewBackend(t)
+			backend.EXPECT().ExecutionOptimistic().Return(false).Maybe()
 			h := beacon.NewHandler(backend, cs, noop.NewLogger[log.Logger]())
 			e := echo.New()
 			e.Validator = &middleware.CustomValidator{
@@ -552,6 +553,7 @@ func TestGetBlockHeaderByID(t *testing.T) {
 
 			// setup test
 			backend := mocks.NewBackend(t)
+			backend.EXPECT().ExecutionOptimistic().Return(false).Maybe()
 			h := beacon.NewHandler(backend, cs, noop.NewLogger[log.Logger]())
 			e := echo.New()
 			e.Validator = &middleware.CustomValidator{
@@ -41,7 +41,7 @@ func (h *Handler) GetStateRoot(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	return beacontypes.NewResponse(beacontypes.RootData{Root: st.HashTreeRoot()}), nil
+	return beacontypes.NewResponse(beacontypes.RootData{Root: st.HashTreeRoot()}, h.backend.ExecutionOptimistic()), nil
 }
 
 func (h *Handler) GetStateFork(c handlers.Context) (any, error) {
@@ -63,5 +63,5 @@ func (h *Handler) GetStateFork(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	return beacontypes.NewResponse(fork), nil
+	return beacontypes.NewResponse(fork, h.backend.ExecutionOptimistic()), nil
 }
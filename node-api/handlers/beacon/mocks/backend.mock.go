@@ -5,11 +5,15 @@ package mocks
 import (
 	backend "github.com/berachain/beacon-kit/node-api/backend"
 
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+
 	types "github.com/berachain/beacon-kit/da/types"
 	common "github.com/berachain/beacon-kit/primitives/common"
 	crypto "github.com/berachain/beacon-kit/primitives/crypto"
 	math "github.com/berachain/beacon-kit/primitives/math"
 	mock "github.com/stretchr/testify/mock"
+
+	snapshot "github.com/berachain/beacon-kit/storage/deposit/snapshot"
 )
 
 // Backend is an autogenerated mock type for the Backend type
@@ -25,6 +29,51 @@ func (_m *Backend) EXPECT() *Backend_Expecter {
 	return &Backend_Expecter{mock: &_m.Mock}
 }
 
+// ExecutionOptimistic provides a mock function with no fields
+func (_m *Backend) ExecutionOptimistic() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecutionOptimistic")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Backend_ExecutionOptimistic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecutionOptimistic'
+type Backend_ExecutionOptimistic_Call struct {
+	*mock.Call
+}
+
+// ExecutionOptimistic is a helper method to define mock.On call
+func (_e *Backend_Expecter) ExecutionOptimistic() *Backend_ExecutionOptimistic_Call {
+	return &Backend_ExecutionOptimistic_Call{Call: _e.mock.On("ExecutionOptimistic")}
+}
+
+func (_c *Backend_ExecutionOptimistic_Call) Run(run func()) *Backend_ExecutionOptimistic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Backend_ExecutionOptimistic_Call) Return(_a0 bool) *Backend_ExecutionOptimistic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Backend_ExecutionOptimistic_Call) RunAndReturn(run func() bool) *Backend_ExecutionOptimistic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBlobSidecarsAtSlot provides a mock function with given fields: slot
 func (_m *Backend) GetBlobSidecarsAtSlot(slot math.Slot) (types.BlobSidecars, error) {
 	ret := _m.Called(slot)
@@ -83,6 +132,179 @@ func (_c *Backend_GetBlobSidecarsAtSlot_Call) RunAndReturn(run func(math.Slot) (
 	return _c
 }
 
+// GetDepositBlockNumber provides a mock function with given fields: index
+func (_m *Backend) GetDepositBlockNumber(index uint64) (math.U64, error) {
+	ret := _m.Called(index)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDepositBlockNumber")
+	}
+
+	var r0 math.U64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (math.U64, error)); ok {
+		return rf(index)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) math.U64); ok {
+		r0 = rf(index)
+	} else {
+		r0 = ret.Get(0).(math.U64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(index)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Backend_GetDepositBlockNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDepositBlockNumber'
+type Backend_GetDepositBlockNumber_Call struct {
+	*mock.Call
+}
+
+// GetDepositBlockNumber is a helper method to define mock.On call
+//   - index uint64
+func (_e *Backend_Expecter) GetDepositBlockNumber(index interface{}) *Backend_GetDepositBlockNumber_Call {
+	return &Backend_GetDepositBlockNumber_Call{Call: _e.mock.On("GetDepositBlockNumber", index)}
+}
+
+func (_c *Backend_GetDepositBlockNumber_Call) Run(run func(index uint64)) *Backend_GetDepositBlockNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint64))
+	})
+	return _c
+}
+
+func (_c *Backend_GetDepositBlockNumber_Call) Return(_a0 math.U64, _a1 error) *Backend_GetDepositBlockNumber_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Backend_GetDepositBlockNumber_Call) RunAndReturn(run func(uint64) (math.U64, error)) *Backend_GetDepositBlockNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDepositSnapshot provides a mock function with given fields: maxCount
+func (_m *Backend) GetDepositSnapshot(maxCount uint64) (*snapshot.Snapshot, error) {
+	ret := _m.Called(maxCount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDepositSnapshot")
+	}
+
+	var r0 *snapshot.Snapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*snapshot.Snapshot, error)); ok {
+		return rf(maxCount)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *snapshot.Snapshot); ok {
+		r0 = rf(maxCount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*snapshot.Snapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(maxCount)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Backend_GetDepositSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDepositSnapshot'
+type Backend_GetDepositSnapshot_Call struct {
+	*mock.Call
+}
+
+// GetDepositSnapshot is a helper method to define mock.On call
+//   - maxCount uint64
+func (_e *Backend_Expecter) GetDepositSnapshot(maxCount interface{}) *Backend_GetDepositSnapshot_Call {
+	return &Backend_GetDepositSnapshot_Call{Call: _e.mock.On("GetDepositSnapshot", maxCount)}
+}
+
+func (_c *Backend_GetDepositSnapshot_Call) Run(run func(maxCount uint64)) *Backend_GetDepositSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint64))
+	})
+	return _c
+}
+
+func (_c *Backend_GetDepositSnapshot_Call) Return(_a0 *snapshot.Snapshot, _a1 error) *Backend_GetDepositSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Backend_GetDepositSnapshot_Call) RunAndReturn(run func(uint64) (*snapshot.Snapshot, error)) *Backend_GetDepositSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDepositsByIndex provides a mock function with given fields: startIndex, count
+func (_m *Backend) GetDepositsByIndex(startIndex uint64, count uint64) (ctypes.Deposits, error) {
+	ret := _m.Called(startIndex, count)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDepositsByIndex")
+	}
+
+	var r0 ctypes.Deposits
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64) (ctypes.Deposits, error)); ok {
+		return rf(startIndex, count)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, uint64) ctypes.Deposits); ok {
+		r0 = rf(startIndex, count)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ctypes.Deposits)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, uint64) error); ok {
+		r1 = rf(startIndex, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Backend_GetDepositsByIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDepositsByIndex'
+type Backend_GetDepositsByIndex_Call struct {
+	*mock.Call
+}
+
+// GetDepositsByIndex is a helper method to define mock.On call
+//   - startIndex uint64
+//   - count uint64
+func (_e *Backend_Expecter) GetDepositsByIndex(startIndex interface{}, count interface{}) *Backend_GetDepositsByIndex_Call {
+	return &Backend_GetDepositsByIndex_Call{Call: _e.mock.On("GetDepositsByIndex", startIndex, count)}
+}
+
+func (_c *Backend_GetDepositsByIndex_Call) Run(run func(startIndex uint64, count uint64)) *Backend_GetDepositsByIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint64), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *Backend_GetDepositsByIndex_Call) Return(_a0 ctypes.Deposits, _a1 error) *Backend_GetDepositsByIndex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Backend_GetDepositsByIndex_Call) RunAndReturn(run func(uint64, uint64) (ctypes.Deposits, error)) *Backend_GetDepositsByIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSignatureBySlot provides a mock function with given fields: slot
 func (_m *Backend) GetSignatureBySlot(slot math.Slot) (crypto.BLSSignature, error) {
 	ret := _m.Called(slot)
@@ -373,6 +595,52 @@ func (_c *Backend_StateAndSlotFromHeight_Call) RunAndReturn(run func(int64) (bac
 	return _c
 }
 
+// SubmitSignedBlock provides a mock function with given fields: contents
+func (_m *Backend) SubmitSignedBlock(contents *ctypes.SignedBlockContents) error {
+	ret := _m.Called(contents)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitSignedBlock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*ctypes.SignedBlockContents) error); ok {
+		r0 = rf(contents)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Backend_SubmitSignedBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitSignedBlock'
+type Backend_SubmitSignedBlock_Call struct {
+	*mock.Call
+}
+
+// SubmitSignedBlock is a helper method to define mock.On call
+//   - contents *ctypes.SignedBlockContents
+func (_e *Backend_Expecter) SubmitSignedBlock(contents interface{}) *Backend_SubmitSignedBlock_Call {
+	return &Backend_SubmitSignedBlock_Call{Call: _e.mock.On("SubmitSignedBlock", contents)}
+}
+
+func (_c *Backend_SubmitSignedBlock_Call) Run(run func(contents *ctypes.SignedBlockContents)) *Backend_SubmitSignedBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*ctypes.SignedBlockContents))
+	})
+	return _c
+}
+
+func (_c *Backend_SubmitSignedBlock_Call) Return(_a0 error) *Backend_SubmitSignedBlock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Backend_SubmitSignedBlock_Call) RunAndReturn(run func(*ctypes.SignedBlockContents) error) *Backend_SubmitSignedBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewBackend creates a new instance of Backend. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewBackend(t interface {
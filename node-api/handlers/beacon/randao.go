@@ -65,5 +65,5 @@ func (h *Handler) GetRandao(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	return beacontypes.NewResponse(randao), nil
+	return beacontypes.NewResponse(randao, h.backend.ExecutionOptimistic()), nil
 }
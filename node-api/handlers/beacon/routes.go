@@ -77,7 +77,7 @@ func registerRoutes(h *Handler) {
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/states/:state_id/validator_identities",
-			Handler: h.NotImplemented,
+			Handler: h.PostStateValidatorIdentities,
 		},
 		{
 			Method:  http.MethodGet,
@@ -97,7 +97,7 @@ func registerRoutes(h *Handler) {
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/pending_deposits",
-			Handler: h.NotImplemented,
+			Handler: h.GetPendingDeposits,
 		},
 		{
 			Method:  http.MethodGet,
@@ -131,8 +131,8 @@ func registerRoutes(h *Handler) {
 		},
 		{
 			Method:  http.MethodPost,
-			Path:    "eth/v2/beacon/blocks",
-			Handler: h.NotImplemented,
+			Path:    "/eth/v2/beacon/blocks",
+			Handler: h.PublishBlockV2,
 		},
 		{
 			Method:  http.MethodGet,
@@ -167,7 +167,7 @@ func registerRoutes(h *Handler) {
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/deposit_snapshot",
-			Handler: h.Deprecated,
+			Handler: h.GetDepositSnapshot,
 		},
 		{
 			Method:  http.MethodGet,
@@ -279,5 +279,20 @@ func registerRoutes(h *Handler) {
 			Path:    "/eth/v1/beacon/pool/bls_to_execution_changes",
 			Handler: h.NotImplemented,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/beacon/states/:state_id/validators/withdrawal_address/:withdrawal_address",
+			Handler: h.GetValidatorsByWithdrawalAddress,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/deposits",
+			Handler: h.GetDeposits,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/deposits/root/:state_id",
+			Handler: h.GetDepositRoot,
+		},
 	})
 }
@@ -47,6 +47,19 @@ type GetPendingPartialWithdrawalsRequest struct {
 	types.StateIDRequest
 }
 
+type GetPendingDepositsRequest struct {
+	types.StateIDRequest
+}
+
+type GetDepositsRequest struct {
+	StartIndex uint64 `query:"start_index"`
+	Limit      uint64 `query:"limit"       validate:"max=1024"`
+}
+
+type GetDepositRootRequest struct {
+	types.StateIDRequest
+}
+
 type GetStateValidatorsRequest struct {
 	types.StateIDRequest
 	IDs      []string `query:"id"     validate:"max=1024,dive,validator_id"`
@@ -74,6 +87,17 @@ type PostValidatorBalancesRequest struct {
 	IDs []string `json:"-" validate:"max=1024,dive,validator_id"`
 }
 
+type PostValidatorIdentitiesRequest struct {
+	types.StateIDRequest
+	IDs []string `json:"-" validate:"max=1024,dive,validator_id"`
+}
+
+type GetValidatorsByWithdrawalAddressRequest struct {
+	types.StateIDRequest
+	Address  string   `param:"withdrawal_address" validate:"required,eth_addr"`
+	Statuses []string `query:"status"             validate:"max=16,dive,validator_status"`
+}
+
 type GetStateCommitteesRequest struct {
 	types.StateIDRequest
 	EpochOptionalRequest
@@ -32,12 +32,13 @@ type GenericResponse struct {
 }
 
 // NewResponse creates a new response with CometBFT's finality guarantees.
-func NewResponse(data any) GenericResponse {
+// executionOptimistic is true while the execution client has not verified
+// the payloads of the latest blocks.
+func NewResponse(data any, executionOptimistic bool) GenericResponse {
 	return GenericResponse{
 		// All data is finalized in CometBFT since we only return data for slots up to head
-		Finalized: true,
-		// Never optimistic since we only return finalized data
-		ExecutionOptimistic: false,
+		Finalized:           true,
+		ExecutionOptimistic: executionOptimistic,
 		Data:                data,
 	}
 }
@@ -102,6 +103,12 @@ type ValidatorBalanceData struct {
 	Balance uint64 `json:"balance,string"`
 }
 
+type ValidatorIdentityData struct {
+	Index           uint64 `json:"index,string"`
+	Pubkey          string `json:"pubkey"`
+	ActivationEpoch string `json:"activation_epoch"`
+}
+
 // Validator is the spec representation of the struct.
 type Validator struct {
 	PublicKey                  string `json:"pubkey"`
@@ -160,10 +167,47 @@ type PendingPartialWithdrawalData struct {
 func NewPendingPartialWithdrawalsResponse(
 	forkVersion common.Version,
 	withdrawals []*PendingPartialWithdrawalData,
+	executionOptimistic bool,
 ) PendingPartialWithdrawalsResponse {
 	return PendingPartialWithdrawalsResponse{
 		// Version is the name of the fork version.
 		Version:         version.Name(forkVersion),
-		GenericResponse: NewResponse(withdrawals),
+		GenericResponse: NewResponse(withdrawals, executionOptimistic),
+	}
+}
+
+// PendingDepositsResponse has a version field to indicate the fork version.
+// https://ethereum.github.io/beacon-APIs/#/Beacon/getPendingDeposits
+type PendingDepositsResponse struct {
+	Version string `json:"version"`
+	GenericResponse
+}
+
+// DepositData is a deposit read from the deposit contract. ExecutionBlockNumber is the
+// number of the execution block the deposit was read from, empty if unknown (e.g. genesis deposits).
+type DepositData struct {
+	Index                 uint64 `json:"index,string"`
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount,string"`
+	Signature             string `json:"signature"`
+	ExecutionBlockNumber  string `json:"execution_block_number,omitempty"`
+}
+
+// NewPendingDepositsResponse creates a typed response with pending deposits data
+func NewPendingDepositsResponse(
+	forkVersion common.Version,
+	deposits []*DepositData,
+	executionOptimistic bool,
+) PendingDepositsResponse {
+	return PendingDepositsResponse{
+		// Version is the name of the fork version.
+		Version:         version.Name(forkVersion),
+		GenericResponse: NewResponse(deposits, executionOptimistic),
 	}
 }
+
+type DepositRootData struct {
+	DepositRoot  common.Root `json:"deposit_root"`
+	DepositCount uint64      `json:"deposit_count,string"`
+}
@@ -25,10 +25,12 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
 	types "github.com/berachain/beacon-kit/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/common"
 )
 
 func (h *Handler) GetStateValidators(c handlers.Context) (any, error) {
@@ -47,7 +49,7 @@ func (h *Handler) GetStateValidators(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to filter validators: %w", err)
 	}
-	return beacontypes.NewResponse(filteredVals), nil
+	return beacontypes.NewResponse(filteredVals, h.backend.ExecutionOptimistic()), nil
 }
 
 func (h *Handler) PostStateValidators(c handlers.Context) (any, error) {
@@ -66,7 +68,44 @@ func (h *Handler) PostStateValidators(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to filter validators: %w", err)
 	}
-	return beacontypes.NewResponse(filteredVals), nil
+	return beacontypes.NewResponse(filteredVals, h.backend.ExecutionOptimistic()), nil
+}
+
+// GetValidatorsByWithdrawalAddress returns the validators withdrawing to the
+// requested execution address, optionally filtered by status.
+func (h *Handler) GetValidatorsByWithdrawalAddress(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetValidatorsByWithdrawalAddressRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var address common.ExecutionAddress
+	if err = address.UnmarshalText([]byte(req.Address)); err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), types.ErrInvalidRequest)
+	}
+	height, err := utils.StateIDToHeight(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	st, epoch, err := h.stateAndEpochFromHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	indices, err := st.ValidatorIndicesByWithdrawalCredentials(ctypes.NewCredentialsFromExecutionAddress(address))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validator indices by withdrawal address: %w", err)
+	}
+	if indices, err = filterByStatus(st, epoch, indices, req.Statuses); err != nil {
+		return nil, err
+	}
+	vals, err := buildValidatorsData(st, epoch, indices)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewResponse(vals, h.backend.ExecutionOptimistic()), nil
 }
 
 func (h *Handler) GetStateValidator(c handlers.Context) (any, error) {
@@ -85,7 +124,7 @@ func (h *Handler) GetStateValidator(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	return beacontypes.NewResponse(valData), err
+	return beacontypes.NewResponse(valData, h.backend.ExecutionOptimistic()), err
 }
 
 // getValidator contains all the logic of the GetStateValidator api
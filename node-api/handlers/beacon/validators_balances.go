@@ -50,7 +50,7 @@ func (h *Handler) GetStateValidatorBalances(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	return beacontypes.NewResponse(balances), nil
+	return beacontypes.NewResponse(balances, h.backend.ExecutionOptimistic()), nil
 }
 
 func (h *Handler) PostStateValidatorBalances(c handlers.Context) (any, error) {
@@ -76,7 +76,7 @@ func (h *Handler) PostStateValidatorBalances(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	return beacontypes.NewResponse(balances), nil
+	return beacontypes.NewResponse(balances, h.backend.ExecutionOptimistic()), nil
 }
 
 func (h *Handler) getValidatorBalance(height int64, validatorIDs []string) ([]*beacontypes.ValidatorBalanceData, error) {
@@ -211,6 +211,7 @@ func TestGetStateValidatorBalances(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// setup test
 			backend := mocks.NewBackend(t)
+			backend.EXPECT().ExecutionOptimistic().Return(false).Maybe()
 			h := beacon.NewHandler(backend, cs, noop.NewLogger[log.Logger]())
 			e := echo.New()
 			e.Validator = &middleware.CustomValidator{
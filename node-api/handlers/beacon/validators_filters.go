@@ -24,7 +24,7 @@ import (
 	"fmt"
 	"slices"
 
-	consensustypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"cosmossdk.io/collections"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/node-api/backend"
@@ -35,37 +35,40 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// errStatusFilterMismatch is an error for when a validator status does not
-// match the status filter.
-var errStatusFilterMismatch = errors.New("validator status does not match status filter")
-
 // FilterValidators is a helper function to provide implementation
 // consistency between GetStateValidators and PostStateValidators, since they
 // are intended to behave the same way.
 func (h *Handler) FilterValidators(height int64, ids []string, statuses []string) ([]*beacontypes.ValidatorData, error) {
+	st, epoch, err := h.stateAndEpochFromHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse all IDs and pubkeys once at the start
+	filters := parseValidatorIDs(ids)
+	indices, err := filters.selectIndices(st, epoch, statuses)
+	if err != nil {
+		return nil, err
+	}
+	return buildValidatorsData(st, epoch, indices)
+}
+
+// stateAndEpochFromHeight loads the state at the given height, together with
+// its epoch.
+func (h *Handler) stateAndEpochFromHeight(height int64) (backend.ReadOnlyBeaconState, math.Epoch, error) {
 	st, resolvedSlot, err := h.backend.StateAndSlotFromHeight(height)
 	if err != nil {
 		if errors.Is(err, cometbft.ErrAppNotReady) {
 			// chain not ready, like when genesis time is set in the future
-			return nil, handlertypes.ErrNotFound
+			return nil, 0, handlertypes.ErrNotFound
 		}
 		if errors.Is(err, sdkerrors.ErrInvalidHeight) {
 			// height requested too high
-			return nil, handlertypes.ErrNotFound
+			return nil, 0, handlertypes.ErrNotFound
 		}
-		return nil, fmt.Errorf("failed to get state from height %d: %w", height, err)
+		return nil, 0, fmt.Errorf("failed to get state from height %d: %w", height, err)
 	}
-
-	allVals, err := st.GetValidators()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get validators: %w", err)
-	}
-
-	// Parse all IDs and pubkeys once at the start
-	filters := parseValidatorIDs(ids)
-	epoch := h.cs.SlotToEpoch(resolvedSlot)
-
-	return filterAndBuildValidatorData(st, allVals, filters, epoch, statuses)
+	return st, h.cs.SlotToEpoch(resolvedSlot), nil
 }
 
 type validatorFilters struct {
@@ -76,8 +79,8 @@ type validatorFilters struct {
 // parseID attempts to parse a single ID as either a numeric ID or pubkey
 func (f *validatorFilters) parseID(id string) {
 	// Try parsing as numeric ID first
-	if index, err := math.U64FromString(id); err == nil {
-		f.indexes[index.Unwrap()] = struct{}{}
+	if idx, err := math.U64FromString(id); err == nil {
+		f.indexes[idx.Unwrap()] = struct{}{}
 		return
 	}
 
@@ -104,102 +107,120 @@ func parseValidatorIDs(ids []string) *validatorFilters {
 	return filters
 }
 
-// filterAndBuildValidatorData processes all validators and builds their data based on filters
-func filterAndBuildValidatorData(
+// selectIndices returns the indices of the validators matching the filters and
+// any of the statuses at the given epoch, in ascending order. Pubkeys and
+// statuses are resolved through the state validator indexes, so that the full
+// validator set is only walked when no filter is set.
+func (f *validatorFilters) selectIndices(
 	st backend.ReadOnlyBeaconState,
-	validators []*consensustypes.Validator,
-	filters *validatorFilters,
 	epoch math.Epoch,
 	statuses []string,
-) ([]*beacontypes.ValidatorData, error) {
-	validatorData := make([]*beacontypes.ValidatorData, 0, len(validators))
-
-	for _, validator := range validators {
-		index, err := st.ValidatorIndexByPubkey(validator.GetPubkey())
+) ([]math.ValidatorIndex, error) {
+	if len(f.indexes) == 0 && len(f.pubkeys) == 0 {
+		if len(statuses) > 0 {
+			indices, err := st.ValidatorIndicesByStatus(epoch, statuses...)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get validator indices by status: %w", err)
+			}
+			return indices, nil
+		}
+		allVals, err := st.GetValidators()
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to get validator index by pubkey %s", validator.GetPubkey())
+			return nil, fmt.Errorf("failed to get validators: %w", err)
 		}
-
-		if !matchesFilters(validator, index, filters) {
-			continue
+		indices := make([]math.ValidatorIndex, len(allVals))
+		for i := range indices {
+			indices[i] = math.ValidatorIndex(i) // #nosec:G115 // Safe as i comes from range loop
 		}
+		return indices, nil
+	}
 
-		data, err := buildValidatorData(st, validator, index, epoch, statuses)
+	indices := make([]math.ValidatorIndex, 0, len(f.indexes)+len(f.pubkeys))
+	for idx := range f.indexes {
+		indices = append(indices, math.ValidatorIndex(idx))
+	}
+	for pubkey := range f.pubkeys {
+		idx, err := st.ValidatorIndexByPubkey(pubkey)
 		switch {
 		case err == nil:
-			validatorData = append(validatorData, data)
-		case errors.Is(err, errStatusFilterMismatch):
+			indices = append(indices, idx)
+		case errors.Is(err, collections.ErrNotFound):
+			// Unknown pubkeys are simply skipped.
 			continue
 		default:
-			return nil, err
+			return nil, errors.Wrapf(err, "failed to get validator index by pubkey %s", pubkey)
 		}
 	}
+	slices.Sort(indices)
+	indices = slices.Compact(indices)
 
-	return validatorData, nil
+	// Unknown indices are skipped as well, as are validators with other statuses.
+	return filterByStatus(st, epoch, indices, statuses)
 }
 
-// matchesFilters checks if a validator matches the filters.
-func matchesFilters(validator *consensustypes.Validator, index math.U64, filters *validatorFilters) bool {
-	// If no filters, accept all validators
-	if len(filters.indexes) == 0 && len(filters.pubkeys) == 0 {
-		return true
-	}
-
-	// Check numeric IDs
-	if len(filters.indexes) > 0 && matchesIndex(index, filters.indexes) {
-		return true
-	}
-
-	// Check pubkeys
-	if len(filters.pubkeys) > 0 && matchesPubkey(validator, filters.pubkeys) {
-		return true
+// filterByStatus returns the indices of the validators having any of the
+// statuses at the given epoch, skipping unknown validators. All indices match
+// if no status is set.
+func filterByStatus(
+	st backend.ReadOnlyBeaconState,
+	epoch math.Epoch,
+	indices []math.ValidatorIndex,
+	statuses []string,
+) ([]math.ValidatorIndex, error) {
+	filtered := make([]math.ValidatorIndex, 0, len(indices))
+	for _, idx := range indices {
+		validator, err := st.ValidatorByIndex(idx)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get validator at index %d", idx)
+		}
+		status, err := validator.Status(epoch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get validator status for validator pubkey %s", validator.GetPubkey())
+		}
+		if matchesStatusFilter(status, statuses) {
+			filtered = append(filtered, idx)
+		}
 	}
-
-	return false
-}
-
-func matchesPubkey(validator *consensustypes.Validator, parsedPubkeys map[crypto.BLSPubkey]struct{}) bool {
-	validatorPubkey := validator.GetPubkey()
-	_, exists := parsedPubkeys[validatorPubkey]
-	return exists
-}
-
-func matchesIndex(index math.U64, ids map[uint64]struct{}) bool {
-	_, exists := ids[index.Unwrap()]
-	return exists
+	return filtered, nil
 }
 
 func matchesStatusFilter(status string, statuses []string) bool {
 	return len(statuses) == 0 || slices.Contains(statuses, status)
 }
 
-func buildValidatorData(
+// buildValidatorsData builds the data of the validators at the given indices.
+func buildValidatorsData(
 	st backend.ReadOnlyBeaconState,
-	validator *consensustypes.Validator,
-	index math.U64,
 	epoch math.Epoch,
-	statuses []string,
-) (*beacontypes.ValidatorData, error) {
-	status, err := validator.Status(epoch)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get validator status for validator pubkey %s and index %d", validator.GetPubkey(), index)
-	}
+	indices []math.ValidatorIndex,
+) ([]*beacontypes.ValidatorData, error) {
+	validatorData := make([]*beacontypes.ValidatorData, 0, len(indices))
+	for _, idx := range indices {
+		validator, err := st.ValidatorByIndex(idx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get validator at index %d", idx)
+		}
+		status, err := validator.Status(epoch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get validator status for validator pubkey %s", validator.GetPubkey())
+		}
 
-	if !matchesStatusFilter(status, statuses) {
-		return nil, errStatusFilterMismatch
-	}
+		balance, err := st.GetBalance(idx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get validator balance for validator pubkey %s and index %d", validator.GetPubkey(), idx)
+		}
 
-	balance, err := st.GetBalance(index)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get validator balance for validator pubkey %s and index %d", validator.GetPubkey(), index)
+		validatorData = append(validatorData, &beacontypes.ValidatorData{
+			ValidatorBalanceData: beacontypes.ValidatorBalanceData{
+				Index:   idx.Unwrap(),
+				Balance: balance.Unwrap(),
+			},
+			Status:    status,
+			Validator: beacontypes.ValidatorFromConsensus(validator),
+		})
 	}
-
-	return &beacontypes.ValidatorData{
-		ValidatorBalanceData: beacontypes.ValidatorBalanceData{
-			Index:   index.Unwrap(),
-			Balance: balance.Unwrap(),
-		},
-		Status:    status,
-		Validator: beacontypes.ValidatorFromConsensus(validator),
-	}, nil
+	return validatorData, nil
 }
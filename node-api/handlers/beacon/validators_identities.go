@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+func (h *Handler) PostStateValidatorIdentities(c handlers.Context) (any, error) {
+	var ids []string
+	if err := c.Bind(&ids); err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), types.ErrInvalidRequest)
+	}
+	// Get state_id from URL path parameter
+	req := beacontypes.PostValidatorIdentitiesRequest{
+		StateIDRequest: types.StateIDRequest{StateID: c.Param("state_id")},
+		IDs:            ids,
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	height, err := utils.StateIDToHeight(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	identities, err := h.getValidatorIdentities(height, req.IDs)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewResponse(identities, h.backend.ExecutionOptimistic()), nil
+}
+
+// getValidatorIdentities returns the identities of the validators matching the
+// ids, or of all validators if no id is specified. Unknown ids are skipped.
+func (h *Handler) getValidatorIdentities(height int64, ids []string) ([]*beacontypes.ValidatorIdentityData, error) {
+	st, epoch, err := h.stateAndEpochFromHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	indices, err := parseValidatorIDs(ids).selectIndices(st, epoch, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	identities := make([]*beacontypes.ValidatorIdentityData, 0, len(indices))
+	for _, idx := range indices {
+		validator, err := st.ValidatorByIndex(idx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get validator at index %d: %w", idx, err)
+		}
+		identities = append(identities, &beacontypes.ValidatorIdentityData{
+			Index:           idx.Unwrap(),
+			Pubkey:          validator.GetPubkey().String(),
+			ActivationEpoch: validator.GetActivationEpoch().Base10(),
+		})
+	}
+	return identities, nil
+}
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/handlers/beacon"
+	"github.com/berachain/beacon-kit/node-api/handlers/beacon/mocks"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/node-api/middleware"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostStateValidatorIdentities(t *testing.T) {
+	t.Parallel()
+
+	cs, errSpec := spec.MainnetChainSpec()
+	require.NoError(t, errSpec)
+
+	// Create some input validators and store them to a readonly state
+	stateValidators := createStateValidators(cs)
+	toIdentity := func(val *beacontypes.ValidatorData) *beacontypes.ValidatorIdentityData {
+		return &beacontypes.ValidatorIdentityData{
+			Index:           val.Index,
+			Pubkey:          val.Validator.PublicKey,
+			ActivationEpoch: val.Validator.ActivationEpoch,
+		}
+	}
+
+	testCases := []struct {
+		name                string
+		ids                 []string
+		setMockExpectations func(*mocks.Backend)
+		check               func(t *testing.T, res any, err error)
+	}{
+		{
+			name: "all validators",
+			ids:  nil,
+			setMockExpectations: func(b *mocks.Backend) {
+				st := makeTestState(t, cs)
+				addTestValidators(t, stateValidators, st)
+
+				// slot is not really tested here, we just return zero
+				b.EXPECT().StateAndSlotFromHeight(mock.Anything).Return(st, math.Slot(0), nil)
+			},
+			check: func(t *testing.T, res any, err error) {
+				t.Helper()
+
+				require.NoError(t, err)
+				require.IsType(t, beacontypes.GenericResponse{}, res)
+				gr, _ := res.(beacontypes.GenericResponse)
+				require.IsType(t, []*beacontypes.ValidatorIdentityData{}, gr.Data)
+				data, _ := gr.Data.([]*beacontypes.ValidatorIdentityData)
+
+				require.Len(t, data, len(stateValidators))
+				for i, val := range stateValidators {
+					require.Equal(t, toIdentity(val), data[i], "index %d", i)
+				}
+			},
+		},
+		{
+			name: "validators by index and pubkey",
+			ids: []string{
+				stateValidators[3].Validator.PublicKey,
+				"1",
+				"1024",                          // unknown index, skipped
+				"0x" + strings.Repeat("ab", 48), // unknown pubkey, skipped
+			},
+			setMockExpectations: func(b *mocks.Backend) {
+				st := makeTestState(t, cs)
+				addTestValidators(t, stateValidators, st)
+
+				// slot is not really tested here, we just return zero
+				b.EXPECT().StateAndSlotFromHeight(mock.Anything).Return(st, math.Slot(0), nil)
+			},
+			check: func(t *testing.T, res any, err error) {
+				t.Helper()
+
+				require.NoError(t, err)
+				require.IsType(t, beacontypes.GenericResponse{}, res)
+				gr, _ := res.(beacontypes.GenericResponse)
+				data, _ := gr.Data.([]*beacontypes.ValidatorIdentityData)
+
+				// identities are returned ordered by index
+				require.Equal(t, []*beacontypes.ValidatorIdentityData{
+					toIdentity(stateValidators[1]),
+					toIdentity(stateValidators[3]),
+				}, data)
+			},
+		},
+		{
+			name: "app not ready",
+			ids:  nil,
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().StateAndSlotFromHeight(mock.Anything).Return(nil, math.Slot(0), cometbft.ErrAppNotReady)
+			},
+			check: func(t *testing.T, res any, err error) {
+				t.Helper()
+
+				// handlertypes.ErrNotFound is the error flag used to return 404 error code
+				require.ErrorIs(t, err, handlertypes.ErrNotFound)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// setup test
+			backend := mocks.NewBackend(t)
+			backend.EXPECT().ExecutionOptimistic().Return(false).Maybe()
+			h := beacon.NewHandler(backend, cs, noop.NewLogger[log.Logger]())
+			e := echo.New()
+			e.Validator = &middleware.CustomValidator{
+				Validator: middleware.ConstructValidator(),
+			}
+
+			// set expectations
+			tc.setMockExpectations(backend)
+
+			// Marshal only the IDs array for the POST body
+			inputBytes, err := json.Marshal(tc.ids)
+			require.NoError(t, err)
+			body := strings.NewReader(string(inputBytes))
+			req := httptest.NewRequest(http.MethodPost, "/", body)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON) // otherwise code=415, message=Unsupported Media Type
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			// Set the state_id as a URL path parameter
+			c.SetParamNames("state_id")
+			c.SetParamValues(utils.StateIDHead)
+
+			res, err := h.PostStateValidatorIdentities(c)
+			tc.check(t, res, err)
+		})
+	}
+}
@@ -75,5 +75,6 @@ func (h *Handler) GetPendingPartialWithdrawals(c handlers.Context) (any, error)
 	return beacontypes.NewPendingPartialWithdrawalsResponse(
 		forkVersion.CurrentVersion,
 		partialWithdrawals,
+		h.backend.ExecutionOptimistic(),
 	), nil
 }
@@ -30,4 +30,5 @@ import (
 type Backend interface {
 	GetSlotByStateRoot(root common.Root) (math.Slot, error)
 	StateAndSlotFromHeight(height int64) (backend.ReadOnlyBeaconState, math.Slot, error)
+	ExecutionOptimistic() bool
 }
@@ -57,9 +57,8 @@ func (h *Handler) GetState(c handlers.Context) (any, error) {
 
 	return beacontypes.StateResponse{
 		// All data is finalized in CometBFT since we only return data for slots up to head
-		Finalized: true,
-		// Never optimistic since we only return finalized data
-		ExecutionOptimistic: false,
+		Finalized:           true,
+		ExecutionOptimistic: h.backend.ExecutionOptimistic(),
 
 		Version: version.Name(fork.CurrentVersion),
 		Data:    beaconState,
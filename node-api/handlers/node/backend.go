@@ -20,7 +20,20 @@
 
 package node
 
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/node-core/services/health"
+	"github.com/berachain/beacon-kit/node-core/types/network"
+)
+
 type Backend interface {
+	// ExecutionOptimistic returns true while the execution client has not
+	// verified the payloads of the latest blocks.
+	ExecutionOptimistic() bool
+	GetHealth(ctx context.Context) health.Report
+	GetNodeIdentity() (*network.NodeIdentity, error)
+	GetPeers() []*network.PeerInfo
 	GetSyncData() (latestHeight int64, syncToHeight int64)
 	GetVersionData() (
 		appName,
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"net/http"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/node-core/services/health"
+)
+
+// Health reports the health of the node through the status code alone: 200
+// if healthy, 206 or the requested syncing_status if syncing, 503 otherwise.
+func (h *Handler) Health(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[types.HealthRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	report := h.backend.GetHealth(c.Request().Context())
+	code := statusCode(report.Status)
+	if report.Status == health.StatusSyncing && req.SyncingStatus != 0 {
+		code = req.SyncingStatus
+	}
+	return &handlers.StatusResponse{Code: code}, nil
+}
+
+// HealthReport returns the outcome of each health check of the node, with the
+// status code of Health.
+func (h *Handler) HealthReport(c handlers.Context) (any, error) {
+	report := h.backend.GetHealth(c.Request().Context())
+	return &handlers.StatusResponse{
+		Code: statusCode(report.Status),
+		Data: types.Wrap(&report),
+	}, nil
+}
+
+// statusCode returns the status code of the health status of the node.
+func statusCode(status health.Status) int {
+	switch status {
+	case health.StatusHealthy:
+		return http.StatusOK
+	case health.StatusSyncing:
+		return http.StatusPartialContent
+	default:
+		return http.StatusServiceUnavailable
+	}
+}
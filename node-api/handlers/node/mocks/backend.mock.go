@@ -2,7 +2,15 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	health "github.com/berachain/beacon-kit/node-core/services/health"
+
+	mock "github.com/stretchr/testify/mock"
+
+	network "github.com/berachain/beacon-kit/node-core/types/network"
+)
 
 // Backend is an autogenerated mock type for the Backend type
 type Backend struct {
@@ -17,6 +25,201 @@ func (_m *Backend) EXPECT() *Backend_Expecter {
 	return &Backend_Expecter{mock: &_m.Mock}
 }
 
+// ExecutionOptimistic provides a mock function with no fields
+func (_m *Backend) ExecutionOptimistic() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecutionOptimistic")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Backend_ExecutionOptimistic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecutionOptimistic'
+type Backend_ExecutionOptimistic_Call struct {
+	*mock.Call
+}
+
+// ExecutionOptimistic is a helper method to define mock.On call
+func (_e *Backend_Expecter) ExecutionOptimistic() *Backend_ExecutionOptimistic_Call {
+	return &Backend_ExecutionOptimistic_Call{Call: _e.mock.On("ExecutionOptimistic")}
+}
+
+func (_c *Backend_ExecutionOptimistic_Call) Run(run func()) *Backend_ExecutionOptimistic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Backend_ExecutionOptimistic_Call) Return(_a0 bool) *Backend_ExecutionOptimistic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Backend_ExecutionOptimistic_Call) RunAndReturn(run func() bool) *Backend_ExecutionOptimistic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHealth provides a mock function with given fields: ctx
+func (_m *Backend) GetHealth(ctx context.Context) health.Report {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHealth")
+	}
+
+	var r0 health.Report
+	if rf, ok := ret.Get(0).(func(context.Context) health.Report); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(health.Report)
+	}
+
+	return r0
+}
+
+// Backend_GetHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHealth'
+type Backend_GetHealth_Call struct {
+	*mock.Call
+}
+
+// GetHealth is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Backend_Expecter) GetHealth(ctx interface{}) *Backend_GetHealth_Call {
+	return &Backend_GetHealth_Call{Call: _e.mock.On("GetHealth", ctx)}
+}
+
+func (_c *Backend_GetHealth_Call) Run(run func(ctx context.Context)) *Backend_GetHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Backend_GetHealth_Call) Return(_a0 health.Report) *Backend_GetHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Backend_GetHealth_Call) RunAndReturn(run func(context.Context) health.Report) *Backend_GetHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNodeIdentity provides a mock function with no fields
+func (_m *Backend) GetNodeIdentity() (*network.NodeIdentity, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNodeIdentity")
+	}
+
+	var r0 *network.NodeIdentity
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*network.NodeIdentity, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *network.NodeIdentity); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*network.NodeIdentity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Backend_GetNodeIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNodeIdentity'
+type Backend_GetNodeIdentity_Call struct {
+	*mock.Call
+}
+
+// GetNodeIdentity is a helper method to define mock.On call
+func (_e *Backend_Expecter) GetNodeIdentity() *Backend_GetNodeIdentity_Call {
+	return &Backend_GetNodeIdentity_Call{Call: _e.mock.On("GetNodeIdentity")}
+}
+
+func (_c *Backend_GetNodeIdentity_Call) Run(run func()) *Backend_GetNodeIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Backend_GetNodeIdentity_Call) Return(_a0 *network.NodeIdentity, _a1 error) *Backend_GetNodeIdentity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Backend_GetNodeIdentity_Call) RunAndReturn(run func() (*network.NodeIdentity, error)) *Backend_GetNodeIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPeers provides a mock function with no fields
+func (_m *Backend) GetPeers() []*network.PeerInfo {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPeers")
+	}
+
+	var r0 []*network.PeerInfo
+	if rf, ok := ret.Get(0).(func() []*network.PeerInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*network.PeerInfo)
+		}
+	}
+
+	return r0
+}
+
+// Backend_GetPeers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPeers'
+type Backend_GetPeers_Call struct {
+	*mock.Call
+}
+
+// GetPeers is a helper method to define mock.On call
+func (_e *Backend_Expecter) GetPeers() *Backend_GetPeers_Call {
+	return &Backend_GetPeers_Call{Call: _e.mock.On("GetPeers")}
+}
+
+func (_c *Backend_GetPeers_Call) Run(run func()) *Backend_GetPeers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Backend_GetPeers_Call) Return(_a0 []*network.PeerInfo) *Backend_GetPeers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Backend_GetPeers_Call) RunAndReturn(run func() []*network.PeerInfo) *Backend_GetPeers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSyncData provides a mock function with no fields
 func (_m *Backend) GetSyncData() (int64, int64) {
 	ret := _m.Called()
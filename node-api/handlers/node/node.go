@@ -40,10 +40,10 @@ func (h *Handler) Syncing(handlers.Context) (any, error) {
 		// by the EL so for every purpose IsSyncing is equivalent to syncDistance > 0
 		IsSyncing: syncDistance > 0,
 
-		// BeaconKit always verifies blocks payload, whether
-		// it is syncing or it's in normal operation mode.
-		// So IsOptimistic is always false
-		IsOptimistic: false,
+		// BeaconKit verifies blocks payload, whether it is syncing or
+		// it's in normal operation mode, unless the node is not a validator
+		// and keeps finalizing blocks optimistically while the EL is unavailable.
+		IsOptimistic: h.backend.ExecutionOptimistic(),
 
 		// BeaconKit fails to verify and finalize blocks if
 		// the EL is not reachable, resulting in a panic state.
@@ -21,13 +21,23 @@
 package node_test
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/handlers/node"
 	"github.com/berachain/beacon-kit/node-api/handlers/node/mocks"
 	"github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	apitypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/middleware"
+	"github.com/berachain/beacon-kit/node-core/services/health"
+	"github.com/berachain/beacon-kit/node-core/types/network"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -45,6 +55,7 @@ func TestNodeSyncing(t *testing.T) {
 				latestHeight := int64(2025)
 				syncToHeight := int64(2026)
 				b.EXPECT().GetSyncData().Return(latestHeight, syncToHeight).Once()
+				b.EXPECT().ExecutionOptimistic().Return(false).Once()
 			},
 			check: func(t *testing.T, res any, err error) {
 				t.Helper()
@@ -72,6 +83,7 @@ func TestNodeSyncing(t *testing.T) {
 				latestHeight := int64(1492)
 				syncToHeight := int64(1492)
 				b.EXPECT().GetSyncData().Return(latestHeight, syncToHeight).Once()
+				b.EXPECT().ExecutionOptimistic().Return(false).Once()
 			},
 			check: func(t *testing.T, res any, err error) {
 				t.Helper()
@@ -93,6 +105,29 @@ func TestNodeSyncing(t *testing.T) {
 				require.False(t, data.ELOffline)
 			},
 		},
+		{
+			name: "optimistic mode",
+			setMockExpectations: func(b *mocks.Backend) {
+				latestHeight := int64(1492)
+				syncToHeight := int64(1492)
+				b.EXPECT().GetSyncData().Return(latestHeight, syncToHeight).Once()
+				b.EXPECT().ExecutionOptimistic().Return(true).Once()
+			},
+			check: func(t *testing.T, res any, err error) {
+				t.Helper()
+
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.IsType(t, types.DataResponse{}, res)
+				dr, _ := res.(types.DataResponse)
+				require.IsType(t, &types.SyncingData{}, dr.Data)
+				data, _ := dr.Data.(*types.SyncingData)
+
+				require.False(t, data.IsSyncing)
+				require.True(t, data.IsOptimistic)
+				require.False(t, data.ELOffline)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -144,3 +179,277 @@ func TestNodeVersion(t *testing.T) {
 	require.Contains(t, data.Version, os)
 	require.Contains(t, data.Version, arch)
 }
+
+func TestNodeHealth(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		query        string
+		status       health.Status
+		expectedCode int
+		expectedErr  error
+	}{
+		{
+			name:         "healthy",
+			status:       health.StatusHealthy,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "syncing",
+			status:       health.StatusSyncing,
+			expectedCode: http.StatusPartialContent,
+		},
+		{
+			name:         "syncing with custom syncing status",
+			query:        "?syncing_status=200",
+			status:       health.StatusSyncing,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "custom syncing status ignored when healthy",
+			query:        "?syncing_status=299",
+			status:       health.StatusHealthy,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "unhealthy",
+			query:        "?syncing_status=200",
+			status:       health.StatusUnhealthy,
+			expectedCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:        "invalid syncing status",
+			query:       "?syncing_status=600",
+			expectedErr: apitypes.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			backend := mocks.NewBackend(t)
+			h := node.NewHandler(backend, noop.NewLogger[log.Logger]())
+			e := echo.New()
+			e.Validator = &middleware.CustomValidator{
+				Validator: middleware.ConstructValidator(),
+			}
+			if tc.expectedErr == nil {
+				backend.EXPECT().GetHealth(mock.Anything).Return(health.Report{Status: tc.status}).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/eth/v1/node/health"+tc.query, nil)
+			res, err := h.Health(e.NewContext(req, httptest.NewRecorder()))
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, &handlers.StatusResponse{Code: tc.expectedCode}, res)
+		})
+	}
+}
+
+func TestNodeHealthReport(t *testing.T) {
+	t.Parallel()
+
+	backend := mocks.NewBackend(t)
+	h := node.NewHandler(backend, noop.NewLogger[log.Logger]())
+	report := health.Report{
+		Status: health.StatusUnhealthy,
+		Checks: []health.CheckResult{
+			{Name: "execution-client", Status: health.StatusUnhealthy, Error: "not connected"},
+			{Name: "consensus-sync", Status: health.StatusHealthy},
+		},
+	}
+	backend.EXPECT().GetHealth(mock.Anything).Return(report).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/bkit/v1/node/health", nil)
+	res, err := h.HealthReport(echo.New().NewContext(req, httptest.NewRecorder()))
+	require.NoError(t, err)
+	require.Equal(t, &handlers.StatusResponse{
+		Code: http.StatusServiceUnavailable,
+		Data: types.Wrap(&report),
+	}, res)
+}
+
+func testPeers() []*network.PeerInfo {
+	return []*network.PeerInfo{
+		{
+			ID:            "aaaa",
+			RemoteAddress: "10.0.0.1:26656",
+			Moniker:       "inbound-fresh",
+			ConnectedFor:  time.Minute,
+		},
+		{
+			ID:            "bbbb",
+			RemoteAddress: "10.0.0.2:26656",
+			Moniker:       "outbound-persistent",
+			Outbound:      true,
+			Persistent:    true,
+			ConnectedFor:  2 * time.Hour,
+			ReceiveRate:   20 * 1024,
+			Latency:       42 * time.Millisecond,
+		},
+	}
+}
+
+func TestNodeIdentity(t *testing.T) {
+	t.Parallel()
+
+	backend := mocks.NewBackend(t)
+	h := node.NewHandler(backend, noop.NewLogger[log.Logger]())
+	backend.EXPECT().GetNodeIdentity().Return(&network.NodeIdentity{
+		ID:            "abcd",
+		ListenAddress: "tcp://0.0.0.0:26656",
+	}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/eth/v1/node/identity", nil)
+	res, err := h.Identity(echo.New().NewContext(req, httptest.NewRecorder()))
+	require.NoError(t, err)
+	data, ok := res.(types.DataResponse).Data.(*types.IdentityData)
+	require.True(t, ok)
+	require.Equal(t, "abcd", data.PeerID)
+	require.Equal(t, []string{"abcd@0.0.0.0:26656"}, data.P2PAddresses)
+	require.Empty(t, data.DiscoveryAddresses)
+}
+
+func TestNodePeers(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		query       string
+		expectedIDs []string
+		expectedErr error
+	}{
+		{
+			name:        "all",
+			expectedIDs: []string{"aaaa", "bbbb"},
+		},
+		{
+			name:        "outbound",
+			query:       "?direction=outbound",
+			expectedIDs: []string{"bbbb"},
+		},
+		{
+			name:        "connected inbound",
+			query:       "?state=connected&direction=inbound",
+			expectedIDs: []string{"aaaa"},
+		},
+		{
+			name:        "disconnected",
+			query:       "?state=disconnected",
+			expectedIDs: []string{},
+		},
+		{
+			name:        "invalid direction",
+			query:       "?direction=sideways",
+			expectedErr: apitypes.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			backend := mocks.NewBackend(t)
+			h := node.NewHandler(backend, noop.NewLogger[log.Logger]())
+			e := echo.New()
+			e.Validator = &middleware.CustomValidator{
+				Validator: middleware.ConstructValidator(),
+			}
+			backend.EXPECT().GetPeers().Return(testPeers()).Maybe()
+
+			req := httptest.NewRequest(http.MethodGet, "/eth/v1/node/peers"+tc.query, nil)
+			res, err := h.Peers(e.NewContext(req, httptest.NewRecorder()))
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			peers, ok := res.(*types.PeersResponse)
+			require.True(t, ok)
+			require.Equal(t, len(tc.expectedIDs), peers.Meta.Count)
+			ids := make([]string, 0, len(peers.Data))
+			for _, peer := range peers.Data {
+				require.Equal(t, "connected", peer.State)
+				ids = append(ids, peer.PeerID)
+			}
+			require.Equal(t, tc.expectedIDs, ids)
+		})
+	}
+}
+
+func TestNodePeer(t *testing.T) {
+	t.Parallel()
+
+	backend := mocks.NewBackend(t)
+	h := node.NewHandler(backend, noop.NewLogger[log.Logger]())
+	e := echo.New()
+	e.Validator = &middleware.CustomValidator{
+		Validator: middleware.ConstructValidator(),
+	}
+	backend.EXPECT().GetPeers().Return(testPeers()).Twice()
+
+	newContext := func(peerID string) echo.Context {
+		req := httptest.NewRequest(http.MethodGet, "/eth/v1/node/peers/"+peerID, nil)
+		c := e.NewContext(req, httptest.NewRecorder())
+		c.SetParamNames("peer_id")
+		c.SetParamValues(peerID)
+		return c
+	}
+
+	res, err := h.Peer(newContext("bbbb"))
+	require.NoError(t, err)
+	require.Equal(t, types.Wrap(&types.PeerData{
+		PeerID:             "bbbb",
+		LastSeenP2PAddress: "bbbb@10.0.0.2:26656",
+		State:              "connected",
+		Direction:          "outbound",
+		LatencyMillis:      "42",
+	}), res)
+
+	_, err = h.Peer(newContext("cccc"))
+	require.ErrorIs(t, err, apitypes.ErrNotFound)
+}
+
+func TestNodePeerCount(t *testing.T) {
+	t.Parallel()
+
+	backend := mocks.NewBackend(t)
+	h := node.NewHandler(backend, noop.NewLogger[log.Logger]())
+	backend.EXPECT().GetPeers().Return(testPeers()).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/eth/v1/node/peer_count", nil)
+	res, err := h.PeerCount(echo.New().NewContext(req, httptest.NewRecorder()))
+	require.NoError(t, err)
+	require.Equal(t, types.Wrap(&types.PeerCountData{
+		Disconnected:  "0",
+		Connecting:    "0",
+		Connected:     "2",
+		Disconnecting: "0",
+	}), res)
+}
+
+func TestNodePeerScores(t *testing.T) {
+	t.Parallel()
+
+	backend := mocks.NewBackend(t)
+	h := node.NewHandler(backend, noop.NewLogger[log.Logger]())
+	backend.EXPECT().GetPeers().Return(testPeers()).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/bkit/v1/node/peers/score", nil)
+	res, err := h.PeerScores(echo.New().NewContext(req, httptest.NewRecorder()))
+	require.NoError(t, err)
+	scores, ok := res.(types.DataResponse).Data.([]*types.PeerScoreData)
+	require.True(t, ok)
+	require.Len(t, scores, 2)
+	require.Equal(t, "bbbb", scores[0].PeerID)
+	require.Equal(t, "100", scores[0].Score)
+	require.Equal(t, "42", scores[0].LatencyMillis)
+	require.Equal(t, "aaaa", scores[1].PeerID)
+	require.Equal(t, "0", scores[1].Score)
+	require.Empty(t, scores[1].LatencyMillis)
+}
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	apitypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/node-core/types/network"
+)
+
+const (
+	// stateConnected is the only state of the peers reported, CometBFT only
+	// tracking the peers it is connected to.
+	stateConnected = "connected"
+
+	directionInbound  = "inbound"
+	directionOutbound = "outbound"
+
+	// uptimeScore is the score of a peer connected for fullUptime.
+	uptimeScore = 40
+	fullUptime  = time.Hour
+	// trafficScore is the score of a peer sending at least fullTrafficRate
+	// bytes per second.
+	trafficScore    = 40
+	fullTrafficRate = 10 * 1024
+	// persistentScore is the score of a configured persistent peer.
+	persistentScore = 20
+)
+
+// Identity returns the identity of the node. BeaconKit peers over CometBFT
+// p2p, so the node has no ENR nor discovery address, and its p2p address is
+// given in the CometBFT <node id>@<host>:<port> format.
+func (h *Handler) Identity(handlers.Context) (any, error) {
+	identity, err := h.backend.GetNodeIdentity()
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap(&types.IdentityData{
+		PeerID:             identity.ID,
+		P2PAddresses:       []string{p2pAddress(identity.ID, identity.ListenAddress)},
+		DiscoveryAddresses: []string{},
+		Metadata: types.IdentityMetadata{
+			SeqNumber: "0",
+			Attnets:   "0x0000000000000000",
+			Syncnets:  "0x00",
+		},
+	}), nil
+}
+
+// Peers returns the peers of the node matching the requested states and
+// directions. On top of the standard fields, peers carry the round trip time
+// last measured to them, if any.
+func (h *Handler) Peers(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[types.GetPeersRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*types.PeerData, 0)
+	if len(req.States) == 0 || slices.Contains(req.States, stateConnected) {
+		for _, peer := range h.backend.GetPeers() {
+			if len(req.Directions) == 0 || slices.Contains(req.Directions, direction(peer)) {
+				data = append(data, peerData(peer))
+			}
+		}
+	}
+	return &types.PeersResponse{
+		Data: data,
+		Meta: types.PeersMeta{Count: len(data)},
+	}, nil
+}
+
+// Peer returns the peer of the node with the requested ID.
+func (h *Handler) Peer(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[types.GetPeerRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	for _, peer := range h.backend.GetPeers() {
+		if peer.ID == req.PeerID {
+			return types.Wrap(peerData(peer)), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: peer %s", apitypes.ErrNotFound, req.PeerID)
+}
+
+// PeerCount returns the number of peers of the node by state.
+func (h *Handler) PeerCount(handlers.Context) (any, error) {
+	return types.Wrap(&types.PeerCountData{
+		Disconnected:  "0",
+		Connecting:    "0",
+		Connected:     strconv.Itoa(len(h.backend.GetPeers())),
+		Disconnecting: "0",
+	}), nil
+}
+
+// PeerScores returns the peers of the node scored, out of 100, by the
+// duration of their connection, the rate at which they send data and whether
+// they are persistent peers, best first.
+func (h *Handler) PeerScores(handlers.Context) (any, error) {
+	peers := h.backend.GetPeers()
+	data := make([]*types.PeerScoreData, len(peers))
+	scores := make(map[string]int, len(peers))
+	for i, peer := range peers {
+		score := peerScore(peer)
+		scores[peer.ID] = score
+		data[i] = &types.PeerScoreData{
+			PeerID:           peer.ID,
+			Moniker:          peer.Moniker,
+			Direction:        direction(peer),
+			Persistent:       peer.Persistent,
+			Score:            strconv.Itoa(score),
+			ConnectedSeconds: strconv.FormatInt(int64(peer.ConnectedFor.Seconds()), 10),
+			SendRate:         strconv.FormatInt(peer.SendRate, 10),
+			ReceiveRate:      strconv.FormatInt(peer.ReceiveRate, 10),
+			LatencyMillis:    latencyMillis(peer),
+		}
+	}
+	sort.SliceStable(data, func(i, j int) bool {
+		return scores[data[i].PeerID] > scores[data[j].PeerID]
+	})
+	return types.Wrap(data), nil
+}
+
+// peerScore returns the score of the peer, out of 100.
+func peerScore(peer *network.PeerInfo) int {
+	uptime := min(float64(peer.ConnectedFor)/float64(fullUptime), 1)
+	traffic := min(float64(peer.ReceiveRate)/fullTrafficRate, 1)
+	score := int(uptime*uptimeScore + traffic*trafficScore)
+	if peer.Persistent {
+		score += persistentScore
+	}
+	return score
+}
+
+// peerData maps a peer to its beacon API description.
+func peerData(peer *network.PeerInfo) *types.PeerData {
+	return &types.PeerData{
+		PeerID:             peer.ID,
+		LastSeenP2PAddress: p2pAddress(peer.ID, peer.RemoteAddress),
+		State:              stateConnected,
+		Direction:          direction(peer),
+		LatencyMillis:      latencyMillis(peer),
+	}
+}
+
+// direction returns the direction of the connection to the peer.
+func direction(peer *network.PeerInfo) string {
+	if peer.Outbound {
+		return directionOutbound
+	}
+	return directionInbound
+}
+
+// latencyMillis returns the latency to the peer in milliseconds, or an empty
+// string if it is unknown.
+func latencyMillis(peer *network.PeerInfo) string {
+	if peer.Latency <= 0 {
+		return ""
+	}
+	return strconv.FormatInt(peer.Latency.Milliseconds(), 10)
+}
+
+// p2pAddress returns the CometBFT p2p address of the node listening on
+// address, which may carry a scheme such as tcp://.
+func p2pAddress(id, address string) string {
+	if u, err := url.Parse(address); err == nil && u.Host != "" {
+		address = u.Host
+	}
+	return id + "@" + address
+}
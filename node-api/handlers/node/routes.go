@@ -31,22 +31,27 @@ func registerRoutes(h *Handler) {
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/identity",
-			Handler: h.NotImplemented,
+			Handler: h.Identity,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/peers",
-			Handler: h.NotImplemented,
+			Handler: h.Peers,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/peers/:peer_id",
-			Handler: h.NotImplemented,
+			Handler: h.Peer,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/peer_count",
-			Handler: h.NotImplemented,
+			Handler: h.PeerCount,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/bkit/v1/node/peers/score",
+			Handler: h.PeerScores,
 		},
 		{
 			Method:  http.MethodGet,
@@ -61,7 +66,12 @@ func registerRoutes(h *Handler) {
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/health",
-			Handler: h.NotImplemented,
+			Handler: h.Health,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/bkit/v1/node/health",
+			Handler: h.HealthReport,
 		},
 	})
 }
@@ -35,6 +35,59 @@ func Wrap(data any) DataResponse {
 	}
 }
 
+// PeersResponse is the response of the peers endpoint, which carries the
+// number of peers returned along with them.
+type PeersResponse struct {
+	Data []*PeerData `json:"data"`
+	Meta PeersMeta   `json:"meta"`
+}
+
+type PeersMeta struct {
+	Count int `json:"count"`
+}
+
+type IdentityData struct {
+	PeerID             string           `json:"peer_id"`
+	ENR                string           `json:"enr"`
+	P2PAddresses       []string         `json:"p2p_addresses"`
+	DiscoveryAddresses []string         `json:"discovery_addresses"`
+	Metadata           IdentityMetadata `json:"metadata"`
+}
+
+type IdentityMetadata struct {
+	SeqNumber string `json:"seq_number"`
+	Attnets   string `json:"attnets"`
+	Syncnets  string `json:"syncnets"`
+}
+
+type PeerData struct {
+	PeerID             string  `json:"peer_id"`
+	ENR                *string `json:"enr"`
+	LastSeenP2PAddress string  `json:"last_seen_p2p_address"`
+	State              string  `json:"state"`
+	Direction          string  `json:"direction"`
+	LatencyMillis      string  `json:"latency_ms,omitempty"`
+}
+
+type PeerCountData struct {
+	Disconnected  string `json:"disconnected"`
+	Connecting    string `json:"connecting"`
+	Connected     string `json:"connected"`
+	Disconnecting string `json:"disconnecting"`
+}
+
+type PeerScoreData struct {
+	PeerID           string `json:"peer_id"`
+	Moniker          string `json:"moniker"`
+	Direction        string `json:"direction"`
+	Persistent       bool   `json:"persistent"`
+	Score            string `json:"score"`
+	ConnectedSeconds string `json:"connected_seconds"`
+	SendRate         string `json:"send_rate"`
+	ReceiveRate      string `json:"receive_rate"`
+	LatencyMillis    string `json:"latency_ms,omitempty"`
+}
+
 type VersionData struct {
 	Version string `json:"version"`
 }
@@ -32,3 +32,11 @@ const (
 	Head    int64 = -1
 	Genesis int64 = 0
 )
+
+// HTTP headers of the Beacon Node API.
+const (
+	HeaderConsensusVersion      = "Eth-Consensus-Version"
+	HeaderExecutionPayloadBlind = "Eth-Execution-Payload-Blinded"
+	HeaderExecutionPayloadValue = "Eth-Execution-Payload-Value"
+	HeaderConsensusBlockValue   = "Eth-Consensus-Block-Value"
+)
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	validatortypes "github.com/berachain/beacon-kit/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// ProduceBlockV3 returns the unsigned block for the requested slot, along with
+// the blobs it commits to, so that an external validator client can sign it
+// and publish it back via the beacon blocks endpoint.
+//
+// Blocks are only served SSZ-encoded, as BlockContents, and are never blinded:
+// relay payloads are only used by the node when it signs blocks itself.
+//
+// Proposers are picked by CometBFT rather than scheduled by the beacon state,
+// hence blocks are produced for the node's own key by default. On top of the
+// standard parameters, the optional proposer_pubkey parameter overrides it.
+func (h *Handler) ProduceBlockV3(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[validatortypes.ProduceBlockRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	slot, err := math.U64FromString(req.Slot)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid slot: %s", types.ErrInvalidRequest, err.Error())
+	}
+	var proposer *crypto.BLSPubkey
+	if req.ProposerPubkey != "" {
+		proposer = new(crypto.BLSPubkey)
+		if err = proposer.UnmarshalText([]byte(req.ProposerPubkey)); err != nil {
+			return nil, fmt.Errorf("%w: invalid proposer pubkey: %s", types.ErrInvalidRequest, err.Error())
+		}
+	}
+	var randaoReveal crypto.BLSSignature
+	if err = randaoReveal.UnmarshalText([]byte(req.RandaoReveal)); err != nil {
+		return nil, fmt.Errorf("%w: invalid randao reveal: %s", types.ErrInvalidRequest, err.Error())
+	}
+	var graffiti *common.Bytes32
+	if req.Graffiti != "" {
+		graffiti = new(common.Bytes32)
+		if err = graffiti.UnmarshalText([]byte(req.Graffiti)); err != nil {
+			return nil, fmt.Errorf("%w: invalid graffiti: %s", types.ErrInvalidRequest, err.Error())
+		}
+	}
+	// skip_randao_verification is a flag, its presence is enough to set it.
+	_, skipRandaoVerification := c.QueryParams()["skip_randao_verification"]
+
+	contents, payloadValue, err := h.backend.ProduceBlock(slot, proposer, randaoReveal, graffiti, skipRandaoVerification)
+	if err != nil {
+		return nil, err
+	}
+	data, err := contents.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	if payloadValue == nil {
+		payloadValue = math.NewU256(0)
+	}
+	return &handlers.SSZResponse{
+		Headers: map[string]string{
+			utils.HeaderConsensusVersion:      version.Name(contents.Block.GetForkVersion()),
+			utils.HeaderExecutionPayloadBlind: strconv.FormatBool(false),
+			utils.HeaderExecutionPayloadValue: payloadValue.Dec(),
+			// Consensus rewards are not part of beacon-kit.
+			utils.HeaderConsensusBlockValue: "0",
+		},
+		Data: data,
+	}, nil
+}
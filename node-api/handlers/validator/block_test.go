@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/node-api/handlers/validator"
+	"github.com/berachain/beacon-kit/node-api/handlers/validator/mocks"
+	"github.com/berachain/beacon-kit/node-api/middleware"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	sszutil "github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	testutils "github.com/berachain/beacon-kit/testing/utils"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduceBlockV3(t *testing.T) {
+	t.Parallel()
+
+	var (
+		slot         = math.Slot(10)
+		proposer     = crypto.BLSPubkey{0x03}
+		randaoReveal = crypto.BLSSignature{0x01}
+		graffiti     = common.Bytes32{0x02}
+		proposerArg  = "?proposer_pubkey=" + proposer.String()
+		revealHex    = randaoReveal.String()
+		graffitiHex  = graffiti.String()
+	)
+
+	testCases := []struct {
+		name                string
+		query               string
+		setMockExpectations func(*mocks.Backend, *ctypes.BlockContents)
+		check               func(t *testing.T, contents *ctypes.BlockContents, res any, err error)
+	}{
+		{
+			name:  "block with graffiti",
+			query: proposerArg + "&randao_reveal=" + revealHex + "&graffiti=" + graffitiHex,
+			setMockExpectations: func(b *mocks.Backend, contents *ctypes.BlockContents) {
+				b.EXPECT().ProduceBlock(slot, &proposer, randaoReveal, &graffiti, false).Return(contents, math.NewU256(1e18), nil)
+			},
+			check: func(t *testing.T, contents *ctypes.BlockContents, res any, err error) {
+				t.Helper()
+
+				require.NoError(t, err)
+				require.IsType(t, &handlers.SSZResponse{}, res)
+				resp, _ := res.(*handlers.SSZResponse)
+				require.Equal(t, version.Name(version.Deneb()), resp.Headers[utils.HeaderConsensusVersion])
+				require.Equal(t, "false", resp.Headers[utils.HeaderExecutionPayloadBlind])
+				require.Equal(t, "1000000000000000000", resp.Headers[utils.HeaderExecutionPayloadValue])
+
+				decoded, err := ctypes.NewEmptyBlockContentsWithVersion(version.Deneb())
+				require.NoError(t, err)
+				require.NoError(t, sszutil.Unmarshal(resp.Data, decoded))
+				require.Equal(t, contents.Block.HashTreeRoot(), decoded.Block.HashTreeRoot())
+				require.Equal(t, contents.Blobs, decoded.Blobs)
+			},
+		},
+		{
+			name:  "randao verification skipped and default graffiti",
+			query: proposerArg + "&randao_reveal=" + revealHex + "&skip_randao_verification",
+			setMockExpectations: func(b *mocks.Backend, contents *ctypes.BlockContents) {
+				b.EXPECT().ProduceBlock(slot, &proposer, randaoReveal, (*common.Bytes32)(nil), true).Return(contents, math.NewU256(0), nil)
+			},
+			check: func(t *testing.T, _ *ctypes.BlockContents, res any, err error) {
+				t.Helper()
+
+				require.NoError(t, err)
+				require.IsType(t, &handlers.SSZResponse{}, res)
+			},
+		},
+		{
+			name:                "invalid randao reveal",
+			query:               proposerArg + "&randao_reveal=0x01",
+			setMockExpectations: func(*mocks.Backend, *ctypes.BlockContents) {},
+			check: func(t *testing.T, _ *ctypes.BlockContents, res any, err error) {
+				t.Helper()
+
+				require.ErrorIs(t, err, handlertypes.ErrInvalidRequest)
+				require.Nil(t, res)
+			},
+		},
+		{
+			name:                "missing randao reveal",
+			query:               proposerArg,
+			setMockExpectations: func(*mocks.Backend, *ctypes.BlockContents) {},
+			check: func(t *testing.T, _ *ctypes.BlockContents, res any, err error) {
+				t.Helper()
+
+				require.ErrorIs(t, err, handlertypes.ErrInvalidRequest)
+				require.Nil(t, res)
+			},
+		},
+		{
+			name:  "proposer defaults to the node key",
+			query: "?randao_reveal=" + revealHex,
+			setMockExpectations: func(b *mocks.Backend, contents *ctypes.BlockContents) {
+				b.EXPECT().ProduceBlock(slot, (*crypto.BLSPubkey)(nil), randaoReveal, (*common.Bytes32)(nil), false).Return(contents, math.NewU256(0), nil)
+			},
+			check: func(t *testing.T, _ *ctypes.BlockContents, res any, err error) {
+				t.Helper()
+
+				require.NoError(t, err)
+				require.IsType(t, &handlers.SSZResponse{}, res)
+			},
+		},
+		{
+			name:                "invalid proposer pubkey",
+			query:               "?proposer_pubkey=0x03&randao_reveal=" + revealHex,
+			setMockExpectations: func(*mocks.Backend, *ctypes.BlockContents) {},
+			check: func(t *testing.T, _ *ctypes.BlockContents, res any, err error) {
+				t.Helper()
+
+				require.ErrorIs(t, err, handlertypes.ErrInvalidRequest)
+				require.Nil(t, res)
+			},
+		},
+		{
+			name:  "backend error",
+			query: proposerArg + "&randao_reveal=" + revealHex,
+			setMockExpectations: func(b *mocks.Backend, _ *ctypes.BlockContents) {
+				b.EXPECT().ProduceBlock(slot, &proposer, mock.Anything, mock.Anything, false).Return(nil, nil, errTest)
+			},
+			check: func(t *testing.T, _ *ctypes.BlockContents, res any, err error) {
+				t.Helper()
+
+				require.ErrorIs(t, err, errTest)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// setup test
+			backend := mocks.NewBackend(t)
+			h := validator.NewHandler(backend, noop.NewLogger[log.Logger]())
+			e := echo.New()
+			e.Validator = &middleware.CustomValidator{
+				Validator: middleware.ConstructValidator(),
+			}
+			contents := &ctypes.BlockContents{
+				Block:     testutils.GenerateValidBeaconBlock(t, version.Deneb()),
+				KZGProofs: []eip4844.KZGProof{{0x03}},
+				Blobs:     []eip4844.Blob{{0x04}},
+			}
+
+			// set expectations
+			tc.setMockExpectations(backend, contents)
+
+			// create input
+			req := httptest.NewRequest(http.MethodGet, "/"+tc.query, http.NoBody)
+			c := e.NewContext(req, httptest.NewRecorder())
+			c.SetParamNames("slot")
+			c.SetParamValues(slot.Base10())
+
+			// test
+			res, err := h.ProduceBlockV3(c)
+
+			// check
+			tc.check(t, contents, res, err)
+		})
+	}
+}
+
+var errTest = errors.New("test error")
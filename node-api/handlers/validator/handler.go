@@ -25,13 +25,17 @@ import (
 	"github.com/berachain/beacon-kit/node-api/handlers"
 )
 
+// Handler is the handler for the validator API.
 type Handler struct {
 	*handlers.BaseHandler
+	backend Backend
 }
 
-func NewHandler(logger log.Logger) *Handler {
+// NewHandler creates a new handler for the validator API.
+func NewHandler(backend Backend, logger log.Logger) *Handler {
 	h := &Handler{
 		BaseHandler: handlers.NewBaseHandler(logger),
+		backend:     backend,
 	}
 	registerRoutes(h)
 	return h
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	validatortypes "github.com/berachain/beacon-kit/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// PrepareBeaconProposer registers the fee recipients of the payloads proposed
+// by the given validators. Registrations are persisted until they expire, so
+// that they survive restarts, and never override the fee recipients configured
+// by the operator.
+// Validators unknown to the node are ignored.
+func (h *Handler) PrepareBeaconProposer(c handlers.Context) (any, error) {
+	var preparations []validatortypes.ProposerPreparation
+	if err := c.Bind(&preparations); err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), types.ErrInvalidRequest)
+	}
+
+	recipients := make(map[math.ValidatorIndex]common.ExecutionAddress, len(preparations))
+	for _, p := range preparations {
+		if err := c.Validate(&p); err != nil {
+			return nil, types.ErrInvalidRequest
+		}
+		index, err := math.U64FromString(p.ValidatorIndex)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid validator index: %s", types.ErrInvalidRequest, err.Error())
+		}
+		var recipient common.ExecutionAddress
+		if err = recipient.UnmarshalText([]byte(p.FeeRecipient)); err != nil {
+			return nil, fmt.Errorf("%w: invalid fee recipient: %s", types.ErrInvalidRequest, err.Error())
+		}
+		recipients[index] = recipient
+	}
+
+	skipped, err := h.backend.SetFeeRecipients(recipients)
+	if err != nil {
+		return nil, err
+	}
+	if len(skipped) > 0 {
+		h.Logger().Warn("Ignoring fee recipients of unknown validators", "indices", skipped)
+	}
+	return nil, nil
+}
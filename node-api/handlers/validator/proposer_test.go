@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/validator"
+	"github.com/berachain/beacon-kit/node-api/handlers/validator/mocks"
+	"github.com/berachain/beacon-kit/node-api/middleware"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrepareBeaconProposer(t *testing.T) {
+	t.Parallel()
+
+	recipient := common.ExecutionAddress{0x01}
+
+	testCases := []struct {
+		name                string
+		body                string
+		setMockExpectations func(*mocks.Backend)
+		check               func(t *testing.T, err error)
+	}{
+		{
+			name: "recipients registered",
+			body: `[{"validator_index":"1","fee_recipient":"` + recipient.String() + `"},` +
+				`{"validator_index":"7","fee_recipient":"` + recipient.String() + `"}]`,
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().SetFeeRecipients(map[math.ValidatorIndex]common.ExecutionAddress{
+					1: recipient,
+					7: recipient,
+				}).Return([]math.ValidatorIndex{7}, nil)
+			},
+			check: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:                "invalid validator index",
+			body:                `[{"validator_index":"one","fee_recipient":"` + recipient.String() + `"}]`,
+			setMockExpectations: func(*mocks.Backend) {},
+			check: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, handlertypes.ErrInvalidRequest)
+			},
+		},
+		{
+			name:                "invalid fee recipient",
+			body:                `[{"validator_index":"1","fee_recipient":"0x0102"}]`,
+			setMockExpectations: func(*mocks.Backend) {},
+			check: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, handlertypes.ErrInvalidRequest)
+			},
+		},
+		{
+			name: "backend error",
+			body: `[{"validator_index":"1","fee_recipient":"` + recipient.String() + `"}]`,
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().SetFeeRecipients(map[math.ValidatorIndex]common.ExecutionAddress{
+					1: recipient,
+				}).Return(nil, errTest)
+			},
+			check: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// setup test
+			backend := mocks.NewBackend(t)
+			h := validator.NewHandler(backend, noop.NewLogger[log.Logger]())
+			e := echo.New()
+			e.Validator = &middleware.CustomValidator{
+				Validator: middleware.ConstructValidator(),
+			}
+
+			// set expectations
+			tc.setMockExpectations(backend)
+
+			// create input
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			// test
+			res, err := h.PrepareBeaconProposer(c)
+
+			// check
+			require.Nil(t, res)
+			tc.check(t, err)
+		})
+	}
+}
@@ -46,7 +46,7 @@ func registerRoutes(h *Handler) {
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v3/validator/blocks/:slot",
-			Handler: h.NotImplemented,
+			Handler: h.ProduceBlockV3,
 		},
 		{
 			Method:  http.MethodPost,
@@ -101,7 +101,7 @@ func registerRoutes(h *Handler) {
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/validator/prepare_beacon_proposer",
-			Handler: h.NotImplemented,
+			Handler: h.PrepareBeaconProposer,
 		},
 		{
 			Method:  http.MethodPost,
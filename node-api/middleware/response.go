@@ -40,6 +40,18 @@ type ErrorResponse struct {
 func responseMiddleware(handler *handlers.Route) echo.HandlerFunc {
 	return func(c handlers.Context) error {
 		data, err := handler.Handler(c)
+		if raw, ok := data.(*handlers.SSZResponse); ok && err == nil {
+			for key, value := range raw.Headers {
+				c.Response().Header().Set(key, value)
+			}
+			return c.Blob(http.StatusOK, echo.MIMEOctetStream, raw.Data)
+		}
+		if status, ok := data.(*handlers.StatusResponse); ok && err == nil {
+			if status.Data == nil {
+				return c.NoContent(status.Code)
+			}
+			return c.JSON(status.Code, status.Data)
+		}
 		code, response := responseFromError(data, err)
 		return c.JSON(code, response)
 	}
@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/log/noop"
 	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-api/handlers"
 	beaconapi "github.com/berachain/beacon-kit/node-api/handlers/beacon"
 	builderapi "github.com/berachain/beacon-kit/node-api/handlers/builder"
 	cometbftapi "github.com/berachain/beacon-kit/node-api/handlers/cometbft"
@@ -36,6 +37,7 @@ import (
 	eventsapi "github.com/berachain/beacon-kit/node-api/handlers/events"
 	nodeapi "github.com/berachain/beacon-kit/node-api/handlers/node"
 	proofapi "github.com/berachain/beacon-kit/node-api/handlers/proof"
+	validatorapi "github.com/berachain/beacon-kit/node-api/handlers/validator"
 	"github.com/berachain/beacon-kit/node-api/middleware"
 	"github.com/berachain/beacon-kit/node-core/components/storage"
 	"github.com/berachain/beacon-kit/node-core/types"
@@ -71,6 +73,21 @@ func New(
 	// consensusService allows apis to access node state
 	// and carry out all sorts of queries, including hystorical ones
 	consensusService types.ConsensusService,
+
+	// blockProducer builds blocks for external validator clients
+	blockProducer backend.BlockProducer,
+
+	// feeRecipients stores the fee recipients registered by validator clients
+	feeRecipients backend.FeeRecipientRegistry,
+
+	// healthMonitor runs the health checks of the node
+	healthMonitor backend.HealthMonitor,
+
+	// executionStatus reports whether the execution client is behind the node
+	executionStatus backend.ExecutionStatus,
+
+	// extraRoutes are the routes registered by the plugins of the node
+	extraRoutes []*handlers.RouteSet,
 ) *Server {
 	apiLogger := logger
 	if !config.Logging {
@@ -80,7 +97,9 @@ func New(
 	mware := middleware.NewDefaultMiddleware(apiLogger)
 
 	// instantiate handlers and register their routes in the middleware
-	b := backend.New(storageBackend, sp, cs, cmtCfg, consensusService)
+	b := backend.New(
+		storageBackend, sp, cs, cmtCfg, consensusService, blockProducer, feeRecipients, healthMonitor, executionStatus,
+	)
 	beaconHandler := beaconapi.NewHandler(b, cs, apiLogger)
 	mware.RegisterRoutes(beaconHandler.RouteSet())
 	mware.RegisterRoutes(builderapi.NewHandler(apiLogger).RouteSet())
@@ -90,6 +109,10 @@ func New(
 	mware.RegisterRoutes(eventsapi.NewHandler(apiLogger).RouteSet())
 	mware.RegisterRoutes(nodeapi.NewHandler(b, apiLogger).RouteSet())
 	mware.RegisterRoutes(proofapi.NewHandler(b, apiLogger).RouteSet())
+	mware.RegisterRoutes(validatorapi.NewHandler(b, apiLogger).RouteSet())
+	for _, routes := range extraRoutes {
+		mware.RegisterRoutes(routes)
+	}
 
 	return &Server{
 		config:        config,
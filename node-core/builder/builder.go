@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/config/spec"
 	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/plugin"
 	"github.com/berachain/beacon-kit/node-core/types"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
@@ -41,6 +42,8 @@ import (
 type NodeBuilder struct {
 	// components is a list of components to provide.
 	components []any
+	// plugins are the plugins extending the node.
+	plugins plugin.Plugins
 }
 
 // New returns a new NodeBuilder.
@@ -83,6 +86,7 @@ func (nb *NodeBuilder) Build(
 			depinject.Supply(
 				appOpts,
 				logger,
+				nb.plugins,
 				db,
 				cmtCfg,
 				chainSpec,
@@ -20,6 +20,8 @@
 
 package builder
 
+import "github.com/berachain/beacon-kit/node-core/plugin"
+
 // Opt is a type that defines a function that modifies NodeBuilder.
 type Opt func(*NodeBuilder)
 
@@ -29,3 +31,10 @@ func WithComponents(components []any) Opt {
 		nb.components = components
 	}
 }
+
+// WithPlugins is a function that adds plugins to the NodeBuilder.
+func WithPlugins(plugins ...plugin.Plugin) Opt {
+	return func(nb *NodeBuilder) {
+		nb.plugins = append(nb.plugins, plugins...)
+	}
+}
@@ -22,13 +22,18 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/beacon/validator"
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/execution/engine"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-api/server"
 	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/node-core/plugin"
+	"github.com/berachain/beacon-kit/node-core/services/health"
 	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/payload/feerecipient"
 	"github.com/berachain/beacon-kit/state-transition/core"
 	cmtcfg "github.com/cometbft/cometbft/config"
 )
@@ -43,6 +48,11 @@ type NodeAPIServerInput struct {
 	StateProcessor   *core.StateProcessor
 	CometConfig      *cmtcfg.Config
 	ConsensusService types.ConsensusService
+	ValidatorService *validator.Service
+	FeeRecipients    *feerecipient.Registry
+	HealthMonitor    *health.Monitor
+	ExecutionEngine  *engine.Engine
+	PluginExtensions *plugin.Extensions
 }
 
 func ProvideNodeAPIServer(in NodeAPIServerInput) *server.Server {
@@ -59,5 +69,10 @@ func ProvideNodeAPIServer(in NodeAPIServerInput) *server.Server {
 		in.ChainSpec,
 		in.CometConfig,
 		in.ConsensusService,
+		in.ValidatorService,
+		in.FeeRecipients,
+		in.HealthMonitor,
+		in.ExecutionEngine,
+		in.PluginExtensions.Routes(),
 	)
 }
@@ -23,17 +23,17 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/chain"
-	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/payload/attributes"
+	"github.com/berachain/beacon-kit/payload/feerecipient"
 )
 
 type AttributesFactoryInput struct {
 	depinject.In
 
-	ChainSpec chain.Spec
-	Config    *config.Config
-	Logger    *phuslu.Logger
+	ChainSpec     chain.Spec
+	Logger        *phuslu.Logger
+	FeeRecipients *feerecipient.Registry
 }
 
 // ProvideAttributesFactory provides an AttributesFactory for the client.
@@ -41,6 +41,6 @@ func ProvideAttributesFactory(in AttributesFactoryInput) (*attributes.Factory, e
 	return attributes.NewAttributesFactory(
 		in.ChainSpec,
 		in.Logger,
-		in.Config.PayloadBuilder.SuggestedFeeRecipient,
+		in.FeeRecipients,
 	), nil
 }
@@ -43,15 +43,10 @@ type AvailabilityStoreInput struct {
 
 // ProvideAvailabilityStore provides the availability store.
 func ProvideAvailabilityStore(in AvailabilityStoreInput) (*dastore.Store, error) {
-	var (
-		rootDir  = cast.ToString(in.AppOpts.Get(flags.FlagHome))
-		blobsDir = filepath.Join(rootDir, "data", "blobs")
-	)
-
 	return dastore.New(
 		filedb.NewRangeDB(
 			filedb.NewDB(
-				filedb.WithRootDirectory(blobsDir),
+				filedb.WithRootDirectory(availabilityStoreDir(in.AppOpts)),
 				filedb.WithFileExtension("ssz"),
 				filedb.WithDirectoryPermissions(os.ModePerm),
 				filedb.WithLogger(in.Logger),
@@ -60,3 +55,9 @@ func ProvideAvailabilityStore(in AvailabilityStoreInput) (*dastore.Store, error)
 		in.Logger.With("service", "da-store"),
 	), nil
 }
+
+// availabilityStoreDir returns the directory the blob sidecars are stored in.
+func availabilityStoreDir(appOpts config.AppOptions) string {
+	rootDir := cast.ToString(appOpts.Get(flags.FlagHome))
+	return filepath.Join(rootDir, "data", "blobs")
+}
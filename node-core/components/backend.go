@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/storage/archive"
 	"github.com/berachain/beacon-kit/storage/beacondb"
 	"github.com/berachain/beacon-kit/storage/block"
 	"github.com/berachain/beacon-kit/storage/deposit"
@@ -42,6 +43,7 @@ type StorageBackendInput struct {
 	DepositStore      deposit.StoreManager
 	BeaconStore       *beacondb.KVStore
 	Logger            *phuslu.Logger
+	StateArchive      *archive.Store
 	TelemetrySink     *metrics.TelemetrySink
 }
 
@@ -56,6 +58,7 @@ func ProvideStorageBackend(
 		in.BeaconStore,
 		in.DepositStore,
 		in.BlockStore,
+		in.StateArchive,
 		in.Logger.With("service", "storage-backend"),
 		in.TelemetrySink,
 	)
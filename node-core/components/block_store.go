@@ -21,25 +21,43 @@
 package components
 
 import (
+	"path/filepath"
+
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/config"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/storage/block"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
 )
 
 // BlockStoreInput is the input for the dep inject framework.
 type BlockStoreInput struct {
 	depinject.In
 
-	Config *config.Config
-	Logger *phuslu.Logger
+	AppOpts config.AppOptions
+	Config  *config.Config
+	Logger  *phuslu.Logger
 }
 
 // ProvideBlockStore is a function that provides the module to the
 // application.
 func ProvideBlockStore(in BlockStoreInput) (*block.KVStore[*ctypes.BeaconBlock], error) {
-	return block.NewStore[*ctypes.BeaconBlock](
+	var (
+		rootDir = cast.ToString(in.AppOpts.Get(flags.FlagHome))
+		dataDir = filepath.Join(rootDir, "data")
+		name    = "block_index"
+	)
+
+	db, err := dbm.NewDB(name, dbm.PebbleDBBackend, dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return block.NewStoreFromDB[*ctypes.BeaconBlock](
+		db,
 		in.Logger.With("service", "block-store"),
 		in.Config.BlockStoreService.AvailabilityWindow,
 	), nil
@@ -29,6 +29,8 @@ import (
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/node-core/plugin"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 )
 
 // ChainServiceInput is the input for the chain service provider.
@@ -44,6 +46,8 @@ type ChainServiceInput struct {
 	BlobProcessor         BlobProcessor
 	TelemetrySink         *metrics.TelemetrySink
 	BeaconDepositContract deposit.Contract
+	Signer                crypto.BLSSigner
+	PluginExtensions      *plugin.Extensions
 }
 
 // ProvideChainService is a depinject provider for the blockchain service.
@@ -57,6 +61,8 @@ func ProvideChainService(in ChainServiceInput) *blockchain.Service {
 		in.ExecutionEngine,
 		in.LocalBuilder,
 		in.StateProcessor,
+		in.Signer.PublicKey(),
 		in.TelemetrySink,
+		in.PluginExtensions,
 	)
 }
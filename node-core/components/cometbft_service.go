@@ -26,9 +26,13 @@ import (
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/delay"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-core/builder"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/observability/debug"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
 )
@@ -43,7 +47,22 @@ func ProvideCometBFTService(
 	cmtCfg *cmtcfg.Config,
 	appOpts config.AppOptions,
 	telemetrySink *metrics.TelemetrySink,
+	blsSigner crypto.BLSSigner,
+	blockSchedule *delay.Schedule,
+	cfg *config.Config,
+	debugService *debug.Service,
 ) *cometbft.Service {
+	opts := append(
+		builder.DefaultServiceOptions(appOpts),
+		cometbft.SetBlockSchedule(blockSchedule),
+		cometbft.SetMaxFinalizedBlockAge(cfg.Health.MaxFinalizedBlockAge),
+		cometbft.SetBlockProfiler(debugService),
+	)
+	// CometBFT must sign with the key decrypted from the keystore, as the
+	// privval key file is not available in that case.
+	if ks, ok := blsSigner.(*signer.KeystoreSigner); ok {
+		opts = append(opts, cometbft.SetPrivValidator(ks.PrivValidator))
+	}
 	return cometbft.NewService(
 		logger,
 		db,
@@ -52,6 +71,6 @@ func ProvideCometBFTService(
 		cs,
 		cmtCfg,
 		telemetrySink,
-		builder.DefaultServiceOptions(appOpts)...,
+		opts...,
 	)
 }
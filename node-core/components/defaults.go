@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+// DefaultComponents returns the providers of the components of a beacond
+// node. Nodes extending beacond should register plugins, see the plugin
+// package, rather than copy this list.
+func DefaultComponents() []any {
+	c := []any{
+		ProvideAttributesFactory,
+		ProvideAvailabilityStore,
+		ProvideDepositContract,
+		ProvideBlockStore,
+		ProvideBlsSigner,
+		ProvideBlobProcessor,
+		ProvideBlobProofVerifier,
+		ProvideBlockSchedule,
+		ProvideChainService,
+		ProvideNode,
+		ProvideConfig,
+		ProvideServerConfig,
+		ProvideDepositStore,
+		ProvideFeeRecipientRegistry,
+		ProvideEngineClient,
+		ProvideExecutionEngine,
+		ProvideHealthMonitor,
+		ProvideJWTSecret,
+		ProvideLocalBuilder,
+		ProvideLogReloadService,
+		ProvideRelayClient,
+		ProvideReportingService,
+		ProvideCometBFTService,
+		ProvideDebugService,
+		ProvideServiceRegistry,
+		ProvideSidecarFactory,
+		ProvideStateArchive,
+		ProvideStateProcessor,
+		ProvideKVStore,
+		ProvideStorageBackend,
+		ProvideMetricsRegistry,
+		ProvideMetricsService,
+		ProvideTelemetrySink,
+		ProvideTelemetryService,
+		ProvideTracingService,
+		ProvideTrustedSetup,
+		ProvideValidatorService,
+		ProvideNodeAPIServer,
+		ProvidePluginExtensions,
+		ProvideShutDownService,
+	}
+	return c
+}
@@ -21,12 +21,17 @@
 package components
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
 	"path/filepath"
 
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/berachain/beacon-kit/storage/deposit/snapshot"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cast"
@@ -37,6 +42,7 @@ type DepositStoreInput struct {
 	depinject.In
 	Logger  *phuslu.Logger
 	AppOpts config.AppOptions
+	Config  *config.Config
 }
 
 // ProvideDepositStore is a function that provides the module to the
@@ -53,8 +59,46 @@ func ProvideDepositStore(in DepositStoreInput) (deposit.StoreManager, error) {
 		return nil, err
 	}
 
-	return deposit.NewStore(
+	cfg := in.Config.DepositStore
+	store := deposit.NewStore(
 		dbV1,
 		in.Logger.With("service", "deposit-store"),
-	), nil
+		cfg,
+	)
+	if cfg.SnapshotPath == "" {
+		return store, nil
+	}
+
+	// Initialize the store from the configured snapshot, if still empty.
+	s, err := readDepositSnapshot(cfg.SnapshotPath)
+	if err != nil {
+		return nil, err
+	}
+	if err = store.InitFromSnapshot(context.Background(), s); err != nil {
+		return nil, fmt.Errorf("failed initializing deposit store from snapshot: %w", err)
+	}
+	return store, nil
+}
+
+// readDepositSnapshot reads a JSON encoded EIP-4881 deposit snapshot from file.
+// Both the bare snapshot and the deposit_snapshot endpoint response are accepted.
+func readDepositSnapshot(path string) (*snapshot.Snapshot, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading deposit snapshot: %w", err)
+	}
+	var resp struct {
+		Data *snapshot.Snapshot `json:"data"`
+	}
+	if err = json.Unmarshal(bz, &resp); err != nil {
+		return nil, fmt.Errorf("failed decoding deposit snapshot: %w", err)
+	}
+	if resp.Data != nil {
+		return resp.Data, nil
+	}
+	s := new(snapshot.Snapshot)
+	if err = json.Unmarshal(bz, s); err != nil {
+		return nil, fmt.Errorf("failed decoding deposit snapshot: %w", err)
+	}
+	return s, nil
 }
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+	"time"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/payload/feerecipient"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// feeRecipientRegistrationEpochs is the number of epochs an API registration
+// of a fee recipient is used for. Validator clients renew their registrations
+// every epoch, hence an extra epoch covers a late renewal.
+const feeRecipientRegistrationEpochs = 2
+
+// FeeRecipientRegistryInput is the input for the dep inject framework.
+type FeeRecipientRegistryInput struct {
+	depinject.In
+	AppOpts   config.AppOptions
+	ChainSpec chain.Spec
+	Config    *config.Config
+}
+
+// ProvideFeeRecipientRegistry provides the registry of the per-validator fee
+// recipients for the depinject framework.
+func ProvideFeeRecipientRegistry(in FeeRecipientRegistryInput) (*feerecipient.Registry, error) {
+	cfg := in.Config.PayloadBuilder
+
+	var configured map[crypto.BLSPubkey]common.ExecutionAddress
+	if cfg.FeeRecipientsFile != "" {
+		var err error
+		if configured, err = feerecipient.ReadMappingFile(cfg.FeeRecipientsFile); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		rootDir = cast.ToString(in.AppOpts.Get(flags.FlagHome))
+		dataDir = filepath.Join(rootDir, "data")
+		name    = "fee_recipients"
+	)
+	db, err := dbm.NewDB(name, dbm.PebbleDBBackend, dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// #nosec G115 -- the product of the spec values fits an int64.
+	ttl := time.Duration(
+		feeRecipientRegistrationEpochs*in.ChainSpec.SlotsPerEpoch()*in.ChainSpec.TargetSecondsPerEth1Block(),
+	) * time.Second
+	return feerecipient.NewRegistry(cfg.SuggestedFeeRecipient, configured, db, ttl)
+}
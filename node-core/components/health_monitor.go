@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/node-core/services/health"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// HealthMonitorInput is the input for the health monitor provider.
+type HealthMonitorInput struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Config  *config.Config
+}
+
+// ProvideHealthMonitor provides the monitor of the health of the node, with
+// the checks of the storage of the node. The checks of the services are
+// registered along with the services, by ProvideServiceRegistry.
+func ProvideHealthMonitor(in HealthMonitorInput) *health.Monitor {
+	cfg := in.Config.Health
+	monitor := health.NewMonitor(cfg.CheckTimeout, cfg.CacheTTL)
+	monitor.Register(health.DirWritable("da-store", availabilityStoreDir(in.AppOpts)))
+	if cfg.MinFreeDiskSpaceMB > 0 {
+		homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
+		monitor.Register(health.FreeDiskSpace("disk-space", homeDir, cfg.MinFreeDiskSpaceMB))
+	}
+	return monitor
+}
@@ -50,8 +50,10 @@ type (
 			payloadWithdrawals engineprimitives.Withdrawals,
 			prevRandao common.Bytes32,
 			prevHeadRoot common.Root,
+			proposerPubkey crypto.BLSPubkey,
 			parentProposerPubkey *crypto.BLSPubkey,
 		) (*engineprimitives.PayloadAttributes, error)
+		FeeRecipient(proposerPubkey crypto.BLSPubkey) common.ExecutionAddress
 	}
 
 	// BlobProcessor is the interface for the blobs processor.
@@ -88,6 +90,7 @@ type (
 			slot math.Slot,
 			parentBlockRoot common.Root,
 			expectedForkVersion common.Version,
+			proposerPubkey crypto.BLSPubkey,
 		) (ctypes.BuiltExecutionPayloadEnv, error)
 		// RequestPayloadSync requests a payload for the given slot and
 		// blocks until the payload is delivered.
@@ -95,6 +98,12 @@ type (
 			ctx context.Context,
 			r *builder.RequestPayloadData,
 		) (ctypes.BuiltExecutionPayloadEnv, error)
+		// FallbackPayload returns a payload for the given slot after building
+		// it the regular way failed, within the deadline of ctx.
+		FallbackPayload(
+			ctx context.Context,
+			r *builder.RequestPayloadData,
+		) (ctypes.BuiltExecutionPayloadEnv, error)
 		CacheLatestVerifiedPayload(
 			latestEnvelopeSlot math.Slot,
 			latestEnvelope ctypes.BuiltExecutionPayloadEnv,
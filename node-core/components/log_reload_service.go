@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/services/logreload"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// LogReloadServiceInput is the input for the log reload service provider.
+type LogReloadServiceInput struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Logger  *phuslu.Logger
+}
+
+// ProvideLogReloadService provides the service reloading the logger
+// configuration from app.toml when the node receives SIGHUP.
+func ProvideLogReloadService(in LogReloadServiceInput) *logreload.Service {
+	appTOML := filepath.Join(cast.ToString(in.AppOpts.Get(flags.FlagHome)), "config", "app.toml")
+	return logreload.NewService(
+		in.Logger.With("service", "log-reload"),
+		in.Logger,
+		func() (*phuslu.Config, error) {
+			cfg, err := config.ReadConfigFile(appTOML)
+			if err != nil {
+				return nil, err
+			}
+			return cfg.GetLogger(), nil
+		},
+	)
+}
@@ -23,60 +23,45 @@ package metrics
 import (
 	"time"
 
-	"github.com/cosmos/cosmos-sdk/telemetry"
-	"github.com/hashicorp/go-metrics"
+	"github.com/berachain/beacon-kit/observability/metrics"
 )
 
-type TelemetrySink struct{}
+// TelemetrySink emits the metrics of the node to the Prometheus registry.
+// The zero value discards the metrics.
+type TelemetrySink struct {
+	registry *metrics.Registry
+}
 
-// NewTelemetrySink creates a new TelemetrySink.
-func NewTelemetrySink() TelemetrySink {
-	return TelemetrySink{}
+// NewTelemetrySink creates a new TelemetrySink emitting to the registry.
+func NewTelemetrySink(registry *metrics.Registry) *TelemetrySink {
+	return &TelemetrySink{registry: registry}
 }
 
 // IncrementCounter increments a counter metric identified by the provided
 // keys.
-func (TelemetrySink) IncrementCounter(key string, args ...string) {
-	telemetry.IncrCounterWithLabels([]string{key}, 1, argsToLabels(args...))
+func (s TelemetrySink) IncrementCounter(key string, args ...string) {
+	if s.registry == nil {
+		return
+	}
+	s.registry.IncrementCounter(key, args...)
 }
 
 // SetGauge sets a gauge metric to the specified value, identified by the
 // provided keys.
-func (TelemetrySink) SetGauge(key string, value int64, args ...string) {
-	telemetry.SetGaugeWithLabels(
-		[]string{key},
-		float32(value),
-		argsToLabels(args...),
-	)
+func (s TelemetrySink) SetGauge(key string, value int64, args ...string) {
+	if s.registry == nil {
+		return
+	}
+	s.registry.SetGauge(key, float64(value), args...)
 }
 
 // MeasureSince measures the time since the provided start time and records
 // the duration in a metric identified by the provided key.
-func (TelemetrySink) MeasureSince(key string, start time.Time, args ...string) {
-	if !telemetry.IsTelemetryEnabled() {
+func (s TelemetrySink) MeasureSince(key string, start time.Time, args ...string) {
+	if s.registry == nil {
 		return
 	}
-
-	// TODO: Make PR to SDK, currently this will not have any globalLabels.
-	metrics.MeasureSinceWithLabels(
-		[]string{key},
-		start.UTC(),
-		argsToLabels(args...),
-	)
-}
-
-// argsToLabels converts a list of key-value pairs to a list of metrics labels.
-//
-//nolint:mnd // its okay.
-func argsToLabels(args ...string) []metrics.Label {
-	labels := make([]metrics.Label, len(args)/2)
-	for i := 0; i < len(args); i += 2 {
-		labels[i/2] = metrics.Label{
-			Name:  args[i],
-			Value: args[i+1],
-		}
-	}
-	return labels
+	s.registry.Observe(key, time.Since(start).Seconds(), args...)
 }
 
 // NoOpTelemetrySink is a no-op implementation of the TelemetrySink interface.
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/observability/metrics"
+)
+
+// MetricsServiceInput is the input for the metrics service provider.
+type MetricsServiceInput struct {
+	depinject.In
+	Config   *config.Config
+	Logger   *phuslu.Logger
+	Registry *metrics.Registry
+}
+
+// ProvideMetricsRegistry provides the Prometheus registry the metrics of the
+// node are emitted to.
+func ProvideMetricsRegistry(cfg *config.Config) (*metrics.Registry, error) {
+	return metrics.NewRegistry(&cfg.Metrics)
+}
+
+// ProvideMetricsService provides the service serving the metrics of the node.
+func ProvideMetricsService(in MetricsServiceInput) *metrics.Service {
+	return metrics.NewService(
+		&in.Config.Metrics,
+		in.Logger.With("service", "metrics"),
+		in.Registry,
+	)
+}
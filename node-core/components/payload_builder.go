@@ -24,8 +24,10 @@ import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/delay"
 	"github.com/berachain/beacon-kit/execution/engine"
 	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	payloadbuilder "github.com/berachain/beacon-kit/payload/builder"
 	"github.com/berachain/beacon-kit/payload/cache"
 )
@@ -34,10 +36,18 @@ import (
 type LocalBuilderInput struct {
 	depinject.In
 	AttributesFactory AttributesFactory
+	BlockSchedule     *delay.Schedule
 	Cfg               *config.Config
 	ChainSpec         chain.Spec
 	ExecutionEngine   *engine.Engine
 	Logger            *phuslu.Logger
+	TelemetrySink     *metrics.TelemetrySink
+}
+
+// ProvideBlockSchedule provides the schedule the expected proposal time of
+// the next block is published to.
+func ProvideBlockSchedule() *delay.Schedule {
+	return delay.NewSchedule()
 }
 
 // ProvideLocalBuilder provides a local payload builder for the
@@ -50,5 +60,7 @@ func ProvideLocalBuilder(in LocalBuilderInput) *payloadbuilder.PayloadBuilder {
 		in.ExecutionEngine,
 		cache.NewPayloadIDCache(),
 		in.AttributesFactory,
+		in.BlockSchedule,
+		in.TelemetrySink,
 	)
 }
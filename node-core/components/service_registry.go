@@ -25,28 +25,46 @@ import (
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	"github.com/berachain/beacon-kit/beacon/validator"
 	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/execution/engine"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-api/server"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/plugin"
+	"github.com/berachain/beacon-kit/node-core/services/health"
+	"github.com/berachain/beacon-kit/node-core/services/logreload"
 	service "github.com/berachain/beacon-kit/node-core/services/registry"
 	"github.com/berachain/beacon-kit/node-core/services/shutdown"
 	"github.com/berachain/beacon-kit/node-core/services/version"
 	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/observability/debug"
+	obsmetrics "github.com/berachain/beacon-kit/observability/metrics"
 	"github.com/berachain/beacon-kit/observability/telemetry"
+	"github.com/berachain/beacon-kit/observability/tracing"
+	"github.com/berachain/beacon-kit/payload/feerecipient"
+	"github.com/berachain/beacon-kit/payload/relay"
 )
 
 // ServiceRegistryInput is the input for the service registry provider.
 type ServiceRegistryInput struct {
 	depinject.In
 	ChainService     *blockchain.Service
+	DebugService     *debug.Service
 	EngineClient     *client.EngineClient
+	ExecutionEngine  *engine.Engine
+	FeeRecipients    *feerecipient.Registry
+	HealthMonitor    *health.Monitor
 	Logger           *phuslu.Logger
+	LogReloadService *logreload.Service
 	NodeAPIServer    *server.Server
+	RelayClient      *relay.Client
 	ReportingService *version.ReportingService
 	TelemetrySink    *metrics.TelemetrySink
+	MetricsService   *obsmetrics.Service
 	TelemetryService *telemetry.Service
+	TracingService   *tracing.Service
 	ValidatorService *validator.Service
 	CometBFTService  types.ConsensusService
+	PluginExtensions *plugin.Extensions
 	ShutdownService  *shutdown.Service
 }
 
@@ -58,20 +76,41 @@ func ProvideServiceRegistry(in ServiceRegistryInput) *service.Registry {
 	opts := []service.RegistryOption{
 		// we want shutdownservice to be the first service to start and the last to stop
 		service.WithService(in.ShutdownService),
+		// tracingService stops right before, to export the spans of the
+		// other services
+		service.WithService(in.TracingService),
+		// debugService starts early so that a node stuck starting, e.g.
+		// waiting for the execution client, can be inspected
+		service.WithService(in.DebugService),
+		service.WithService(in.LogReloadService),
+		// feeRecipients stops after the services registering and reading
+		// the fee recipients, to persist their registrations until then
+		service.WithService(in.FeeRecipients),
 
 		service.WithService(in.ValidatorService),
+		service.WithService(in.RelayClient),
 		service.WithService(in.NodeAPIServer),
 		service.WithService(in.ReportingService),
 		service.WithService(in.TelemetryService),
+		service.WithService(in.MetricsService),
 
 		// engineClient will block until it connects to the execution layer
 		service.WithService(in.EngineClient),
+		// executionEngine replays the payloads the execution client missed,
+		// if the optimistic mode is enabled
+		service.WithService(in.ExecutionEngine),
 
 		// only once we connect to an execution client will we start the
 		// chain service and cometbft service
 		service.WithService(in.ChainService),
 		service.WithService(in.CometBFTService),
 	}
+	// plugin services start once the node is running, and stop first
+	for _, svc := range in.PluginExtensions.Services() {
+		opts = append(opts, service.WithService(svc))
+	}
 
-	return service.NewRegistry(in.Logger, opts...)
+	registry := service.NewRegistry(in.Logger, opts...)
+	in.HealthMonitor.Register(registry.HealthChecks()...)
+	return registry
 }
@@ -47,19 +47,27 @@ func ProvideBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
 	if in.PrivKey == [constants.BLSSecretKeyLength]byte{} {
 		// if no private key is provided, use privval signer
 		homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
-		privValKeyFile := cast.ToString(
-			in.AppOpts.Get(beaconflags.PrivValidatorKeyFile),
+		privValKeyFile := resolveHomePath(
+			homeDir, cast.ToString(in.AppOpts.Get(beaconflags.PrivValidatorKeyFile)),
 		)
-		privValStateFile := cast.ToString(
-			in.AppOpts.Get(beaconflags.PrivValidatorStateFile),
+		privValStateFile := resolveHomePath(
+			homeDir, cast.ToString(in.AppOpts.Get(beaconflags.PrivValidatorStateFile)),
 		)
-		// If privValKeyFile is not an absolute path, join with homeDir
-		if !filepath.IsAbs(privValKeyFile) {
-			privValKeyFile = filepath.Join(homeDir, privValKeyFile)
+
+		// Check state file existence as the error in NewBLSSigner is vague.
+		if _, err := os.Stat(privValStateFile); os.IsNotExist(err) {
+			return nil, fmt.Errorf("state file does not exist at path: %s", privValStateFile)
 		}
-		// If privValStateFile is not an absolute path, join with homeDir
-		if !filepath.IsAbs(privValStateFile) {
-			privValStateFile = filepath.Join(homeDir, privValStateFile)
+
+		// If a keystore is configured, the privval key file is not read.
+		if keystorePath := cast.ToString(in.AppOpts.Get(beaconflags.KeystorePath)); keystorePath != "" {
+			password, err := signer.ReadKeystorePassword(
+				cast.ToString(in.AppOpts.Get(beaconflags.KeystorePasswordFile)),
+			)
+			if err != nil {
+				return nil, err
+			}
+			return signer.NewKeystoreSigner(resolveHomePath(homeDir, keystorePath), password, privValStateFile)
 		}
 
 		// Check key file existence here as the error in NewBLSSigner is vague.
@@ -67,12 +75,15 @@ func ProvideBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
 			return nil, fmt.Errorf("key file does not exist at path: %s", privValKeyFile)
 		}
 
-		// Check state file existence as the error in NewBLSSigner is vague.
-		if _, err := os.Stat(privValStateFile); os.IsNotExist(err) {
-			return nil, fmt.Errorf("state file does not exist at path: %s", privValStateFile)
-		}
-
 		return signer.NewBLSSigner(privValKeyFile, privValStateFile), nil
 	}
 	return signer.NewLegacySigner(in.PrivKey)
 }
+
+// resolveHomePath joins the path with the home directory, unless absolute.
+func resolveHomePath(homeDir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(homeDir, path)
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"github.com/berachain/beacon-kit/primitives/crypto/eip2333"
+	"github.com/cosmos/go-bip39"
+)
+
+// LegacyKeyFromMnemonic derives the BLS secret key at the given EIP-2334 path
+// from a bip39 mnemonic, following EIP-2333 with an empty bip39 passphrase.
+func LegacyKeyFromMnemonic(mnemonic, path string) (LegacyKey, error) {
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return LegacyKey{}, ErrInvalidMnemonic
+	}
+	seed := bip39.NewSeed(mnemonic, "")
+	secret, err := eip2333.DeriveSecretKey(seed, path)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	return LegacyKey(secret), nil
+}
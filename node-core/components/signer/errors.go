@@ -36,4 +36,23 @@ var (
 	ErrInvalidValidatorPrivateKeyLength = errors.New(
 		"invalid validator private key length",
 	)
+
+	// ErrUnsupportedKeystore is returned when a keystore uses a version or a
+	// function not supported by EIP-2335.
+	ErrUnsupportedKeystore = errors.New("unsupported keystore")
+
+	// ErrInvalidKeystorePassword is returned when a keystore cannot be
+	// decrypted with the given password.
+	ErrInvalidKeystorePassword = errors.New("invalid keystore password")
+
+	// ErrKeystorePasswordRequired is returned when neither a keystore password
+	// file nor the keystore password environment variable is set.
+	ErrKeystorePasswordRequired = errors.New("keystore password required")
+
+	// ErrKeystorePubkeyMismatch is returned when the secret key held by a
+	// keystore does not match the public key it declares.
+	ErrKeystorePubkeyMismatch = errors.New("keystore public key mismatch")
+
+	// ErrInvalidMnemonic is returned when a bip39 mnemonic is invalid.
+	ErrInvalidMnemonic = errors.New("invalid mnemonic")
 )
@@ -0,0 +1,315 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// KDFScrypt is the scrypt key derivation function of EIP-2335 keystores.
+	KDFScrypt = "scrypt"
+	// KDFPBKDF2 is the PBKDF2 key derivation function of EIP-2335 keystores.
+	KDFPBKDF2 = "pbkdf2"
+
+	keystoreVersion  = 4
+	checksumFunction = "sha256"
+	cipherFunction   = "aes-128-ctr"
+	pbkdf2PRF        = "hmac-sha256"
+
+	// Parameters recommended by EIP-2335.
+	derivedKeyLength = 32
+	scryptN          = 262144
+	scryptR          = 8
+	scryptP          = 1
+	pbkdf2Count      = 262144
+	saltLength       = 32
+)
+
+// Keystore is an EIP-2335 keystore, holding a BLS secret key encrypted with a
+// key derived from a password.
+type Keystore struct {
+	Crypto      KeystoreCrypto `json:"crypto"`
+	Description string         `json:"description"`
+	Pubkey      string         `json:"pubkey"`
+	Path        string         `json:"path"`
+	UUID        string         `json:"uuid"`
+	Version     uint           `json:"version"`
+}
+
+// KeystoreCrypto holds the modules used to encrypt the secret key.
+type KeystoreCrypto struct {
+	KDF      KeystoreModule `json:"kdf"`
+	Checksum KeystoreModule `json:"checksum"`
+	Cipher   KeystoreModule `json:"cipher"`
+}
+
+// KeystoreModule is a keystore module, whose params depend on its function.
+type KeystoreModule struct {
+	Function string          `json:"function"`
+	Params   json.RawMessage `json:"params"`
+	Message  string          `json:"message"`
+}
+
+type scryptParams struct {
+	DKLen int    `json:"dklen"`
+	N     int    `json:"n"`
+	P     int    `json:"p"`
+	R     int    `json:"r"`
+	Salt  string `json:"salt"`
+}
+
+type pbkdf2Params struct {
+	DKLen int    `json:"dklen"`
+	C     int    `json:"c"`
+	PRF   string `json:"prf"`
+	Salt  string `json:"salt"`
+}
+
+type cipherParams struct {
+	IV string `json:"iv"`
+}
+
+// EncryptKeystore encrypts the given BLS secret key into an EIP-2335
+// keystore, deriving the encryption key from the password with the given key
+// derivation function, either KDFScrypt or KDFPBKDF2.
+func EncryptKeystore(
+	secret []byte,
+	pubkey crypto.BLSPubkey,
+	password string,
+	kdf string,
+) (*Keystore, error) {
+	salt := make([]byte, saltLength)
+	iv := make([]byte, aes.BlockSize)
+	id := make([]byte, 16) //nolint:mnd // 128 bits UUID.
+	for _, bz := range [][]byte{salt, iv, id} {
+		if _, err := rand.Read(bz); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		kdfModule = KeystoreModule{Function: kdf}
+		err       error
+	)
+	switch kdf {
+	case KDFScrypt:
+		kdfModule.Params, err = json.Marshal(scryptParams{
+			DKLen: derivedKeyLength, N: scryptN, P: scryptP, R: scryptR, Salt: hex.EncodeToString(salt),
+		})
+	case KDFPBKDF2:
+		kdfModule.Params, err = json.Marshal(pbkdf2Params{
+			DKLen: derivedKeyLength, C: pbkdf2Count, PRF: pbkdf2PRF, Salt: hex.EncodeToString(salt),
+		})
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedKeystore, "kdf function %q", kdf)
+	}
+	if err != nil {
+		return nil, err
+	}
+	cipherModuleParams, err := json.Marshal(cipherParams{IV: hex.EncodeToString(iv)})
+	if err != nil {
+		return nil, err
+	}
+
+	ks := &Keystore{
+		Crypto: KeystoreCrypto{
+			KDF:      kdfModule,
+			Checksum: KeystoreModule{Function: checksumFunction, Params: json.RawMessage(`{}`)},
+			Cipher:   KeystoreModule{Function: cipherFunction, Params: cipherModuleParams},
+		},
+		Pubkey:  hex.EncodeToString(pubkey[:]),
+		UUID:    formatUUID(id),
+		Version: keystoreVersion,
+	}
+	decryptionKey, err := ks.decryptionKey(password)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := aes128CTR(decryptionKey[:16], iv, secret)
+	if err != nil {
+		return nil, err
+	}
+	checksum := keystoreChecksum(decryptionKey, cipherText)
+	ks.Crypto.Cipher.Message = hex.EncodeToString(cipherText)
+	ks.Crypto.Checksum.Message = hex.EncodeToString(checksum[:])
+	return ks, nil
+}
+
+// ReadKeystore reads an EIP-2335 keystore from file.
+func ReadKeystore(path string) (*Keystore, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading keystore: %w", err)
+	}
+	ks := new(Keystore)
+	if err = json.Unmarshal(bz, ks); err != nil {
+		return nil, fmt.Errorf("failed decoding keystore %s: %w", path, err)
+	}
+	if ks.Version != keystoreVersion {
+		return nil, errors.Wrapf(ErrUnsupportedKeystore, "version %d", ks.Version)
+	}
+	return ks, nil
+}
+
+// Decrypt returns the BLS secret key held by the keystore, failing with
+// ErrInvalidKeystorePassword if the password does not match.
+func (ks *Keystore) Decrypt(password string) ([]byte, error) {
+	if ks.Crypto.Checksum.Function != checksumFunction {
+		return nil, errors.Wrapf(ErrUnsupportedKeystore, "checksum function %q", ks.Crypto.Checksum.Function)
+	}
+	if ks.Crypto.Cipher.Function != cipherFunction {
+		return nil, errors.Wrapf(ErrUnsupportedKeystore, "cipher function %q", ks.Crypto.Cipher.Function)
+	}
+	var params cipherParams
+	if err := json.Unmarshal(ks.Crypto.Cipher.Params, &params); err != nil {
+		return nil, fmt.Errorf("failed decoding cipher params: %w", err)
+	}
+	iv, err := hex.DecodeString(params.IV)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding cipher iv: %w", err)
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, errors.Wrapf(ErrUnsupportedKeystore, "cipher iv of %d bytes", len(iv))
+	}
+	cipherText, err := hex.DecodeString(ks.Crypto.Cipher.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding cipher message: %w", err)
+	}
+	checksum, err := hex.DecodeString(ks.Crypto.Checksum.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding checksum: %w", err)
+	}
+
+	decryptionKey, err := ks.decryptionKey(password)
+	if err != nil {
+		return nil, err
+	}
+	if expected := keystoreChecksum(decryptionKey, cipherText); !bytes.Equal(expected[:], checksum) {
+		return nil, ErrInvalidKeystorePassword
+	}
+	return aes128CTR(decryptionKey[:16], iv, cipherText)
+}
+
+// PublicKey returns the public key of the secret key held by the keystore.
+func (ks *Keystore) PublicKey() (crypto.BLSPubkey, error) {
+	var pubkey crypto.BLSPubkey
+	err := pubkey.UnmarshalText([]byte("0x" + strings.TrimPrefix(ks.Pubkey, "0x")))
+	return pubkey, err
+}
+
+// WriteFile writes the keystore to the given path, with owner-only permissions.
+func (ks *Keystore) WriteFile(path string) error {
+	bz, err := json.MarshalIndent(ks, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, bz, secretFilePerms)
+}
+
+// decryptionKey derives the decryption key from the password, as specified by
+// the kdf module of the keystore.
+func (ks *Keystore) decryptionKey(password string) ([]byte, error) {
+	pw := normalizePassword(password)
+	switch ks.Crypto.KDF.Function {
+	case KDFScrypt:
+		var params scryptParams
+		if err := json.Unmarshal(ks.Crypto.KDF.Params, &params); err != nil {
+			return nil, fmt.Errorf("failed decoding kdf params: %w", err)
+		}
+		if params.DKLen != derivedKeyLength {
+			return nil, errors.Wrapf(ErrUnsupportedKeystore, "kdf dklen %d", params.DKLen)
+		}
+		salt, err := hex.DecodeString(params.Salt)
+		if err != nil {
+			return nil, fmt.Errorf("failed decoding kdf salt: %w", err)
+		}
+		return scrypt.Key(pw, salt, params.N, params.R, params.P, params.DKLen)
+	case KDFPBKDF2:
+		var params pbkdf2Params
+		if err := json.Unmarshal(ks.Crypto.KDF.Params, &params); err != nil {
+			return nil, fmt.Errorf("failed decoding kdf params: %w", err)
+		}
+		if params.DKLen != derivedKeyLength {
+			return nil, errors.Wrapf(ErrUnsupportedKeystore, "kdf dklen %d", params.DKLen)
+		}
+		if params.PRF != pbkdf2PRF {
+			return nil, errors.Wrapf(ErrUnsupportedKeystore, "pbkdf2 prf %q", params.PRF)
+		}
+		salt, err := hex.DecodeString(params.Salt)
+		if err != nil {
+			return nil, fmt.Errorf("failed decoding kdf salt: %w", err)
+		}
+		return pbkdf2.Key(sha256.New, string(pw), salt, params.C, params.DKLen)
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedKeystore, "kdf function %q", ks.Crypto.KDF.Function)
+	}
+}
+
+// normalizePassword applies the NFKD normalization to the password and strips
+// the C0, C1 and Delete control codes from it, as required by EIP-2335.
+func normalizePassword(password string) []byte {
+	return []byte(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, norm.NFKD.String(password)))
+}
+
+// keystoreChecksum computes the checksum of the cipher text, as the SHA256 of
+// the second half of the decryption key followed by the cipher text.
+func keystoreChecksum(decryptionKey, cipherText []byte) [sha256.Size]byte {
+	return sha256.Sum256(append(append([]byte{}, decryptionKey[16:32]...), cipherText...))
+}
+
+func aes128CTR(key, iv, in []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(in))
+	cipher.NewCTR(block, iv).XORKeyStream(out, in)
+	return out, nil
+}
+
+// formatUUID formats 16 random bytes as a version 4 UUID.
+func formatUUID(bz []byte) string {
+	bz[6] = (bz[6] & 0x0f) | 0x40 //nolint:mnd // UUID version 4.
+	bz[8] = (bz[8] & 0x3f) | 0x80 //nolint:mnd // RFC 4122 variant.
+	return fmt.Sprintf("%x-%x-%x-%x-%x", bz[0:4], bz[4:6], bz[6:8], bz[8:10], bz[10:16])
+}
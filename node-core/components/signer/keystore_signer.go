@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+)
+
+const (
+	// KeystorePasswordEnv is the environment variable the keystore password is
+	// read from when no password file is configured.
+	KeystorePasswordEnv = "BEACOND_KEYSTORE_PASSWORD"
+
+	secretFilePerms os.FileMode = 0o600
+)
+
+// KeystoreSigner is a BLSSigner whose secret key is decrypted at startup from
+// an EIP-2335 keystore, so that it is never stored unencrypted at rest. The
+// last sign state is still persisted to the privval state file to prevent
+// double signing.
+type KeystoreSigner struct {
+	BLSSigner
+}
+
+// NewKeystoreSigner creates a new KeystoreSigner from the keystore and the
+// privval state file at the given paths.
+func NewKeystoreSigner(keystorePath, password, stateFilePath string) (*KeystoreSigner, error) {
+	privKey, err := DecryptKeystoreFile(keystorePath, password)
+	if err != nil {
+		return nil, err
+	}
+
+	// The key file path is left empty so that the secret key can never be
+	// saved back to disk in plaintext.
+	filePV := privval.NewFilePV(privKey, "", stateFilePath)
+	bz, err := os.ReadFile(stateFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading privval state: %w", err)
+	}
+	// Unmarshalling in place preserves the state file path set above.
+	if err = cmtjson.Unmarshal(bz, &filePV.LastSignState); err != nil {
+		return nil, fmt.Errorf("failed decoding privval state %s: %w", stateFilePath, err)
+	}
+	return &KeystoreSigner{BLSSigner: BLSSigner{PrivValidator: filePV}}, nil
+}
+
+// DecryptKeystoreFile reads the keystore at the given path and returns the
+// secret key it holds, after checking it matches the keystore public key.
+func DecryptKeystoreFile(path, password string) (*bls12381.PrivKey, error) {
+	ks, err := ReadKeystore(path)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := ks.Decrypt(password)
+	if err != nil {
+		return nil, fmt.Errorf("failed decrypting keystore %s: %w", path, err)
+	}
+	privKey, err := bls12381.NewPrivateKeyFromBytes(secret)
+	if err != nil {
+		return nil, err
+	}
+	pubkey, err := ks.PublicKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding keystore public key: %w", err)
+	}
+	if crypto.BLSPubkey(privKey.PubKey().Bytes()) != pubkey {
+		return nil, ErrKeystorePubkeyMismatch
+	}
+	return privKey, nil
+}
+
+// ReadKeystorePassword reads the keystore password from the given file, with
+// any trailing newline stripped, or from the KeystorePasswordEnv environment
+// variable if no file is given.
+func ReadKeystorePassword(passwordFile string) (string, error) {
+	if passwordFile == "" {
+		password, ok := os.LookupEnv(KeystorePasswordEnv)
+		if !ok {
+			return "", ErrKeystorePasswordRequired
+		}
+		return password, nil
+	}
+	bz, err := os.ReadFile(passwordFile)
+	if err != nil {
+		return "", fmt.Errorf("failed reading keystore password: %w", err)
+	}
+	return strings.TrimRight(string(bz), "\r\n"), nil
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/storage/archive"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// StateArchiveInput is the input for the dep inject framework.
+type StateArchiveInput struct {
+	depinject.In
+
+	AppOpts config.AppOptions
+	Config  *config.Config
+	Logger  *phuslu.Logger
+}
+
+// ProvideStateArchive is a function that provides the module to the
+// application.
+func ProvideStateArchive(in StateArchiveInput) (*archive.Store, error) {
+	cfg := in.Config.StateArchive
+	if !cfg.Enabled {
+		// Avoid creating the database on nodes that do not archive states.
+		return archive.NewStore(dbm.NewMemDB(), in.Logger.With("service", "state-archive"), cfg), nil
+	}
+
+	var (
+		rootDir = cast.ToString(in.AppOpts.Get(flags.FlagHome))
+		dataDir = filepath.Join(rootDir, "data")
+		name    = "state_archive"
+	)
+
+	db, err := dbm.NewDB(name, dbm.PebbleDBBackend, dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return archive.NewStore(db, in.Logger.With("service", "state-archive"), cfg), nil
+}
@@ -28,6 +28,7 @@ import (
 	dastore "github.com/berachain/beacon-kit/da/store"
 	"github.com/berachain/beacon-kit/log"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/archive"
 	"github.com/berachain/beacon-kit/storage/beacondb"
 	"github.com/berachain/beacon-kit/storage/block"
 	"github.com/berachain/beacon-kit/storage/deposit"
@@ -41,6 +42,7 @@ type Backend struct {
 	kvStore           *beacondb.KVStore
 	depositStore      deposit.StoreManager
 	blockStore        *block.KVStore[*types.BeaconBlock]
+	stateArchive      *archive.Store
 	logger            log.Logger
 	telemetrySink     statedb.TelemetrySink
 }
@@ -51,6 +53,7 @@ func NewBackend(
 	kvStore *beacondb.KVStore,
 	depositStore deposit.StoreManager,
 	blockStore *block.KVStore[*types.BeaconBlock],
+	stateArchive *archive.Store,
 	logger log.Logger,
 	telemetrySink statedb.TelemetrySink,
 ) *Backend {
@@ -60,6 +63,7 @@ func NewBackend(
 		kvStore:           kvStore,
 		depositStore:      depositStore,
 		blockStore:        blockStore,
+		stateArchive:      stateArchive,
 		logger:            logger,
 		telemetrySink:     telemetrySink,
 	}
@@ -91,6 +95,11 @@ func (k Backend) BlockStore() *block.KVStore[*types.BeaconBlock] {
 	return k.blockStore
 }
 
+// StateArchive returns the archive of historical beacon states.
+func (k Backend) StateArchive() *archive.Store {
+	return k.stateArchive
+}
+
 // DepositStore returns the deposit store struct initialized with a.
 func (k Backend) DepositStore() deposit.StoreManager {
 	return k.depositStore
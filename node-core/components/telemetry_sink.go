@@ -20,9 +20,13 @@
 
 package components
 
-import "github.com/berachain/beacon-kit/node-core/components/metrics"
+import (
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	obsmetrics "github.com/berachain/beacon-kit/observability/metrics"
+)
 
-// ProvideTelemetrySink is a function that provides a TelemetrySink.
-func ProvideTelemetrySink() *metrics.TelemetrySink {
-	return &metrics.TelemetrySink{}
+// ProvideTelemetrySink is a function that provides a TelemetrySink emitting
+// to the metrics registry.
+func ProvideTelemetrySink(registry *obsmetrics.Registry) *metrics.TelemetrySink {
+	return metrics.NewTelemetrySink(registry)
 }
@@ -25,11 +25,14 @@ import (
 	"github.com/berachain/beacon-kit/beacon/validator"
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/execution/client"
 	"github.com/berachain/beacon-kit/execution/deposit"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/payload/relay"
 	"github.com/berachain/beacon-kit/primitives/crypto"
+	sdkversion "github.com/cosmos/cosmos-sdk/version"
 )
 
 // ValidatorServiceInput is the input for the validator service provider.
@@ -38,8 +41,10 @@ type ValidatorServiceInput struct {
 	Cfg                   *config.Config
 	ChainSpec             chain.Spec
 	BeaconDepositContract deposit.Contract
+	EngineClient          *client.EngineClient
 	LocalBuilder          LocalBuilder
 	Logger                *phuslu.Logger
+	RelayClient           *relay.Client
 	StateProcessor        StateProcessor
 	StorageBackend        *storage.Backend
 	Signer                crypto.BLSSigner
@@ -49,10 +54,18 @@ type ValidatorServiceInput struct {
 
 // ProvideValidatorService is a depinject provider for the validator service.
 func ProvideValidatorService(in ValidatorServiceInput) (*validator.Service, error) {
+	logger := in.Logger.With("service", "validator")
+	graffiti, err := validator.NewGraffitiCalculator(
+		&in.Cfg.Validator, logger, in.EngineClient, sdkversion.Commit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build the builder service.
 	return validator.NewService(
 		&in.Cfg.Validator,
-		in.Logger.With("service", "validator"),
+		logger,
 		in.ChainSpec,
 		in.StorageBackend,
 		in.BeaconDepositContract,
@@ -60,6 +73,8 @@ func ProvideValidatorService(in ValidatorServiceInput) (*validator.Service, erro
 		in.Signer,
 		in.SidecarFactory,
 		in.LocalBuilder,
+		in.RelayClient,
+		graffiti,
 		in.TelemetrySink,
 	), nil
 }
@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package indexer is an example plugin indexing the latest finalized blocks
+// in memory and serving them on the node API.
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	apitypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-core/plugin"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// name is the name of the plugin.
+const name = "example-indexer"
+
+// Block is the summary of a finalized block.
+type Block struct {
+	Slot                 uint64 `json:"slot,string"`
+	ProposerIndex        uint64 `json:"proposer_index,string"`
+	BlockRoot            string `json:"block_root"`
+	StateRoot            string `json:"state_root"`
+	ExecutionBlockNumber uint64 `json:"execution_block_number,string"`
+	ExecutionBlockHash   string `json:"execution_block_hash"`
+	TransactionCount     int    `json:"transaction_count"`
+	DepositIndex         uint64 `json:"deposit_index,string"`
+}
+
+// Indexer indexes the latest finalized blocks. It is both the plugin and the
+// service it registers.
+type Indexer struct {
+	logger log.Logger
+	// capacity is the number of blocks retained.
+	capacity int
+
+	mu sync.RWMutex
+	// blocks are the latest finalized blocks, oldest first.
+	blocks []*Block
+}
+
+// New creates a new indexer retaining the latest capacity finalized blocks.
+func New(capacity int) *Indexer {
+	return &Indexer{
+		capacity: capacity,
+		blocks:   make([]*Block, 0, capacity),
+	}
+}
+
+// Name returns the name of the plugin and of its service.
+func (i *Indexer) Name() string {
+	return name
+}
+
+// Register registers the finalize hook indexing the blocks, the routes
+// serving them and the indexer service.
+func (i *Indexer) Register(deps plugin.Dependencies, ext *plugin.Extensions) error {
+	if i.capacity <= 0 {
+		return fmt.Errorf("invalid capacity %d", i.capacity)
+	}
+	i.logger = deps.Logger
+	ext.RegisterFinalizeHook(i.index)
+	ext.RegisterRoutes(handlers.NewRouteSet("",
+		&handlers.Route{
+			Method:  http.MethodGet,
+			Path:    "/plugins/indexer/v1/blocks",
+			Handler: i.GetBlocks,
+		},
+		&handlers.Route{
+			Method:  http.MethodGet,
+			Path:    "/plugins/indexer/v1/blocks/:slot",
+			Handler: i.GetBlock,
+		},
+	))
+	ext.RegisterService(i)
+	return nil
+}
+
+// Start starts the indexer service.
+func (i *Indexer) Start(context.Context) error {
+	i.logger.Info("Indexing finalized blocks", "capacity", i.capacity)
+	return nil
+}
+
+// Stop stops the indexer service.
+func (i *Indexer) Stop() error {
+	return nil
+}
+
+// index is the finalize hook indexing blk.
+func (i *Indexer) index(_ context.Context, blk *ctypes.BeaconBlock, st *statedb.StateDB) error {
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return err
+	}
+	payload := blk.GetBody().GetExecutionPayload()
+	i.add(&Block{
+		Slot:                 blk.GetSlot().Unwrap(),
+		ProposerIndex:        blk.GetProposerIndex().Unwrap(),
+		BlockRoot:            blk.HashTreeRoot().String(),
+		StateRoot:            blk.GetStateRoot().String(),
+		ExecutionBlockNumber: payload.GetNumber().Unwrap(),
+		ExecutionBlockHash:   payload.GetBlockHash().String(),
+		TransactionCount:     len(payload.GetTransactions()),
+		DepositIndex:         depositIndex,
+	})
+	return nil
+}
+
+// add indexes b, dropping the oldest block beyond the capacity.
+func (i *Indexer) add(b *Block) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if len(i.blocks) == i.capacity {
+		i.blocks = append(i.blocks[:0], i.blocks[1:]...)
+	}
+	i.blocks = append(i.blocks, b)
+}
+
+// response is the response of the indexer routes.
+type response struct {
+	Data any `json:"data"`
+}
+
+// GetBlocks returns the indexed blocks, latest first.
+func (i *Indexer) GetBlocks(handlers.Context) (any, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	blocks := make([]*Block, 0, len(i.blocks))
+	for j := len(i.blocks) - 1; j >= 0; j-- {
+		blocks = append(blocks, i.blocks[j])
+	}
+	return response{Data: blocks}, nil
+}
+
+// GetBlock returns the indexed block at the requested slot.
+func (i *Indexer) GetBlock(c handlers.Context) (any, error) {
+	slot, err := strconv.ParseUint(c.Param("slot"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid slot %q", apitypes.ErrInvalidRequest, c.Param("slot"))
+	}
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	for _, b := range i.blocks {
+		if b.Slot == slot {
+			return response{Data: b}, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: block at slot %d is not indexed", apitypes.ErrNotFound, slot)
+}
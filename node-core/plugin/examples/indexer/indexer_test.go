@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package indexer_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log/phuslu"
+	apitypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-core/plugin"
+	"github.com/berachain/beacon-kit/node-core/plugin/examples/indexer"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexerRegister(t *testing.T) {
+	t.Parallel()
+
+	idx := indexer.New(8)
+	ext, err := plugin.NewExtensions(phuslu.NewLogger(&bytes.Buffer{}, nil), plugin.Dependencies{}, plugin.Plugins{idx})
+	require.NoError(t, err)
+	require.Same(t, idx, ext.Services()[0])
+	require.Len(t, ext.Routes(), 1)
+	require.Len(t, ext.Routes()[0].Routes, 2)
+	require.NoError(t, idx.Start(t.Context()))
+	require.NoError(t, idx.Stop())
+
+	_, err = plugin.NewExtensions(phuslu.NewLogger(&bytes.Buffer{}, nil), plugin.Dependencies{}, plugin.Plugins{indexer.New(0)})
+	require.ErrorContains(t, err, "invalid capacity")
+}
+
+func TestIndexerRoutes(t *testing.T) {
+	t.Parallel()
+
+	idx := indexer.New(8)
+	e := echo.New()
+	newContext := func(slot string) echo.Context {
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/plugins/indexer/v1/blocks/"+slot, nil), httptest.NewRecorder())
+		c.SetParamNames("slot")
+		c.SetParamValues(slot)
+		return c
+	}
+
+	res, err := idx.GetBlocks(e.NewContext(httptest.NewRequest(http.MethodGet, "/plugins/indexer/v1/blocks", nil), httptest.NewRecorder()))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	_, err = idx.GetBlock(newContext("12"))
+	require.ErrorIs(t, err, apitypes.ErrNotFound)
+	_, err = idx.GetBlock(newContext("latest"))
+	require.ErrorIs(t, err, apitypes.ErrInvalidRequest)
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	service "github.com/berachain/beacon-kit/node-core/services/registry"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// FinalizeHook is called once blk is finalized, with the post-state of the
+// block. The state is a protected copy: changes made to it are discarded, and
+// it must not be used once the hook returns. Hooks run on the block
+// finalization path, so they must return quickly and leave heavy work to a
+// service of the plugin.
+type FinalizeHook func(ctx context.Context, blk *ctypes.BeaconBlock, st *statedb.StateDB) error
+
+// namedHook is a finalize hook along with the name of its plugin.
+type namedHook struct {
+	plugin string
+	hook   FinalizeHook
+}
+
+// Extensions are the services, node API routes and finalize hooks registered
+// by the plugins of a node.
+type Extensions struct {
+	logger log.Logger
+	// plugin is the name of the plugin registering its extensions.
+	plugin   string
+	services []service.Basic
+	routes   []*handlers.RouteSet
+	hooks    []namedHook
+}
+
+// NewExtensions registers the extensions of plugins. Each plugin is given a
+// logger derived from logger.
+func NewExtensions(
+	logger *phuslu.Logger,
+	deps Dependencies,
+	plugins Plugins,
+) (*Extensions, error) {
+	ext := &Extensions{logger: logger}
+	names := make([]string, 0, len(plugins))
+	for _, p := range plugins {
+		if slices.Contains(names, p.Name()) {
+			return nil, fmt.Errorf("plugin %s registered twice", p.Name())
+		}
+		names = append(names, p.Name())
+
+		ext.plugin = p.Name()
+		deps.Logger = logger.With("plugin", p.Name())
+		if err := p.Register(deps, ext); err != nil {
+			return nil, fmt.Errorf("failed registering plugin %s: %w", p.Name(), err)
+		}
+	}
+	ext.plugin = ""
+	return ext, nil
+}
+
+// RegisterService registers a service, started after the services of the
+// node and stopped before them.
+func (e *Extensions) RegisterService(svc service.Basic) {
+	e.services = append(e.services, svc)
+}
+
+// RegisterRoutes registers routes on the node API server. The paths of the
+// routes must not collide with the ones of the node API.
+func (e *Extensions) RegisterRoutes(routes *handlers.RouteSet) {
+	e.routes = append(e.routes, routes)
+}
+
+// RegisterFinalizeHook registers a hook called once a block is finalized.
+func (e *Extensions) RegisterFinalizeHook(hook FinalizeHook) {
+	e.hooks = append(e.hooks, namedHook{plugin: e.plugin, hook: hook})
+}
+
+// Services returns the services registered by the plugins.
+func (e *Extensions) Services() []service.Basic {
+	return e.services
+}
+
+// Routes returns the node API routes registered by the plugins.
+func (e *Extensions) Routes() []*handlers.RouteSet {
+	return e.routes
+}
+
+// RunFinalizeHooks runs the finalize hooks, in the order they were
+// registered, on a protected copy of st. Failing hooks are logged but never
+// fail the finalization of the block.
+func (e *Extensions) RunFinalizeHooks(ctx context.Context, blk *ctypes.BeaconBlock, st *statedb.StateDB) {
+	if len(e.hooks) == 0 {
+		return
+	}
+	protected := st.Protect(ctx)
+	for _, h := range e.hooks {
+		if err := runHook(ctx, h.hook, blk, protected); err != nil {
+			e.logger.Error(
+				"Finalize hook failed",
+				"plugin", h.plugin, "slot", blk.GetSlot(), "error", err,
+			)
+		}
+	}
+}
+
+// runHook runs hook, turning its panics into errors.
+func runHook(
+	ctx context.Context,
+	hook FinalizeHook,
+	blk *ctypes.BeaconBlock,
+	st *statedb.StateDB,
+) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return hook(ctx, blk, st)
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package plugin_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-core/plugin"
+	"github.com/stretchr/testify/require"
+)
+
+type testService struct{ name string }
+
+func (s *testService) Start(context.Context) error { return nil }
+func (s *testService) Stop() error                 { return nil }
+func (s *testService) Name() string                { return s.name }
+
+type testPlugin struct {
+	name string
+	err  error
+}
+
+func (p *testPlugin) Name() string { return p.name }
+
+func (p *testPlugin) Register(deps plugin.Dependencies, ext *plugin.Extensions) error {
+	if p.err != nil {
+		return p.err
+	}
+	deps.Logger.Info("registering")
+	ext.RegisterService(&testService{name: p.name})
+	ext.RegisterRoutes(handlers.NewRouteSet("", &handlers.Route{
+		Method: http.MethodGet,
+		Path:   "/plugins/" + p.name,
+	}))
+	return nil
+}
+
+func TestNewExtensions(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	logger := phuslu.NewLogger(&out, &phuslu.Config{Style: phuslu.StyleJSON, LogLevel: "info"})
+	ext, err := plugin.NewExtensions(logger, plugin.Dependencies{}, plugin.Plugins{
+		&testPlugin{name: "first"},
+		&testPlugin{name: "second"},
+	})
+	require.NoError(t, err)
+
+	services := ext.Services()
+	require.Len(t, services, 2)
+	require.Equal(t, "first", services[0].Name())
+	require.Equal(t, "second", services[1].Name())
+	routes := ext.Routes()
+	require.Len(t, routes, 2)
+	require.Equal(t, "/plugins/first", routes[0].Routes[0].Path)
+	require.Equal(t, "/plugins/second", routes[1].Routes[0].Path)
+	// Each plugin logs with its own logger.
+	require.Contains(t, out.String(), `"plugin":"first"`)
+	require.Contains(t, out.String(), `"plugin":"second"`)
+}
+
+func TestNewExtensionsErrors(t *testing.T) {
+	t.Parallel()
+
+	logger := phuslu.NewLogger(&bytes.Buffer{}, nil)
+	_, err := plugin.NewExtensions(logger, plugin.Dependencies{}, plugin.Plugins{
+		&testPlugin{name: "indexer"},
+		&testPlugin{name: "indexer"},
+	})
+	require.ErrorContains(t, err, "plugin indexer registered twice")
+
+	errRegister := errors.New("missing configuration")
+	_, err = plugin.NewExtensions(logger, plugin.Dependencies{}, plugin.Plugins{
+		&testPlugin{name: "indexer", err: errRegister},
+	})
+	require.ErrorIs(t, err, errRegister)
+}
+
+func TestNoPlugins(t *testing.T) {
+	t.Parallel()
+
+	ext, err := plugin.NewExtensions(phuslu.NewLogger(&bytes.Buffer{}, nil), plugin.Dependencies{}, nil)
+	require.NoError(t, err)
+	require.Empty(t, ext.Services())
+	require.Empty(t, ext.Routes())
+	// Without hooks, the state is not even accessed.
+	ext.RunFinalizeHooks(t.Context(), nil, nil)
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package plugin is the extension API of the node. A plugin runs in-process
+// with the node and extends it with services, node API routes and hooks run
+// once a block is finalized, without forking the list of components the node
+// is built from:
+//
+//	nb := nodebuilder.New(
+//		nodebuilder.WithComponents(components.DefaultComponents()),
+//		nodebuilder.WithPlugins(indexer.New()),
+//	)
+package plugin
+
+import (
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log"
+)
+
+// Plugin extends the node it is registered with.
+type Plugin interface {
+	// Name returns the name of the plugin, which must be unique among the
+	// plugins of the node.
+	Name() string
+	// Register registers the extensions of the plugin. It is called once,
+	// while the node is built, before any service is started.
+	Register(deps Dependencies, ext *Extensions) error
+}
+
+// Plugins are the plugins of a node.
+type Plugins []Plugin
+
+// Dependencies are the components of the node available to the plugins.
+type Dependencies struct {
+	// Logger is the logger of the plugin.
+	Logger log.Logger
+	// ChainSpec is the chain spec of the node.
+	ChainSpec chain.Spec
+	// AppOpts are the application options of the node, from which plugins
+	// may read their own configuration.
+	AppOpts config.AppOptions
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// bytesPerMB is the number of bytes in a MiB.
+const bytesPerMB = 1 << 20
+
+// DirWritable returns a check failing if a file cannot be written to dir. The
+// directory is created if missing, as stores create theirs lazily.
+func DirWritable(name, dir string) Check {
+	return Check{
+		Name: name,
+		Run: func(context.Context) error {
+			if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+				return fmt.Errorf("directory %s is not writable: %w", dir, err)
+			}
+			f, err := os.CreateTemp(dir, ".health-*")
+			if err != nil {
+				return fmt.Errorf("directory %s is not writable: %w", dir, err)
+			}
+			_, err = f.Write([]byte("ok"))
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if removeErr := os.Remove(f.Name()); err == nil {
+				err = removeErr
+			}
+			if err != nil {
+				return fmt.Errorf("directory %s is not writable: %w", dir, err)
+			}
+			return nil
+		},
+	}
+}
+
+// FreeDiskSpace returns a check failing if the file system of dir has less
+// than minFreeMB MiB available.
+func FreeDiskSpace(name, dir string, minFreeMB uint64) Check {
+	return Check{
+		Name: name,
+		Run: func(context.Context) error {
+			free, err := freeDiskSpace(dir)
+			if err != nil {
+				return fmt.Errorf("failed reading free disk space of %s: %w", dir, err)
+			}
+			if free < minFreeMB*bytesPerMB {
+				return fmt.Errorf(
+					"%d MiB free on the disk of %s, less than %d MiB",
+					free/bytesPerMB, dir, minFreeMB,
+				)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health
+
+import "time"
+
+const (
+	// defaultCheckTimeout is the default time each check is given to run.
+	defaultCheckTimeout = 2 * time.Second
+	// defaultCacheTTL is the default time a health report is served for.
+	defaultCacheTTL = 5 * time.Second
+	// defaultMaxFinalizedBlockAge is the default time after which a node
+	// which has not finalized a block is reported unhealthy.
+	defaultMaxFinalizedBlockAge = time.Minute
+	// defaultMinFreeDiskSpaceMB is the default free disk space, in MiB,
+	// under which the node is reported unhealthy.
+	defaultMinFreeDiskSpaceMB = 1024
+)
+
+// Config is the configuration for the health checks of the node.
+type Config struct {
+	// CheckTimeout is the time each check is given to run.
+	CheckTimeout time.Duration `mapstructure:"check-timeout"`
+	// CacheTTL is the time a health report is served for before the checks
+	// run again. Zero disables caching.
+	CacheTTL time.Duration `mapstructure:"cache-ttl"`
+	// MaxFinalizedBlockAge is the time after which a synced node which has
+	// not finalized a block is reported unhealthy. Zero disables the check.
+	MaxFinalizedBlockAge time.Duration `mapstructure:"max-finalized-block-age"`
+	// MinFreeDiskSpaceMB is the free disk space, in MiB, of the node home
+	// directory under which the node is reported unhealthy. Zero disables
+	// the check.
+	MinFreeDiskSpaceMB uint64 `mapstructure:"min-free-disk-space-mb"`
+}
+
+// DefaultConfig returns the default health configuration.
+func DefaultConfig() Config {
+	return Config{
+		CheckTimeout:         defaultCheckTimeout,
+		CacheTTL:             defaultCacheTTL,
+		MaxFinalizedBlockAge: defaultMaxFinalizedBlockAge,
+		MinFreeDiskSpaceMB:   defaultMinFreeDiskSpaceMB,
+	}
+}
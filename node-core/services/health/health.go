@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrSyncing is wrapped by the errors of the checks of the components which
+// are still syncing. The node is then reported syncing rather than unhealthy.
+var ErrSyncing = errors.New("syncing")
+
+// Status is the health status of a check or of the node.
+type Status string
+
+const (
+	// StatusHealthy reports a check passing.
+	StatusHealthy Status = "healthy"
+	// StatusSyncing reports a component still syncing.
+	StatusSyncing Status = "syncing"
+	// StatusUnhealthy reports a check failing.
+	StatusUnhealthy Status = "unhealthy"
+)
+
+// Check is a named health check of a component of the node.
+type Check struct {
+	// Name identifies the check in the health report.
+	Name string
+	// Run returns nil if the component is healthy, an error wrapping
+	// ErrSyncing if it is syncing and any other error if it is unhealthy.
+	Run func(ctx context.Context) error
+}
+
+// Checker is implemented by the services reporting their health.
+type Checker interface {
+	// HealthChecks returns the health checks of the service.
+	HealthChecks() []Check
+}
+
+// CheckResult is the outcome of a check.
+type CheckResult struct {
+	Name   string `json:"name"`
+	Status Status `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report is the outcome of all the checks of the node. The node is unhealthy
+// if any check is, else syncing if any check is.
+type Report struct {
+	Status Status        `json:"status"`
+	Checks []CheckResult `json:"checks"`
+}
@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Monitor runs the registered health checks of the node.
+type Monitor struct {
+	timeout  time.Duration
+	cacheTTL time.Duration
+
+	mu     sync.RWMutex
+	checks []Check
+
+	// muReport serializes the runs of the checks and protects the cached
+	// report.
+	muReport   sync.Mutex
+	report     *Report
+	reportedAt time.Time
+}
+
+// NewMonitor creates a monitor giving each check timeout to run, and serving
+// the report of the checks for cacheTTL. Zero disables caching.
+func NewMonitor(timeout, cacheTTL time.Duration) *Monitor {
+	return &Monitor{timeout: timeout, cacheTTL: cacheTTL}
+}
+
+// Register adds checks to the monitor.
+func (m *Monitor) Register(checks ...Check) {
+	m.mu.Lock()
+	m.checks = append(m.checks, checks...)
+	m.mu.Unlock()
+
+	// Drop the cached report, which lacks the new checks.
+	m.muReport.Lock()
+	m.report = nil
+	m.muReport.Unlock()
+}
+
+// Check reports the outcome of the checks, in the order they were registered.
+// The checks run concurrently, at most once per cache TTL: callers within the
+// TTL of the last run are served its report, and concurrent callers share the
+// same run.
+func (m *Monitor) Check(ctx context.Context) Report {
+	m.muReport.Lock()
+	defer m.muReport.Unlock()
+	if m.report != nil && time.Since(m.reportedAt) < m.cacheTTL {
+		return *m.report
+	}
+
+	// The report is shared with other callers, hence the checks must not be
+	// canceled along with the caller. They remain bounded by the timeout.
+	report := m.runChecks(context.WithoutCancel(ctx))
+	if m.cacheTTL > 0 {
+		m.report, m.reportedAt = &report, time.Now()
+	}
+	return report
+}
+
+// runChecks runs all the checks concurrently and reports their outcome.
+func (m *Monitor) runChecks(ctx context.Context) Report {
+	m.mu.RLock()
+	checks := m.checks
+	m.mu.RUnlock()
+
+	results := make([]CheckResult, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = m.run(ctx, check)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusHealthy, Checks: results}
+	for _, result := range results {
+		switch {
+		case result.Status == StatusUnhealthy:
+			report.Status = StatusUnhealthy
+		case result.Status == StatusSyncing && report.Status == StatusHealthy:
+			report.Status = StatusSyncing
+		}
+	}
+	return report
+}
+
+// run runs check within the timeout of the monitor.
+func (m *Monitor) run(ctx context.Context, check Check) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- check.Run(ctx) }()
+	var err error
+	select {
+	case err = <-errCh:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	result := CheckResult{Name: check.Name, Status: StatusHealthy}
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrSyncing):
+		result.Status, result.Error = StatusSyncing, err.Error()
+	default:
+		result.Status, result.Error = StatusUnhealthy, err.Error()
+	}
+	return result
+}
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/node-core/services/health"
+	"github.com/stretchr/testify/require"
+)
+
+var errTest = errors.New("test error")
+
+func check(name string, err error) health.Check {
+	return health.Check{
+		Name: name,
+		Run:  func(context.Context) error { return err },
+	}
+}
+
+func TestMonitorCheck(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		checks   []health.Check
+		expected health.Status
+	}{
+		{
+			name:     "no checks",
+			expected: health.StatusHealthy,
+		},
+		{
+			name:     "all healthy",
+			checks:   []health.Check{check("a", nil), check("b", nil)},
+			expected: health.StatusHealthy,
+		},
+		{
+			name: "syncing",
+			checks: []health.Check{
+				check("a", nil),
+				check("b", fmt.Errorf("%w: 10 blocks behind", health.ErrSyncing)),
+			},
+			expected: health.StatusSyncing,
+		},
+		{
+			name: "unhealthy takes precedence over syncing",
+			checks: []health.Check{
+				check("a", errTest),
+				check("b", fmt.Errorf("%w: 10 blocks behind", health.ErrSyncing)),
+			},
+			expected: health.StatusUnhealthy,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			m := health.NewMonitor(time.Second, 0)
+			m.Register(tc.checks...)
+
+			report := m.Check(t.Context())
+			require.Equal(t, tc.expected, report.Status)
+			require.Len(t, report.Checks, len(tc.checks))
+			for i, result := range report.Checks {
+				require.Equal(t, tc.checks[i].Name, result.Name)
+			}
+		})
+	}
+}
+
+func TestMonitorCheckTimeout(t *testing.T) {
+	t.Parallel()
+	m := health.NewMonitor(10*time.Millisecond, 0)
+	m.Register(health.Check{
+		Name: "stuck",
+		Run: func(context.Context) error {
+			time.Sleep(time.Second)
+			return nil
+		},
+	})
+
+	report := m.Check(t.Context())
+	require.Equal(t, health.StatusUnhealthy, report.Status)
+	require.Equal(t, context.DeadlineExceeded.Error(), report.Checks[0].Error)
+}
+
+func TestMonitorCheckCache(t *testing.T) {
+	t.Parallel()
+	var runs atomic.Int32
+	m := health.NewMonitor(time.Second, time.Hour)
+	m.Register(health.Check{
+		Name: "counted",
+		Run: func(context.Context) error {
+			runs.Add(1)
+			return nil
+		},
+	})
+
+	// The report is served from the cache within the TTL.
+	for range 3 {
+		require.Equal(t, health.StatusHealthy, m.Check(t.Context()).Status)
+	}
+	require.Equal(t, int32(1), runs.Load())
+
+	// Registering checks invalidates the cached report.
+	m.Register(check("failing", errTest))
+	report := m.Check(t.Context())
+	require.Equal(t, health.StatusUnhealthy, report.Status)
+	require.Len(t, report.Checks, 2)
+	require.Equal(t, int32(2), runs.Load())
+
+	// A canceled caller does not poison the cached report.
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	m = health.NewMonitor(time.Second, time.Hour)
+	m.Register(health.Check{Name: "ctx", Run: func(ctx context.Context) error { return ctx.Err() }})
+	require.Equal(t, health.StatusHealthy, m.Check(ctx).Status)
+}
+
+func TestDirWritable(t *testing.T) {
+	t.Parallel()
+	dir := filepath.Join(t.TempDir(), "blobs")
+	require.NoError(t, health.DirWritable("dir", dir).Run(t.Context()))
+
+	// The probe file is removed.
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+	require.Error(t, health.DirWritable("dir", file).Run(t.Context()))
+}
+
+func TestFreeDiskSpace(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	require.NoError(t, health.FreeDiskSpace("disk", dir, 1).Run(t.Context()))
+	require.Error(t, health.FreeDiskSpace("disk", dir, math.MaxUint64>>20).Run(t.Context()))
+}
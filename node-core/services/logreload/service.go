@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package logreload
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/phuslu"
+)
+
+// Reloader applies a logger configuration while the logger is in use.
+type Reloader interface {
+	// Reload applies the configuration.
+	Reload(cfg *phuslu.Config) error
+}
+
+// Service reloads the logger configuration when the node receives SIGHUP.
+type Service struct {
+	logger   log.Logger
+	reloader Reloader
+	// read reads the current logger configuration.
+	read func() (*phuslu.Config, error)
+
+	sigc chan os.Signal
+	done chan struct{}
+}
+
+// NewService creates a new service reloading, on SIGHUP, the configuration
+// returned by read into reloader.
+func NewService(
+	logger log.Logger,
+	reloader Reloader,
+	read func() (*phuslu.Config, error),
+) *Service {
+	return &Service{
+		logger:   logger,
+		reloader: reloader,
+		read:     read,
+		sigc:     make(chan os.Signal, 1),
+		done:     make(chan struct{}),
+	}
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "log-reload"
+}
+
+// Start listens for SIGHUP.
+func (s *Service) Start(context.Context) error {
+	signal.Notify(s.sigc, syscall.SIGHUP)
+	go func() {
+		for {
+			select {
+			case <-s.sigc:
+				s.reload()
+			case <-s.done:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops listening for SIGHUP.
+func (s *Service) Stop() error {
+	signal.Stop(s.sigc)
+	close(s.done)
+	return nil
+}
+
+// reload reads and applies the logger configuration. A configuration which
+// cannot be read or applied leaves the logger untouched.
+func (s *Service) reload() {
+	cfg, err := s.read()
+	if err == nil {
+		err = s.reloader.Reload(cfg)
+	}
+	if err != nil {
+		s.logger.Error("Failed to reload logger configuration", "error", err)
+		return
+	}
+	s.logger.Info(
+		"Reloaded logger configuration",
+		"log_level", cfg.LogLevel, "module_levels", cfg.ModuleLevels,
+	)
+}
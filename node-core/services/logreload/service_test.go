@@ -0,0 +1,66 @@
+//go:build unix
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package logreload_test
+
+import (
+	"bytes"
+	"errors"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/services/logreload"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloadOnSIGHUP(t *testing.T) {
+	logger := phuslu.NewLogger(&bytes.Buffer{}, nil)
+	var reads atomic.Int32
+	s := logreload.NewService(noop.NewLogger[any](), logger, func() (*phuslu.Config, error) {
+		if reads.Add(1) > 1 {
+			return nil, errors.New("unreadable config")
+		}
+		cfg := phuslu.DefaultConfig()
+		cfg.LogLevel = "warn"
+		cfg.ModuleLevels = "blockchain=debug"
+		return &cfg, nil
+	})
+	require.NoError(t, s.Start(t.Context()))
+	defer func() { require.NoError(t, s.Stop()) }()
+
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGHUP))
+	require.Eventually(t, func() bool {
+		return logger.Level() == "warn"
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, map[string]string{"blockchain": "debug"}, logger.ModuleLevels())
+
+	// A configuration which cannot be read leaves the logger untouched.
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGHUP))
+	require.Eventually(t, func() bool {
+		return reads.Load() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, "warn", logger.Level())
+}
@@ -26,6 +26,7 @@ import (
 	"reflect"
 
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/services/health"
 )
 
 // Basic is the minimal interface for a service.
@@ -128,6 +129,18 @@ func (s *Registry) RegisterService(service Basic) error {
 	return nil
 }
 
+// HealthChecks returns the health checks of the registered services which
+// report their health, in the order the services were registered.
+func (s *Registry) HealthChecks() []health.Check {
+	var checks []health.Check
+	for _, typeName := range s.serviceTypes {
+		if checker, ok := s.services[typeName].(health.Checker); ok {
+			checks = append(checks, checker.HealthChecks()...)
+		}
+	}
+	return checks
+}
+
 // FetchService takes in a struct pointer and sets the value of that pointer
 // to a service currently stored in the service registry. This ensures the
 // input argument is set to the right pointer that refers to the originally
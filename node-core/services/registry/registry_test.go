@@ -21,11 +21,13 @@
 package service_test
 
 import (
+	"context"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/services/health"
 	service "github.com/berachain/beacon-kit/node-core/services/registry"
 	"github.com/berachain/beacon-kit/node-core/services/registry/mocks"
 	"github.com/stretchr/testify/mock"
@@ -82,3 +84,36 @@ func TestRegistry_FetchService(t *testing.T) {
 		t.Errorf("Fetched service type mismatch")
 	}
 }
+
+// checkerService is a service reporting its health.
+type checkerService struct {
+	*mocks.Basic
+	checks []health.Check
+}
+
+func (s checkerService) HealthChecks() []health.Check {
+	return s.checks
+}
+
+func TestRegistry_HealthChecks(t *testing.T) {
+	t.Parallel()
+	logger := noop.NewLogger[any]()
+	registry := service.NewRegistry(logger)
+
+	noop := func(context.Context) error { return nil }
+	service1 := checkerService{Basic: new(mocks.Basic), checks: []health.Check{{Name: "a", Run: noop}}}
+	service1.On("Name").Return("Service1")
+	service2 := new(mocks.Basic)
+	service2.On("Name").Return("Service2")
+	service3 := checkerService{Basic: new(mocks.Basic), checks: []health.Check{{Name: "b", Run: noop}, {Name: "c", Run: noop}}}
+	service3.On("Name").Return("Service3")
+	for _, svc := range []service.Basic{service1, service2, service3} {
+		require.NoError(t, registry.RegisterService(svc))
+	}
+
+	checks := registry.HealthChecks()
+	require.Len(t, checks, 3)
+	for i, name := range []string{"a", "b", "c"} {
+		require.Equal(t, name, checks[i].Name)
+	}
+}
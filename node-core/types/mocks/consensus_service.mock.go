@@ -8,6 +8,8 @@ import (
 	cometbfttypes "github.com/cometbft/cometbft/types"
 	mock "github.com/stretchr/testify/mock"
 
+	network "github.com/berachain/beacon-kit/node-core/types/network"
+
 	types "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -322,6 +324,110 @@ func (_c *ConsensusService_Name_Call) RunAndReturn(run func() string) *Consensus
 	return _c
 }
 
+// NodeIdentity provides a mock function with no fields
+func (_m *ConsensusService) NodeIdentity() (*network.NodeIdentity, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NodeIdentity")
+	}
+
+	var r0 *network.NodeIdentity
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*network.NodeIdentity, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *network.NodeIdentity); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*network.NodeIdentity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsensusService_NodeIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NodeIdentity'
+type ConsensusService_NodeIdentity_Call struct {
+	*mock.Call
+}
+
+// NodeIdentity is a helper method to define mock.On call
+func (_e *ConsensusService_Expecter) NodeIdentity() *ConsensusService_NodeIdentity_Call {
+	return &ConsensusService_NodeIdentity_Call{Call: _e.mock.On("NodeIdentity")}
+}
+
+func (_c *ConsensusService_NodeIdentity_Call) Run(run func()) *ConsensusService_NodeIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ConsensusService_NodeIdentity_Call) Return(_a0 *network.NodeIdentity, _a1 error) *ConsensusService_NodeIdentity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConsensusService_NodeIdentity_Call) RunAndReturn(run func() (*network.NodeIdentity, error)) *ConsensusService_NodeIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Peers provides a mock function with no fields
+func (_m *ConsensusService) Peers() []*network.PeerInfo {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Peers")
+	}
+
+	var r0 []*network.PeerInfo
+	if rf, ok := ret.Get(0).(func() []*network.PeerInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*network.PeerInfo)
+		}
+	}
+
+	return r0
+}
+
+// ConsensusService_Peers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Peers'
+type ConsensusService_Peers_Call struct {
+	*mock.Call
+}
+
+// Peers is a helper method to define mock.On call
+func (_e *ConsensusService_Expecter) Peers() *ConsensusService_Peers_Call {
+	return &ConsensusService_Peers_Call{Call: _e.mock.On("Peers")}
+}
+
+func (_c *ConsensusService_Peers_Call) Run(run func()) *ConsensusService_Peers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ConsensusService_Peers_Call) Return(_a0 []*network.PeerInfo) *ConsensusService_Peers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ConsensusService_Peers_Call) RunAndReturn(run func() []*network.PeerInfo) *ConsensusService_Peers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *ConsensusService) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
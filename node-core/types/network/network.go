@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package network
+
+import "time"
+
+// NodeIdentity is the identity of the node on the CometBFT p2p network.
+type NodeIdentity struct {
+	// ID is the CometBFT node ID.
+	ID string
+	// ListenAddress is the address the node accepts peer connections on.
+	ListenAddress string
+	// Moniker is the human readable name of the node.
+	Moniker string
+	// Network is the chain ID of the network.
+	Network string
+	// Version is the CometBFT version of the node.
+	Version string
+}
+
+// PeerInfo describes a peer the node is connected to.
+type PeerInfo struct {
+	// ID is the CometBFT node ID of the peer.
+	ID string
+	// RemoteAddress is the host and port of the connection to the peer.
+	RemoteAddress string
+	// ListenAddress is the address the peer accepts connections on.
+	ListenAddress string
+	// Moniker is the human readable name of the peer.
+	Moniker string
+	// Outbound reports whether the node dialed the peer.
+	Outbound bool
+	// Persistent reports whether the peer is a configured persistent peer.
+	Persistent bool
+	// ConnectedFor is the duration of the connection to the peer.
+	ConnectedFor time.Duration
+	// BytesSent and BytesReceived are the bytes exchanged with the peer.
+	BytesSent     int64
+	BytesReceived int64
+	// SendRate and ReceiveRate are the average rates, in bytes per second,
+	// of the connection to the peer.
+	SendRate    int64
+	ReceiveRate int64
+	// Latency is the round trip time last measured to the peer, zero if
+	// it was not measured yet or the peer does not answer latency probes.
+	Latency time.Duration
+}
@@ -26,6 +26,7 @@ import (
 	"cosmossdk.io/store"
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	service "github.com/berachain/beacon-kit/node-core/services/registry"
+	"github.com/berachain/beacon-kit/node-core/types/network"
 	cmttypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -62,4 +63,8 @@ type ConsensusService interface {
 	GetBlock(height int64) *cmttypes.Block
 	// GetSignedHeader returns the CometBFT signed header (header + commit) at the given height.
 	GetSignedHeader(height int64) *cmttypes.SignedHeader
+	// NodeIdentity returns the identity of the node on the p2p network.
+	NodeIdentity() (*network.NodeIdentity, error)
+	// Peers returns the peers the node is connected to.
+	Peers() []*network.PeerInfo
 }
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import "time"
+
+const (
+	// defaultAddress is the default address the debug endpoints are served
+	// on. It is bound to the loopback interface as the endpoints expose the
+	// internals of the node.
+	defaultAddress = "127.0.0.1:6060"
+	// defaultCPUProfileDuration is the default duration of the CPU profiles
+	// captured after a slow block.
+	defaultCPUProfileDuration = 10 * time.Second
+	// defaultProfileCooldown is the default minimum time between two
+	// captures.
+	defaultProfileCooldown = 5 * time.Minute
+)
+
+// Config is the configuration for the runtime debug endpoints and profiling.
+type Config struct {
+	// Enabled enables serving the debug endpoints on Address.
+	Enabled bool `mapstructure:"enabled"`
+	// Address is the address the debug endpoints listen on.
+	Address string `mapstructure:"address"`
+	// SlowBlockThreshold is the FinalizeBlock duration above which heap and
+	// CPU profiles are captured. Zero disables the captures.
+	SlowBlockThreshold time.Duration `mapstructure:"slow-block-threshold"`
+	// CPUProfileDuration is the duration of the CPU profiles captured after
+	// a slow block.
+	CPUProfileDuration time.Duration `mapstructure:"cpu-profile-duration"`
+	// ProfileCooldown is the minimum time between two captures, bounding the
+	// disk space used by the profiles when blocks are consistently slow.
+	ProfileCooldown time.Duration `mapstructure:"profile-cooldown"`
+}
+
+// DefaultConfig returns the default debug configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:            false,
+		Address:            defaultAddress,
+		SlowBlockThreshold: 0,
+		CPUProfileDuration: defaultCPUProfileDuration,
+		ProfileCooldown:    defaultProfileCooldown,
+	}
+}
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"sync/atomic"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+)
+
+// Profiler captures heap and CPU profiles when a block takes longer than the
+// configured threshold to finalize.
+type Profiler struct {
+	cfg    *Config
+	logger log.Logger
+	// dir is the directory the profiles are written to.
+	dir string
+
+	// ctx cancels the in-flight CPU capture on shutdown.
+	ctx    context.Context
+	cancel context.CancelFunc
+	// capturing is set while a capture is in flight.
+	capturing atomic.Bool
+	// lastCapture is the unix time, in nanoseconds, of the last capture.
+	lastCapture atomic.Int64
+}
+
+// NewProfiler creates a new profiler writing its profiles to dir.
+func NewProfiler(cfg *Config, logger log.Logger, dir string) *Profiler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Profiler{
+		cfg:    cfg,
+		logger: logger,
+		dir:    dir,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// ObserveFinalizeBlock captures the heap and CPU profiles of the node, in the
+// background, if the block at height took longer than the threshold to
+// finalize. Captures are skipped while another one is in flight or cooling
+// down.
+func (p *Profiler) ObserveFinalizeBlock(height int64, elapsed time.Duration) {
+	if p.cfg.SlowBlockThreshold <= 0 || elapsed < p.cfg.SlowBlockThreshold {
+		return
+	}
+	now := time.Now()
+	if now.Sub(time.Unix(0, p.lastCapture.Load())) < p.cfg.ProfileCooldown {
+		return
+	}
+	if !p.capturing.CompareAndSwap(false, true) {
+		return
+	}
+	p.lastCapture.Store(now.UnixNano())
+
+	p.logger.Warn(
+		"Slow block, capturing profiles",
+		"height", height, "elapsed", elapsed, "dir", p.dir,
+	)
+	go func() {
+		defer p.capturing.Store(false)
+		if err := p.capture(height, now); err != nil {
+			p.logger.Error("Failed to capture profiles", "height", height, "error", err)
+		}
+	}()
+}
+
+// Stop cancels the in-flight capture, if any.
+func (p *Profiler) Stop() {
+	p.cancel()
+}
+
+// capture writes the heap profile, then profiles the CPU for the configured
+// duration.
+func (p *Profiler) capture(height int64, at time.Time) error {
+	if err := os.MkdirAll(p.dir, 0o750); err != nil {
+		return err
+	}
+	prefix := fmt.Sprintf("%d-%d", height, at.Unix())
+
+	heapPath, err := p.writeProfile("heap-"+prefix+".pb.gz", pprof.WriteHeapProfile)
+	if err != nil {
+		return fmt.Errorf("heap profile: %w", err)
+	}
+	cpuPath, err := p.writeProfile("cpu-"+prefix+".pb.gz", p.profileCPU)
+	if err != nil {
+		return fmt.Errorf("cpu profile: %w", err)
+	}
+	p.logger.Info("Captured profiles", "height", height, "heap", heapPath, "cpu", cpuPath)
+	return nil
+}
+
+// profileCPU profiles the CPU to w for the configured duration, or until the
+// profiler is stopped.
+func (p *Profiler) profileCPU(w io.Writer) error {
+	if err := pprof.StartCPUProfile(w); err != nil {
+		return err
+	}
+	defer pprof.StopCPUProfile()
+
+	timer := time.NewTimer(p.cfg.CPUProfileDuration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-p.ctx.Done():
+	}
+	return nil
+}
+
+// writeProfile creates the profile file name in the profiles directory and
+// writes it with write, returning its path.
+func (p *Profiler) writeProfile(name string, write func(io.Writer) error) (string, error) {
+	path := filepath.Join(p.dir, name)
+	//#nosec:G304 // the path is built from the configured directory.
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	if err = write(f); err != nil {
+		_ = f.Close()
+		return "", err
+	}
+	return path, f.Close()
+}
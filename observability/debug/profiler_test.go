@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/observability/debug"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfilerCapturesSlowBlocks(t *testing.T) {
+	t.Parallel()
+	cfg := debug.DefaultConfig()
+	cfg.SlowBlockThreshold = time.Second
+	cfg.CPUProfileDuration = 10 * time.Millisecond
+	dir := filepath.Join(t.TempDir(), "profiles")
+	p := debug.NewProfiler(&cfg, noop.NewLogger[any](), dir)
+	defer p.Stop()
+
+	// Fast blocks are not profiled.
+	p.ObserveFinalizeBlock(1, 10*time.Millisecond)
+	_, err := os.Stat(dir)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	p.ObserveFinalizeBlock(2, 2*time.Second)
+	require.Eventually(t, func() bool {
+		heap, _ := filepath.Glob(filepath.Join(dir, "heap-2-*.pb.gz"))
+		cpu, _ := filepath.Glob(filepath.Join(dir, "cpu-2-*.pb.gz"))
+		return len(heap) == 1 && len(cpu) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Slow blocks within the cooldown are not profiled again.
+	p.ObserveFinalizeBlock(3, 2*time.Second)
+	time.Sleep(50 * time.Millisecond)
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+}
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"strconv"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+)
+
+const (
+	// readHeaderTimeout bounds the time to read the headers of a request.
+	readHeaderTimeout = 5 * time.Second
+	// shutdownTimeout bounds the completion of the in-flight requests on
+	// shutdown.
+	shutdownTimeout = 5 * time.Second
+	// goroutineDumpDebug is the pprof debug level of the goroutine dumps,
+	// which prints the stack of every goroutine as a panic would.
+	goroutineDumpDebug = 2
+)
+
+// LevelSetter reads and changes the log levels of the node at runtime.
+type LevelSetter interface {
+	// Level returns the global log level.
+	Level() string
+	// SetLevel sets the global log level.
+	SetLevel(level string) error
+	// ModuleLevels returns the log levels set per module.
+	ModuleLevels() map[string]string
+	// SetModuleLevel sets the log level of a module, or unsets it if level
+	// is empty.
+	SetModuleLevel(module, level string) error
+}
+
+// Service serves the runtime debug endpoints of the node: pprof, goroutine
+// dumps and the log levels. It also captures profiles after slow blocks.
+type Service struct {
+	cfg      *Config
+	logger   log.Logger
+	levels   LevelSetter
+	profiler *Profiler
+	server   *http.Server
+}
+
+// NewService creates a new debug service. The endpoints are served once the
+// service is started.
+func NewService(
+	cfg *Config,
+	logger log.Logger,
+	levels LevelSetter,
+	profiler *Profiler,
+) *Service {
+	return &Service{
+		cfg:      cfg,
+		logger:   logger,
+		levels:   levels,
+		profiler: profiler,
+	}
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "debug"
+}
+
+// Start listens on the configured address and serves the debug endpoints.
+func (s *Service) Start(context.Context) error {
+	if !s.cfg.Enabled {
+		return nil
+	}
+	listener, err := net.Listen("tcp", s.cfg.Address)
+	if err != nil {
+		return err
+	}
+
+	s.server = &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		if err := s.server.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error("Debug server stopped", "error", err)
+		}
+	}()
+	s.logger.Warn(
+		"Serving debug endpoints, do not expose them publicly",
+		"address", listener.Addr().String(),
+	)
+	return nil
+}
+
+// Stop shuts the debug server down and cancels the in-flight profile
+// capture.
+func (s *Service) Stop() error {
+	s.profiler.Stop()
+	if s.server == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return s.server.Shutdown(ctx)
+}
+
+// ObserveFinalizeBlock captures profiles if the block took longer than the
+// configured threshold to finalize.
+func (s *Service) ObserveFinalizeBlock(height int64, elapsed time.Duration) {
+	s.profiler.ObserveFinalizeBlock(height, elapsed)
+}
+
+// Handler returns the handler of the debug endpoints.
+func (s *Service) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("GET /debug/goroutines", pprof.Handler("goroutine"))
+	mux.HandleFunc("GET /debug/log-level", s.getLogLevel)
+	mux.HandleFunc("PUT /debug/log-level", s.setLogLevel)
+	return withGoroutineDump(mux)
+}
+
+// withGoroutineDump defaults the goroutine dumps to the full stacks of every
+// goroutine.
+func withGoroutineDump(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/debug/goroutines" && !r.URL.Query().Has("debug") {
+			q := r.URL.Query()
+			q.Set("debug", strconv.Itoa(goroutineDumpDebug))
+			r.URL.RawQuery = q.Encode()
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// logLevels is the response of the log level endpoints.
+type logLevels struct {
+	Level   string            `json:"level"`
+	Modules map[string]string `json:"modules"`
+}
+
+// getLogLevel returns the global and per-module log levels.
+func (s *Service) getLogLevel(w http.ResponseWriter, _ *http.Request) {
+	s.writeLevels(w)
+}
+
+// setLogLevel sets the log level given by the level query parameter, for the
+// module query parameter if given, and globally otherwise. An empty level
+// unsets the level of the module.
+func (s *Service) setLogLevel(w http.ResponseWriter, r *http.Request) {
+	module, level := r.URL.Query().Get("module"), r.URL.Query().Get("level")
+	var err error
+	switch {
+	case module != "":
+		err = s.levels.SetModuleLevel(module, level)
+	case level != "":
+		err = s.levels.SetLevel(level)
+	default:
+		err = errors.New("missing level")
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.logger.Info("Log level changed", "module", module, "level", level)
+	s.writeLevels(w)
+}
+
+// writeLevels writes the current log levels.
+func (s *Service) writeLevels(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(logLevels{
+		Level:   s.levels.Level(),
+		Modules: s.levels.ModuleLevels(),
+	}); err != nil {
+		s.logger.Error("Failed to write log levels", "error", err)
+	}
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/observability/debug"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestService(t *testing.T) (*debug.Service, *phuslu.Logger) {
+	t.Helper()
+	cfg := debug.DefaultConfig()
+	logger := phuslu.NewLogger(&bytes.Buffer{}, nil)
+	profiler := debug.NewProfiler(&cfg, noop.NewLogger[any](), t.TempDir())
+	return debug.NewService(&cfg, noop.NewLogger[any](), logger, profiler), logger
+}
+
+func serve(t *testing.T, s *debug.Service, method, target string) *http.Response {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+	return rec.Result()
+}
+
+func TestLogLevelEndpoint(t *testing.T) {
+	t.Parallel()
+	s, logger := newTestService(t)
+
+	resp := serve(t, s, http.MethodPut, "/debug/log-level?module=blockchain&level=debug")
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, map[string]string{"blockchain": "debug"}, logger.ModuleLevels())
+
+	resp = serve(t, s, http.MethodPut, "/debug/log-level?level=warn")
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "warn", logger.Level())
+
+	resp = serve(t, s, http.MethodGet, "/debug/log-level")
+	defer resp.Body.Close()
+	var levels struct {
+		Level   string            `json:"level"`
+		Modules map[string]string `json:"modules"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&levels))
+	require.Equal(t, "warn", levels.Level)
+	require.Equal(t, map[string]string{"blockchain": "debug"}, levels.Modules)
+
+	resp = serve(t, s, http.MethodPut, "/debug/log-level?module=blockchain")
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Empty(t, logger.ModuleLevels())
+
+	for _, target := range []string{"/debug/log-level", "/debug/log-level?level=loud"} {
+		resp = serve(t, s, http.MethodPut, target)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode, target)
+	}
+}
+
+func TestGoroutineDump(t *testing.T) {
+	t.Parallel()
+	s, _ := newTestService(t)
+
+	resp := serve(t, s, http.MethodGet, "/debug/goroutines")
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), "goroutine ")
+	require.Contains(t, string(body), "TestGoroutineDump")
+}
+
+func TestPprofIndex(t *testing.T) {
+	t.Parallel()
+	s, _ := newTestService(t)
+
+	resp := serve(t, s, http.MethodGet, "/debug/pprof/")
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestServiceDisabled(t *testing.T) {
+	t.Parallel()
+	s, _ := newTestService(t)
+	require.NoError(t, s.Start(t.Context()))
+	require.NoError(t, s.Stop())
+}
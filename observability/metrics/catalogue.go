@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics
+
+//go:generate go run ./gen -out catalogue.md
+
+// Kind is the Prometheus type of a metric.
+type Kind string
+
+const (
+	// KindCounter is a monotonically increasing counter.
+	KindCounter Kind = "counter"
+	// KindGauge is a value which can go up and down.
+	KindGauge Kind = "gauge"
+	// KindHistogram is a distribution of durations, in seconds.
+	KindHistogram Kind = "histogram"
+)
+
+// Definition describes a metric emitted through the TelemetrySink.
+type Definition struct {
+	// Key is the key the metric is emitted under.
+	Key string
+	// Kind is the Prometheus type of the metric.
+	Kind Kind
+	// Help is the description of the metric.
+	Help string
+	// Labels are the labels the metric is exported with. Labels passed to
+	// the sink which are not listed here are dropped, to keep the
+	// cardinality of the metric bounded.
+	Labels []string
+	// Buckets are the histogram buckets, in seconds. The configured duration
+	// buckets are used if empty.
+	Buckets []float64
+}
+
+// fineBuckets are the buckets of the operations expected to complete well
+// under a millisecond.
+//
+//nolint:gochecknoglobals // read-only.
+var fineBuckets = []float64{
+	0.00001, 0.000025, 0.00005, 0.0001, 0.00025, 0.0005, 0.001, 0.0025, 0.005, 0.01,
+}
+
+// Catalogue is the list of the metrics emitted by the node. Metrics emitted
+// under a key missing from the catalogue are still exported, with the labels
+// of their first emission.
+//
+//nolint:gochecknoglobals,lll // read-only.
+var Catalogue = []Definition{
+	// beacon/blockchain
+	{Key: "beacon_kit.beacon.blockchain.state_transition_duration", Kind: KindHistogram, Help: "Duration of the state transition of a finalized block."},
+	{Key: "beacon_kit.blockchain.state_root_verification_duration", Kind: KindHistogram, Help: "Duration of the state root verification of a proposed block."},
+	{Key: "beacon_kit.blockchain.optimistic_payload_build_success", Kind: KindCounter, Help: "Optimistic payload builds triggered successfully."},
+	{Key: "beacon_kit.blockchain.optimistic_payload_build_failure", Kind: KindCounter, Help: "Optimistic payload builds which failed to be triggered."},
+	{Key: "beacon_kit.blockchain.rebuild_payload_for_rejected_block_success", Kind: KindCounter, Help: "Payload rebuilds for a rejected block triggered successfully."},
+	{Key: "beacon_kit.blockchain.rebuild_payload_for_rejected_block_failure", Kind: KindCounter, Help: "Payload rebuilds for a rejected block which failed to be triggered."},
+
+	// beacon/validator
+	{Key: "beacon_kit.validator.request_block_for_proposal_duration", Kind: KindHistogram, Help: "Duration of building a block for proposal."},
+	{Key: "beacon_kit.validator.state_root_computation_duration", Kind: KindHistogram, Help: "Duration of computing the state root of a proposed block."},
+	{Key: "beacon_kit.validator.failed_to_retrieve_payload", Kind: KindCounter, Help: "Proposals for which the execution payload could not be retrieved."},
+	{Key: "beacon_kit.validator.failed_to_build_block_from_bid", Kind: KindCounter, Help: "Proposals for which the block could not be built from the relay bid."},
+	{Key: "beacon_kit.validator.payload_source", Kind: KindCounter, Help: "Proposed payloads by source.", Labels: []string{"source"}},
+	{Key: "beacon_kit.validator.proposal", Kind: KindCounter, Help: "Block proposals by outcome.", Labels: []string{"outcome"}},
+
+	// consensus/cometbft
+	{Key: "beacon_kit.runtime.prepare_proposal_duration", Kind: KindHistogram, Help: "Duration of PrepareProposal."},
+	{Key: "beacon_kit.runtime.process_proposal_duration", Kind: KindHistogram, Help: "Duration of ProcessProposal."},
+	{Key: "beacon_kit.runtime.proposal_tx_size_bytes", Kind: KindGauge, Help: "Size of the transactions of the last compressed proposal, before and after compression.", Labels: []string{"tx", "encoding"}},
+	{Key: "beacon_kit.runtime.proposal_tx_compression_ratio_percent", Kind: KindGauge, Help: "Compressed size of the transactions of the last compressed proposal, in percent of their SSZ size.", Labels: []string{"tx"}},
+	{Key: "beacon_kit.comet.query_count", Kind: KindCounter, Help: "ABCI queries by path.", Labels: []string{"path"}},
+	{Key: "beacon_kit.comet.query_duration", Kind: KindHistogram, Help: "Duration of ABCI queries by path.", Labels: []string{"path"}},
+	{Key: "beacon_kit.comet.cached_states_size_at_reset", Kind: KindGauge, Help: "Number of cached proposal states when the cache is reset."},
+
+	// da/blob
+	{Key: "beacon_kit.da.blob.factory.build_sidecar_duration", Kind: KindHistogram, Help: "Duration of building the blob sidecars of a block.", Labels: []string{"num_sidecars"}},
+	{Key: "beacon_kit.da.blob.factory.build_kzg_inclusion_proof_duration", Kind: KindHistogram, Help: "Duration of building the KZG commitment inclusion proof of a sidecar.", Buckets: fineBuckets},
+	{Key: "beacon_kit.da.blob.factory.build_block_body_proof_duration", Kind: KindHistogram, Help: "Duration of building the block body proof of a sidecar.", Buckets: fineBuckets},
+	{Key: "beacon_kit.da.blob.factory.build_commitment_proof_duration", Kind: KindHistogram, Help: "Duration of building the commitment proof of a sidecar.", Buckets: fineBuckets},
+	{Key: "beacon_kit.da.blob.processor.verify_blobs_duration", Kind: KindHistogram, Help: "Duration of verifying the blob sidecars of a block.", Labels: []string{"num_sidecars"}},
+	{Key: "beacon_kit.da.blob.processor.process_blob_duration", Kind: KindHistogram, Help: "Duration of persisting the blob sidecars of a block.", Labels: []string{"num_sidecars"}},
+	{Key: "beacon_kit.da.blob.verifier.verify_blobs_duration", Kind: KindHistogram, Help: "Duration of the full verification of the blob sidecars of a block.", Labels: []string{"num_sidecars", "kzg_implementation"}},
+	{Key: "beacon_kit.da.blob.verifier.verify_inclusion_proofs_duration", Kind: KindHistogram, Help: "Duration of verifying the inclusion proofs of the blob sidecars.", Labels: []string{"num_sidecars"}},
+	{Key: "beacon_kit.da.blob.verifier.verify_kzg_proofs_duration", Kind: KindHistogram, Help: "Duration of verifying the KZG proofs of the blob sidecars.", Labels: []string{"num_sidecars", "kzg_implementation"}},
+
+	// execution/client
+	{Key: "beacon_kit.execution.client.forkchoice_update_duration", Kind: KindHistogram, Help: "Duration of engine_forkchoiceUpdated calls."},
+	{Key: "beacon_kit.execution.client.new_payload_duration", Kind: KindHistogram, Help: "Duration of engine_newPayload calls."},
+	{Key: "beacon_kit.execution.client.get_payload_duration", Kind: KindHistogram, Help: "Duration of engine_getPayload calls."},
+	{Key: "beacon_kit.execution.client.engine_api_timeout", Kind: KindCounter, Help: "Engine API calls which timed out."},
+	{Key: "beacon_kit.execution.client.forkchoice_update_duration_timeout", Kind: KindCounter, Help: "engine_forkchoiceUpdated calls which timed out."},
+	{Key: "beacon_kit.execution.client.new_payload_duration_timeout", Kind: KindCounter, Help: "engine_newPayload calls which timed out."},
+	{Key: "beacon_kit.execution.client.get_payload_duration_timeout", Kind: KindCounter, Help: "engine_getPayload calls which timed out."},
+	{Key: "beacon_kit.execution.client.http_timeout", Kind: KindCounter, Help: "HTTP requests to the execution client which timed out."},
+	{Key: "beacon_kit.execution.client.parse_error", Kind: KindCounter, Help: "Engine API responses which could not be parsed."},
+	{Key: "beacon_kit.execution.client.invalid_request", Kind: KindCounter, Help: "Engine API invalid request errors."},
+	{Key: "beacon_kit.execution.client.method_not_found", Kind: KindCounter, Help: "Engine API method not found errors."},
+	{Key: "beacon_kit.execution.client.invalid_params", Kind: KindCounter, Help: "Engine API invalid params errors."},
+	{Key: "beacon_kit.execution.client.internal_error", Kind: KindCounter, Help: "Engine API internal errors."},
+	{Key: "beacon_kit.execution.client.unknown_payload_error", Kind: KindCounter, Help: "Engine API unknown payload errors."},
+	{Key: "beacon_kit.execution.client.invalid_forkchoice_state", Kind: KindCounter, Help: "Engine API invalid forkchoice state errors."},
+	{Key: "beacon_kit.execution.client.invalid_payload_attributes", Kind: KindCounter, Help: "Engine API invalid payload attributes errors."},
+	{Key: "beacon_kit.execution.client.request_too_large", Kind: KindCounter, Help: "Engine API request too large errors."},
+	{Key: "beacon_kit.execution.client.internal_server_error", Kind: KindCounter, Help: "Engine API HTTP internal server errors."},
+
+	// execution/engine
+	{Key: "beacon_kit.execution.engine.new_payload", Kind: KindCounter, Help: "Payloads sent to the execution client."},
+	{Key: "beacon_kit.execution.engine.new_payload_valid", Kind: KindCounter, Help: "Payloads reported valid by the execution client."},
+	{Key: "beacon_kit.execution.engine.new_payload_accepted_payload_status", Kind: KindCounter, Help: "Payloads reported accepted by the execution client."},
+	{Key: "beacon_kit.execution.engine.new_payload_syncing_payload_status", Kind: KindCounter, Help: "Payloads reported syncing by the execution client."},
+	{Key: "beacon_kit.execution.engine.new_payload_invalid_payload_status", Kind: KindCounter, Help: "Payloads reported invalid by the execution client."},
+	{Key: "beacon_kit.execution.engine.new_payload_non_fatal_error", Kind: KindCounter, Help: "Payloads which failed with a non-fatal error."},
+	{Key: "beacon_kit.execution.engine.new_payload_fatal_error", Kind: KindCounter, Help: "Payloads which failed with a fatal error."},
+	{Key: "beacon_kit.execution.engine.new_payload_undefined_error", Kind: KindCounter, Help: "Payloads which failed with an undefined error."},
+	{Key: "beacon_kit.execution.engine.forkchoice_update", Kind: KindCounter, Help: "Forkchoice updates sent to the execution client.", Labels: []string{"has_payload_attributes"}},
+	{Key: "beacon_kit.execution.engine.forkchoice_update_valid", Kind: KindCounter, Help: "Forkchoice updates reported valid by the execution client."},
+	{Key: "beacon_kit.execution.engine.forkchoice_update_syncing", Kind: KindCounter, Help: "Forkchoice updates reported syncing by the execution client."},
+	{Key: "beacon_kit.execution.engine.forkchoice_update_invalid", Kind: KindCounter, Help: "Forkchoice updates reported invalid by the execution client."},
+	{Key: "beacon_kit.execution.engine.forkchoice_update_non_fatal_error", Kind: KindCounter, Help: "Forkchoice updates which failed with a non-fatal error."},
+	{Key: "beacon_kit.execution.engine.forkchoice_update_fatal_error", Kind: KindCounter, Help: "Forkchoice updates which failed with a fatal error."},
+	{Key: "beacon_kit.execution.engine.forkchoice_update_undefined_error", Kind: KindCounter, Help: "Forkchoice updates which failed with an undefined error."},
+	{Key: "beacon_kit.execution.engine.optimistic_payload_queued", Kind: KindCounter, Help: "Payloads queued while the execution client was unavailable."},
+	{Key: "beacon_kit.execution.engine.optimistic_payload_replayed", Kind: KindCounter, Help: "Queued payloads replayed to the execution client."},
+	{Key: "beacon_kit.execution.engine.optimistic_backlog", Kind: KindGauge, Help: "Payloads queued for the execution client."},
+
+	// node-core/services/version
+	{Key: "beacon_kit.runtime.version", Kind: KindGauge, Help: "Always 1, labelled with the versions of the node and of the execution client.", Labels: []string{"version", "system", "eth_version", "eth_name"}},
+	{Key: "beacon_kit.runtime.version.reported", Kind: KindCounter, Help: "Version reports of the node.", Labels: []string{"version", "system"}},
+
+	// payload/builder
+	{Key: "beacon_kit.payload_builder.build_duration", Kind: KindHistogram, Help: "Time the execution client was given to build the retrieved payload."},
+	{Key: "beacon_kit.payload_builder.gas_used_percent", Kind: KindGauge, Help: "Gas used by the last built payload, as a percentage of its gas limit.", Labels: []string{"build_time_ms"}},
+	{Key: "beacon_kit.payload_builder.num_txs", Kind: KindGauge, Help: "Number of transactions of the last built payload.", Labels: []string{"build_time_ms"}},
+
+	// state-transition/core
+	{Key: "beacon_kit.state.block_tx_gas_used", Kind: KindGauge, Help: "Gas used by the transactions of the last processed payload."},
+	{Key: "beacon_kit.state.block_blob_gas_used", Kind: KindGauge, Help: "Blob gas used by the last processed payload."},
+	{Key: "beacon_kit.state.partial_withdrawals_enqueued", Kind: KindGauge, Help: "Partial withdrawals enqueued in the last processed block."},
+	{Key: "beacon_kit.state.payload_consensus_timestamp_diff", Kind: KindGauge, Help: "Difference, in seconds, between the payload and consensus timestamps."},
+	{Key: "beacon_kit.state.deposit_stake_lost", Kind: KindCounter, Help: "Deposits whose stake was lost."},
+	{Key: "beacon_kit.state.partial_withdrawal_request_dropped", Kind: KindCounter, Help: "Partial withdrawal requests dropped because the queue is full."},
+	{Key: "beacon_kit.state.partial_withdrawal_request_invalid", Kind: KindCounter, Help: "Invalid partial withdrawal requests."},
+	{Key: "beacon_kit.state.validator_not_withdrawable", Kind: KindCounter, Help: "Withdrawal requests of validators not yet withdrawable."},
+	{Key: "beacon_kit.statedb.partial_withdrawal_request_invalid", Kind: KindCounter, Help: "Pending partial withdrawals skipped as invalid."},
+	{Key: "beacon_kit.statedb.excess_stake_partial_withdrawal", Kind: KindCounter, Help: "Partial withdrawals of the stake in excess of the maximum effective balance."},
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteCatalogue renders the catalogue as a markdown table, in the order of
+// the catalogue.
+func WriteCatalogue(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("<!-- Code generated by observability/metrics/gen. DO NOT EDIT. -->\n\n")
+	b.WriteString("# Metrics\n\n")
+	b.WriteString("The metrics below are served by the `/metrics` endpoint of the ")
+	b.WriteString("`[beacon-kit.metrics]` listener.\n\n")
+	b.WriteString("| Name | Type | Labels | Description |\n")
+	b.WriteString("| ---- | ---- | ------ | ----------- |\n")
+	for _, def := range Catalogue {
+		labels := make([]string, len(def.Labels))
+		for i, label := range def.Labels {
+			labels[i] = "`" + label + "`"
+		}
+		fmt.Fprintf(
+			&b, "| `%s` | %s | %s | %s |\n",
+			Name(def.Key, def.Kind), def.Kind, strings.Join(labels, ", "), def.Help,
+		)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
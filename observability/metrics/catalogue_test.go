@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics_test
+
+import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/observability/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogueIsUpToDate(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	require.NoError(t, metrics.WriteCatalogue(&buf))
+	committed, err := os.ReadFile("catalogue.md")
+	require.NoError(t, err)
+	require.Equal(t, buf.String(), string(committed), "run go generate ./observability/metrics")
+}
+
+func TestCatalogueIsUnique(t *testing.T) {
+	t.Parallel()
+	names := make(map[string]string, len(metrics.Catalogue))
+	for _, def := range metrics.Catalogue {
+		name := metrics.Name(def.Key, def.Kind)
+		require.NotContains(t, names, name, "%s exported under the name of %s", def.Key, names[name])
+		names[name] = def.Key
+	}
+}
+
+// TestCatalogueCoversEmittedKeys checks that every metric key found in the
+// sources of the node is catalogued.
+func TestCatalogueCoversEmittedKeys(t *testing.T) {
+	t.Parallel()
+	catalogued := make(map[string]bool, len(metrics.Catalogue))
+	for _, def := range metrics.Catalogue {
+		catalogued[def.Key] = true
+	}
+
+	keyRe := regexp.MustCompile(`"(beacon_kit\.[a-z0-9_.]+)"`)
+	root := filepath.Join("..", "..")
+	found := 0
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != root && strings.HasPrefix(d.Name(), ".") || d.Name() == "mocks" ||
+				path == filepath.Join(root, "observability", "metrics") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		bz, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		for _, match := range keyRe.FindAllSubmatch(bz, -1) {
+			found++
+			require.True(t, catalogued[string(match[1])], "%s emitted in %s is not catalogued", match[1], path)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.NotZero(t, found)
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics
+
+const (
+	// defaultAddress is the default address the metrics are served on.
+	defaultAddress = "0.0.0.0:9464"
+)
+
+// Config is the configuration for the Prometheus metrics.
+type Config struct {
+	// Enabled enables serving the metrics on Address.
+	Enabled bool `mapstructure:"enabled"`
+	// Address is the address the /metrics endpoint listens on.
+	Address string `mapstructure:"address"`
+	// DurationBuckets are the buckets, in seconds, of the duration
+	// histograms which do not define their own buckets in the catalogue.
+	DurationBuckets []float64 `mapstructure:"duration-buckets"`
+}
+
+// DefaultConfig returns the default metrics configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled: false,
+		Address: defaultAddress,
+		DurationBuckets: []float64{
+			0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10,
+		},
+	}
+}
@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// Registry holds the Prometheus collectors of the metrics emitted by the node.
+// The metrics of the catalogue are registered upfront, the others on their
+// first emission.
+type Registry struct {
+	reg     *prometheus.Registry
+	buckets []float64
+
+	mu      sync.RWMutex
+	metrics map[string]*metric
+}
+
+// metric is the collector of a metric along with the labels it is exported
+// with.
+type metric struct {
+	kind      Kind
+	labels    []string
+	counter   *prometheus.CounterVec
+	gauge     *prometheus.GaugeVec
+	histogram *prometheus.HistogramVec
+}
+
+// NewRegistry creates a registry holding the go runtime and process
+// collectors along with the metrics of the catalogue.
+func NewRegistry(cfg *Config) (*Registry, error) {
+	r := &Registry{
+		reg:     prometheus.NewRegistry(),
+		buckets: cfg.DurationBuckets,
+		metrics: make(map[string]*metric, len(Catalogue)),
+	}
+	if err := r.reg.Register(collectors.NewGoCollector()); err != nil {
+		return nil, err
+	}
+	if err := r.reg.Register(
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	); err != nil {
+		return nil, err
+	}
+	for _, def := range Catalogue {
+		if _, err := r.register(def); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Gatherer returns the gatherer of the registered metrics.
+func (r *Registry) Gatherer() prometheus.Gatherer {
+	return r.reg
+}
+
+// IncrementCounter increments the counter emitted under key. args are
+// label name and value pairs.
+func (r *Registry) IncrementCounter(key string, args ...string) {
+	if m := r.metric(key, KindCounter, args); m != nil {
+		m.counter.WithLabelValues(m.labelValues(args)...).Inc()
+	}
+}
+
+// SetGauge sets the gauge emitted under key. args are label name and value
+// pairs.
+func (r *Registry) SetGauge(key string, value float64, args ...string) {
+	if m := r.metric(key, KindGauge, args); m != nil {
+		m.gauge.WithLabelValues(m.labelValues(args)...).Set(value)
+	}
+}
+
+// Observe records a duration, in seconds, in the histogram emitted under key.
+// args are label name and value pairs.
+func (r *Registry) Observe(key string, seconds float64, args ...string) {
+	if m := r.metric(key, KindHistogram, args); m != nil {
+		m.histogram.WithLabelValues(m.labelValues(args)...).Observe(seconds)
+	}
+}
+
+// metric returns the metric emitted under key, registering it with the
+// labels of args if it is not in the catalogue. It returns nil if the metric
+// was registered with another kind or cannot be registered.
+func (r *Registry) metric(key string, kind Kind, args []string) *metric {
+	r.mu.RLock()
+	m, found := r.metrics[key]
+	r.mu.RUnlock()
+	if !found {
+		labels := make([]string, 0, len(args)/2) //nolint:mnd // pairs.
+		for i := 0; i+1 < len(args); i += 2 {
+			labels = append(labels, args[i])
+		}
+		var err error
+		if m, err = r.register(Definition{Key: key, Kind: kind, Labels: labels}); err != nil {
+			return nil
+		}
+	}
+	if m.kind != kind {
+		return nil
+	}
+	return m
+}
+
+// register registers the collector of def, unless a metric is already
+// registered under its key.
+func (r *Registry) register(def Definition) (*metric, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if m, found := r.metrics[def.Key]; found {
+		return m, nil
+	}
+
+	m := &metric{kind: def.Kind, labels: def.Labels}
+	var collector prometheus.Collector
+	switch def.Kind {
+	case KindCounter:
+		m.counter = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: Name(def.Key, def.Kind), Help: help(def),
+		}, def.Labels)
+		collector = m.counter
+	case KindGauge:
+		m.gauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: Name(def.Key, def.Kind), Help: help(def),
+		}, def.Labels)
+		collector = m.gauge
+	case KindHistogram:
+		buckets := def.Buckets
+		if len(buckets) == 0 {
+			buckets = r.buckets
+		}
+		m.histogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: Name(def.Key, def.Kind), Help: help(def), Buckets: buckets,
+		}, def.Labels)
+		collector = m.histogram
+	}
+	if err := r.reg.Register(collector); err != nil {
+		return nil, err
+	}
+	r.metrics[def.Key] = m
+	return m, nil
+}
+
+// labelValues returns the values of the labels of the metric from the label
+// name and value pairs of args. Labels missing from args are left empty and
+// labels the metric is not exported with are dropped.
+func (m *metric) labelValues(args []string) []string {
+	values := make([]string, len(m.labels))
+	for i := 0; i+1 < len(args); i += 2 {
+		for j, label := range m.labels {
+			if label == args[i] {
+				values[j] = args[i+1]
+			}
+		}
+	}
+	return values
+}
+
+// Name returns the Prometheus name of the metric emitted under key. Counters
+// are suffixed with _total and histograms with _seconds.
+func Name(key string, kind Kind) string {
+	name := strings.NewReplacer(".", "_", "-", "_").Replace(key)
+	switch kind {
+	case KindCounter:
+		return name + "_total"
+	case KindHistogram:
+		return name + "_seconds"
+	default:
+		return name
+	}
+}
+
+// help returns the help of def, defaulting to its key for the metrics missing
+// from the catalogue.
+func help(def Definition) string {
+	if def.Help == "" {
+		return def.Key
+	}
+	return def.Help
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/observability/metrics"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+)
+
+// gather returns the metric family exported under name.
+func gather(t *testing.T, r *metrics.Registry, name string) *dto.MetricFamily {
+	t.Helper()
+	families, err := r.Gatherer().Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() == name {
+			return family
+		}
+	}
+	return nil
+}
+
+func TestRegistryCataloguedMetrics(t *testing.T) {
+	t.Parallel()
+	cfg := metrics.DefaultConfig()
+	r, err := metrics.NewRegistry(&cfg)
+	require.NoError(t, err)
+
+	// Labels missing from the catalogue are dropped.
+	r.IncrementCounter("beacon_kit.validator.proposal", "outcome", "full", "slot", "5")
+	r.IncrementCounter("beacon_kit.validator.proposal", "outcome", "full", "slot", "6")
+	family := gather(t, r, "beacon_kit_validator_proposal_total")
+	require.NotNil(t, family)
+	require.Equal(t, dto.MetricType_COUNTER, family.GetType())
+	require.Len(t, family.GetMetric(), 1)
+	m := family.GetMetric()[0]
+	require.Len(t, m.GetLabel(), 1)
+	require.Equal(t, "outcome", m.GetLabel()[0].GetName())
+	require.Equal(t, "full", m.GetLabel()[0].GetValue())
+	require.InDelta(t, 2, m.GetCounter().GetValue(), 0)
+
+	// Histograms use the configured buckets unless the catalogue sets them.
+	r.Observe("beacon_kit.runtime.process_proposal_duration", 0.2)
+	family = gather(t, r, "beacon_kit_runtime_process_proposal_duration_seconds")
+	require.NotNil(t, family)
+	h := family.GetMetric()[0].GetHistogram()
+	require.Len(t, h.GetBucket(), len(cfg.DurationBuckets))
+	require.Equal(t, uint64(1), h.GetSampleCount())
+	require.InDelta(t, 0.2, h.GetSampleSum(), 0)
+
+	r.Observe("beacon_kit.da.blob.factory.build_commitment_proof_duration", 0.00002)
+	family = gather(t, r, "beacon_kit_da_blob_factory_build_commitment_proof_duration_seconds")
+	require.NotNil(t, family)
+	require.Less(t, family.GetMetric()[0].GetHistogram().GetBucket()[0].GetUpperBound(), cfg.DurationBuckets[0])
+
+	// Emitting a catalogued metric as another kind is dropped.
+	r.SetGauge("beacon_kit.validator.proposal", 3)
+	require.Nil(t, gather(t, r, "beacon_kit_validator_proposal"))
+}
+
+func TestRegistryUncataloguedMetrics(t *testing.T) {
+	t.Parallel()
+	cfg := metrics.DefaultConfig()
+	r, err := metrics.NewRegistry(&cfg)
+	require.NoError(t, err)
+
+	// Uncatalogued metrics are exported with the labels of their first
+	// emission.
+	r.SetGauge("beacon_kit.test.gauge", 7, "a", "1")
+	r.SetGauge("beacon_kit.test.gauge", 9, "a", "2", "b", "3")
+	family := gather(t, r, "beacon_kit_test_gauge")
+	require.NotNil(t, family)
+	require.Equal(t, dto.MetricType_GAUGE, family.GetType())
+	require.Len(t, family.GetMetric(), 2)
+	for _, m := range family.GetMetric() {
+		require.Len(t, m.GetLabel(), 1)
+		require.Equal(t, "a", m.GetLabel()[0].GetName())
+	}
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	// readHeaderTimeout bounds the time to read the headers of a scrape.
+	readHeaderTimeout = 5 * time.Second
+	// shutdownTimeout bounds the completion of the in-flight scrapes on
+	// shutdown.
+	shutdownTimeout = 5 * time.Second
+)
+
+// Service serves the metrics of the registry on the /metrics endpoint.
+type Service struct {
+	cfg      *Config
+	logger   log.Logger
+	registry *Registry
+	server   *http.Server
+}
+
+// NewService creates a new metrics service. The metrics are served once the
+// service is started.
+func NewService(cfg *Config, logger log.Logger, registry *Registry) *Service {
+	return &Service{
+		cfg:      cfg,
+		logger:   logger,
+		registry: registry,
+	}
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "metrics"
+}
+
+// Start listens on the configured address and serves the metrics.
+func (s *Service) Start(context.Context) error {
+	if !s.cfg.Enabled {
+		return nil
+	}
+	listener, err := net.Listen("tcp", s.cfg.Address)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(
+		s.registry.Gatherer(),
+		promhttp.HandlerOpts{ErrorLog: errorLogger{s.logger}},
+	))
+	s.server = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		if err := s.server.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error("Metrics server stopped", "error", err)
+		}
+	}()
+	s.logger.Info("Serving metrics", "address", listener.Addr().String())
+	return nil
+}
+
+// Stop shuts the metrics server down.
+func (s *Service) Stop() error {
+	if s.server == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return s.server.Shutdown(ctx)
+}
+
+// errorLogger reports the errors of promhttp to the service logger.
+type errorLogger struct {
+	logger log.Logger
+}
+
+// Println implements promhttp.Logger.
+func (l errorLogger) Println(v ...any) {
+	l.logger.Error("Failed to serve metrics", "error", fmt.Sprint(v...))
+}
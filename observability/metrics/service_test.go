@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics_test
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/observability/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceServesMetrics(t *testing.T) {
+	t.Parallel()
+	// Reserve a free port for the service.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	cfg := metrics.DefaultConfig()
+	cfg.Enabled = true
+	cfg.Address = address
+	r, err := metrics.NewRegistry(&cfg)
+	require.NoError(t, err)
+	r.IncrementCounter("beacon_kit.comet.query_count", "path", "/store")
+
+	s := metrics.NewService(&cfg, noop.NewLogger[any](), r)
+	require.NoError(t, s.Start(t.Context()))
+	defer func() { require.NoError(t, s.Stop()) }()
+
+	resp, err := http.Get("http://" + address + "/metrics") //nolint:noctx // test.
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), `beacon_kit_comet_query_count_total{path="/store"} 1`)
+	require.Contains(t, string(body), "go_goroutines")
+}
+
+func TestServiceDisabled(t *testing.T) {
+	t.Parallel()
+	cfg := metrics.DefaultConfig()
+	r, err := metrics.NewRegistry(&cfg)
+	require.NoError(t, err)
+
+	s := metrics.NewService(&cfg, noop.NewLogger[any](), r)
+	require.NoError(t, s.Start(t.Context()))
+	require.NoError(t, s.Stop())
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing
+
+const (
+	// defaultEndpoint is the default OTLP/HTTP collector endpoint.
+	defaultEndpoint = "localhost:4318"
+	// defaultSampleRatio is the default fraction of traces sampled.
+	defaultSampleRatio = 1.0
+)
+
+// Config is the configuration for OpenTelemetry tracing.
+type Config struct {
+	// Enabled enables exporting traces.
+	Enabled bool `mapstructure:"enabled"`
+	// Endpoint is the host and port of the OTLP/HTTP collector the traces
+	// are exported to.
+	Endpoint string `mapstructure:"endpoint"`
+	// Insecure disables TLS when exporting traces.
+	Insecure bool `mapstructure:"insecure"`
+	// SampleRatio is the fraction of traces sampled, between 0 and 1.
+	SampleRatio float64 `mapstructure:"sample-ratio"`
+}
+
+// DefaultConfig returns the default tracing configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:     false,
+		Endpoint:    defaultEndpoint,
+		Insecure:    true,
+		SampleRatio: defaultSampleRatio,
+	}
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	// serviceName is the name the traces are exported under.
+	serviceName = "beacond"
+	// shutdownTimeout bounds the export of the pending spans on shutdown.
+	shutdownTimeout = 5 * time.Second
+)
+
+// Service exports the spans of the node to an OTLP collector.
+type Service struct {
+	cfg    *Config
+	logger log.Logger
+	// tp is the tracer provider exporting the spans, nil if tracing is
+	// disabled.
+	tp *sdktrace.TracerProvider
+}
+
+// NewService creates a new tracing service. The spans are exported once the
+// service is started.
+func NewService(cfg *Config, logger log.Logger, version string) (*Service, error) {
+	s := &Service{
+		cfg:    cfg,
+		logger: logger,
+	}
+	if !cfg.Enabled {
+		return s, nil
+	}
+
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	// The exporter only connects to the collector when exporting.
+	exporter, err := otlptracehttp.New(context.Background(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	s.tp = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(
+			sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio)),
+		),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", serviceName),
+			attribute.String("service.version", version),
+		)),
+	)
+	return s, nil
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "tracing"
+}
+
+// Start registers the tracer provider and the W3C trace context propagator
+// globally, so that spans started from Tracer are exported.
+func (s *Service) Start(context.Context) error {
+	if s.tp == nil {
+		return nil
+	}
+	otel.SetTracerProvider(s.tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	s.logger.Info(
+		"Exporting traces",
+		"endpoint", s.cfg.Endpoint, "sample_ratio", s.cfg.SampleRatio,
+	)
+	return nil
+}
+
+// Stop exports the pending spans and shuts the tracer provider down.
+func (s *Service) Stop() error {
+	if s.tp == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return s.tp.Shutdown(ctx)
+}
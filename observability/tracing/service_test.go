@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/observability/tracing"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// collector is an in-process OTLP/HTTP trace collector.
+type collector struct {
+	mu    sync.Mutex
+	spans []*tracepb.Span
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil || r.URL.Path != "/v1/traces" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	req := &coltracepb.ExportTraceServiceRequest{}
+	if err = proto.Unmarshal(body, req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	c.mu.Lock()
+	for _, rs := range req.GetResourceSpans() {
+		for _, ss := range rs.GetScopeSpans() {
+			c.spans = append(c.spans, ss.GetSpans()...)
+		}
+	}
+	c.mu.Unlock()
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.WriteHeader(http.StatusOK)
+}
+
+//nolint:paralleltest // sets the global tracer provider.
+func TestServiceExportsSpans(t *testing.T) {
+	c := &collector{}
+	server := httptest.NewServer(c)
+	defer server.Close()
+
+	cfg := tracing.DefaultConfig()
+	cfg.Enabled = true
+	cfg.Endpoint = strings.TrimPrefix(server.URL, "http://")
+	s, err := tracing.NewService(&cfg, noop.NewLogger[any](), "test")
+	require.NoError(t, err)
+	require.NoError(t, s.Start(t.Context()))
+
+	ctx, parent := tracing.Tracer("test").Start(t.Context(), "parent")
+	_, child := tracing.Tracer("test").Start(ctx, "child")
+	tracing.End(child, errors.New("child failed"))
+
+	// The trace context of the parent span is propagated in HTTP headers.
+	header := http.Header{}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+	require.Contains(t, header.Get("traceparent"), parent.SpanContext().TraceID().String())
+	tracing.End(parent, nil)
+
+	// Stopping the service exports the pending spans.
+	require.NoError(t, s.Stop())
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	require.Len(t, c.spans, 2)
+	names := map[string]*tracepb.Span{}
+	for _, span := range c.spans {
+		names[span.GetName()] = span
+	}
+	require.Contains(t, names, "parent")
+	require.Contains(t, names, "child")
+	require.Equal(t, names["parent"].GetSpanId(), names["child"].GetParentSpanId())
+	require.Equal(t, tracepb.Status_STATUS_CODE_ERROR, names["child"].GetStatus().GetCode())
+}
+
+func TestServiceDisabled(t *testing.T) {
+	t.Parallel()
+	cfg := tracing.DefaultConfig()
+	s, err := tracing.NewService(&cfg, noop.NewLogger[any](), "test")
+	require.NoError(t, err)
+	require.NoError(t, s.Start(t.Context()))
+	require.NoError(t, s.Stop())
+}
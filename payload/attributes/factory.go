@@ -34,37 +34,45 @@ type Factory struct {
 	chainSpec ChainSpec
 	// logger is the logger for the attributes factory.
 	logger log.Logger
-	// suggestedFeeRecipient is the suggested fee recipient sent to
+	// feeRecipients resolves the suggested fee recipient sent to
 	// the execution client for the payload build.
-	suggestedFeeRecipient common.ExecutionAddress
+	feeRecipients FeeRecipients
 }
 
 // NewAttributesFactory creates a new instance of AttributesFactory.
 func NewAttributesFactory(
 	chainSpec ChainSpec,
 	logger log.Logger,
-	suggestedFeeRecipient common.ExecutionAddress,
+	feeRecipients FeeRecipients,
 ) *Factory {
 	return &Factory{
-		chainSpec:             chainSpec,
-		logger:                logger,
-		suggestedFeeRecipient: suggestedFeeRecipient,
+		chainSpec:     chainSpec,
+		logger:        logger,
+		feeRecipients: feeRecipients,
 	}
 }
 
-// BuildPayloadAttributes creates a new instance of PayloadAttributes.
+// FeeRecipient returns the suggested fee recipient of the payloads proposed
+// by the validator with the given public key.
+func (f *Factory) FeeRecipient(proposerPubkey crypto.BLSPubkey) common.ExecutionAddress {
+	return f.feeRecipients.FeeRecipient(proposerPubkey)
+}
+
+// BuildPayloadAttributes creates a new instance of PayloadAttributes, suggesting
+// the fee recipient configured for the proposer public key.
 func (f *Factory) BuildPayloadAttributes(
 	timestamp math.U64,
 	payloadWithdrawals engineprimitives.Withdrawals,
 	prevRandao common.Bytes32,
 	prevHeadRoot common.Root,
+	proposerPubkey crypto.BLSPubkey,
 	parentProposerPubkey *crypto.BLSPubkey,
 ) (*engineprimitives.PayloadAttributes, error) {
 	return engineprimitives.NewPayloadAttributes(
 		f.chainSpec.ActiveForkVersionForTimestamp(timestamp),
 		timestamp,
 		prevRandao,
-		f.suggestedFeeRecipient,
+		f.FeeRecipient(proposerPubkey),
 		payloadWithdrawals,
 		prevHeadRoot,
 		parentProposerPubkey,
@@ -22,6 +22,7 @@ package attributes
 
 import (
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
@@ -30,3 +31,9 @@ type ChainSpec interface {
 	EpochsPerHistoricalVector() uint64
 	SlotToEpoch(slot math.Slot) math.Epoch
 }
+
+// FeeRecipients resolves the fee recipient of the payloads proposed by a
+// validator.
+type FeeRecipients interface {
+	FeeRecipient(pubkey crypto.BLSPubkey) common.ExecutionAddress
+}
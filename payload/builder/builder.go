@@ -22,8 +22,10 @@ package builder
 
 import (
 	"sync"
+	"time"
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
@@ -45,6 +47,17 @@ type PayloadBuilder struct {
 	pc PayloadCache
 	// attributesFactory is used to create attributes for the
 	attributesFactory AttributesFactory
+	// schedule provides the expected proposal time of the next block, which
+	// optimistic payload builds are timed against.
+	schedule BlockSchedule
+	// metrics is a metrics collector.
+	metrics *builderMetrics
+
+	// muBuilds protects buildStarts.
+	muBuilds sync.Mutex
+	// buildStarts holds the time each in-flight payload build was started
+	// at, to measure build time once the payload is retrieved.
+	buildStarts map[engineprimitives.PayloadID]time.Time
 
 	// latestEnvelope caches the latest verified payload, so that
 	// it can be re-issued if the verified payload is not finalized
@@ -64,6 +77,8 @@ func New(
 	ee ExecutionEngine,
 	pc PayloadCache,
 	af AttributesFactory,
+	schedule BlockSchedule,
+	ts TelemetrySink,
 ) *PayloadBuilder {
 	return &PayloadBuilder{
 		cfg:               cfg,
@@ -72,6 +87,9 @@ func New(
 		ee:                ee,
 		pc:                pc,
 		attributesFactory: af,
+		schedule:          schedule,
+		metrics:           newBuilderMetrics(ts),
+		buildStarts:       make(map[engineprimitives.PayloadID]time.Time),
 	}
 }
 
@@ -23,6 +23,7 @@ package builder
 import (
 	"time"
 
+	"github.com/berachain/beacon-kit/payload/relay"
 	"github.com/berachain/beacon-kit/primitives/common"
 )
 
@@ -30,6 +31,9 @@ const (
 	// defaultPayloadTimeout is the default value for local build
 	// payload timeout.
 	defaultPayloadTimeout = 850 * time.Millisecond
+	// defaultPayloadBuildTime is the default value for the optimistic
+	// payload build time. Zero starts building as soon as possible.
+	defaultPayloadBuildTime = 0
 )
 
 // Config is the configuration for the payload builder.
@@ -39,11 +43,26 @@ type Config struct {
 	// SuggestedFeeRecipient is the address that will receive the transaction
 	// fees produced by any blocks from this node.
 	SuggestedFeeRecipient common.ExecutionAddress `mapstructure:"suggested-fee-recipient"`
+	// FeeRecipientsFile is the path to a JSON file mapping validator public
+	// keys to the fee recipient of the blocks they propose. It takes precedence
+	// over the recipients registered through the API. Validators missing from
+	// both use SuggestedFeeRecipient. Leave empty to disable.
+	FeeRecipientsFile string `mapstructure:"fee-recipients-file"`
 	// PayloadTimeout is the timeout parameter for local build
 	// payload. This should match, or be slightly less than the configured
 	// timeout on your execution client. It also must be less than
 	// timeout_proposal in the CometBFT configuration.
 	PayloadTimeout time.Duration `mapstructure:"payload-timeout"`
+	// PayloadBuildTime is the time given to the execution client to build
+	// optimistic payloads. The forkchoice update starting the build is sent
+	// PayloadBuildTime before the expected proposal time of the next block,
+	// so that the payload includes the transactions received in the
+	// meantime. Zero starts building as soon as the previous block is
+	// verified.
+	PayloadBuildTime time.Duration `mapstructure:"payload-build-time"`
+	// Relay is the configuration of the external builder relays payloads
+	// are also sourced from.
+	Relay relay.Config `mapstructure:"relay"`
 }
 
 // DefaultConfig returns the default fork configuration.
@@ -51,6 +70,9 @@ func DefaultConfig() Config {
 	return Config{
 		Enabled:               true,
 		SuggestedFeeRecipient: common.ExecutionAddress{},
+		FeeRecipientsFile:     "",
 		PayloadTimeout:        defaultPayloadTimeout,
+		PayloadBuildTime:      defaultPayloadBuildTime,
+		Relay:                 relay.DefaultConfig(),
 	}
 }
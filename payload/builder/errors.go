@@ -36,4 +36,8 @@ var (
 
 	// ErrNilWithdrawals is returned when nil withdrawals list is received.
 	ErrNilWithdrawals = errors.New("nil withdrawals received from execution client")
+
+	// ErrSlotAlreadyScheduled is returned when an optimistic payload build
+	// is requested for a slot that a later slot has superseded.
+	ErrSlotAlreadyScheduled = errors.New("a later slot is already scheduled")
 )
@@ -22,6 +22,7 @@ package builder
 
 import (
 	"context"
+	"time"
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
@@ -44,8 +45,12 @@ type AttributesFactory interface {
 		payloadWithdrawals engineprimitives.Withdrawals,
 		prevRandao common.Bytes32,
 		prevHeadRoot common.Root,
+		proposerPubkey crypto.BLSPubkey,
 		parentProposerPubkey *crypto.BLSPubkey,
 	) (*engineprimitives.PayloadAttributes, error)
+	// FeeRecipient returns the suggested fee recipient of the payloads
+	// proposed by the validator with the given public key.
+	FeeRecipient(proposerPubkey crypto.BLSPubkey) common.ExecutionAddress
 }
 
 // ExecutionEngine is the interface for the execution engine.
@@ -63,6 +68,24 @@ type ExecutionEngine interface {
 	) (*engineprimitives.PayloadID, error)
 }
 
+// BlockSchedule publishes the expected proposal time of the next block.
+type BlockSchedule interface {
+	// Wait blocks until the expected proposal time of the block at the given
+	// height is known and returns it. It returns false if the context is
+	// done first or if a later height has been scheduled already.
+	Wait(ctx context.Context, height int64) (time.Time, bool)
+}
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+	// MeasureSince measures the time since the provided start time,
+	// identified by the provided keys.
+	MeasureSince(key string, start time.Time, args ...string)
+}
+
 type ChainSpec interface {
 	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
 	SlotToEpoch(slot math.Slot) math.Epoch
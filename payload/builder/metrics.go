@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"strconv"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+)
+
+// buildTimeBucket is the width of the build time buckets payload fullness
+// is reported by.
+const buildTimeBucket = 250 * time.Millisecond
+
+// builderMetrics is a struct that contains metrics for the payload builder.
+type builderMetrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+}
+
+// newBuilderMetrics creates a new builderMetrics.
+func newBuilderMetrics(sink TelemetrySink) *builderMetrics {
+	return &builderMetrics{
+		sink: sink,
+	}
+}
+
+// measurePayloadBuild measures the time the execution client was given to
+// build the payload, along with how full the payload is. Fullness is labeled
+// with the build time, bucketed, so that the build time can be tuned.
+func (bm *builderMetrics) measurePayloadBuild(
+	start time.Time,
+	payload *ctypes.ExecutionPayload,
+) {
+	buildTime := time.Since(start)
+	bm.sink.MeasureSince("beacon_kit.payload_builder.build_duration", start)
+
+	bucket := strconv.FormatInt((buildTime / buildTimeBucket * buildTimeBucket).Milliseconds(), 10)
+	var gasUsedPercent int64
+	if gasLimit := payload.GetGasLimit().Unwrap(); gasLimit > 0 {
+		gasUsedPercent = int64(payload.GetGasUsed().Unwrap() * 100 / gasLimit) // #nosec G115
+	}
+	bm.sink.SetGauge(
+		"beacon_kit.payload_builder.gas_used_percent",
+		gasUsedPercent,
+		"build_time_ms", bucket,
+	)
+	bm.sink.SetGauge(
+		"beacon_kit.payload_builder.num_txs",
+		int64(len(payload.GetTransactions())),
+		"build_time_ms", bucket,
+	)
+}
@@ -42,11 +42,17 @@ type RequestPayloadData struct {
 	PrevRandao           common.Bytes32
 	ParentBlockRoot      common.Root
 	FCState              engineprimitives.ForkchoiceStateV1
+	ProposerPubkey       crypto.BLSPubkey  // selects the suggested fee recipient
 	ParentProposerPubkey *crypto.BLSPubkey // nil for fork versions before Electra1
 }
 
+// buildStartsRetention is how long the start time of a payload build is kept
+// if the payload is never retrieved.
+const buildStartsRetention = time.Minute
+
 // RequestPayloadAsync builds a payload for the given slot and
-// returns the payload ID.
+// returns the payload ID. If PayloadBuildTime is set, the build is
+// started PayloadBuildTime before the expected proposal time of the slot.
 func (pb *PayloadBuilder) RequestPayloadAsync(
 	ctx context.Context,
 	r *RequestPayloadData,
@@ -54,7 +60,52 @@ func (pb *PayloadBuilder) RequestPayloadAsync(
 	if !pb.Enabled() {
 		return nil, common.Version{}, ErrPayloadBuilderDisabled
 	}
+	if err := pb.waitBuildStart(ctx, r.Slot); err != nil {
+		return nil, common.Version{}, err
+	}
+	return pb.requestPayload(ctx, r)
+}
+
+// waitBuildStart waits until PayloadBuildTime before the expected proposal
+// time of the given slot. It returns right away if PayloadBuildTime is not
+// set, and ErrSlotAlreadyScheduled if a later slot is expected already.
+//
+// Only optimistic builds are timed against the schedule: the retrieval of a
+// payload built on demand is bounded by PayloadTimeout instead, as explained
+// in RequestPayloadSync.
+func (pb *PayloadBuilder) waitBuildStart(ctx context.Context, slot math.Slot) error {
+	if pb.cfg.PayloadBuildTime <= 0 || pb.schedule == nil {
+		return nil
+	}
+	next, ok := pb.schedule.Wait(ctx, int64(slot.Unwrap())) // #nosec G115
+	if !ok {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return ErrSlotAlreadyScheduled
+	}
+
+	startIn := time.Until(next.Add(-pb.cfg.PayloadBuildTime))
+	pb.logger.Debug(
+		"Scheduling payload build",
+		"for_slot", slot.Base10(), "start_in", startIn.String(),
+	)
+	timer := time.NewTimer(startIn)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
 
+// requestPayload sends the forkchoice update starting the payload build for
+// the given slot and returns the payload ID.
+func (pb *PayloadBuilder) requestPayload(
+	ctx context.Context,
+	r *RequestPayloadData,
+) (*engineprimitives.PayloadID, common.Version, error) {
 	if payloadID, found := pb.pc.Get(r.Slot, r.ParentBlockRoot); found {
 		pb.logger.Info(
 			"aborting payload build; payload already exists in cache",
@@ -70,6 +121,7 @@ func (pb *PayloadBuilder) RequestPayloadAsync(
 		r.PayloadWithdrawals,
 		r.PrevRandao,
 		r.ParentBlockRoot,
+		r.ProposerPubkey,
 		r.ParentProposerPubkey,
 	)
 	if err != nil {
@@ -91,6 +143,7 @@ func (pb *PayloadBuilder) RequestPayloadAsync(
 	// Only add to cache if we received back a payload ID.
 	if payloadID != nil {
 		pb.pc.Set(r.Slot, r.ParentBlockRoot, *payloadID, forkVersion)
+		pb.markBuildStart(*payloadID)
 	}
 
 	return payloadID, forkVersion, nil
@@ -108,7 +161,7 @@ func (pb *PayloadBuilder) RequestPayloadSync(
 
 	// Build the payload and wait for the execution client to
 	// return the payload ID.
-	payloadID, forkVersion, err := pb.RequestPayloadAsync(ctx, r)
+	payloadID, forkVersion, err := pb.requestPayload(ctx, r)
 	if err != nil {
 		return nil, err
 	}
@@ -117,6 +170,12 @@ func (pb *PayloadBuilder) RequestPayloadSync(
 	}
 
 	// Wait for the payload to be delivered to the execution client.
+	//
+	// The wait is fixed rather than derived from the block schedule: this
+	// build is only started once consensus requests the proposal, that is at
+	// or after the proposal time computed by BlockDelay.ComputeNext, hence the
+	// schedule leaves no time to build. PayloadTimeout is the time consensus
+	// grants the proposer instead, as it is bounded by timeout_propose.
 	pb.logger.Info(
 		"Waiting for local payload to be delivered to execution client",
 		"for_slot", r.Slot.Base10(), "timeout", pb.cfg.PayloadTimeout.String(),
@@ -153,6 +212,7 @@ func (pb *PayloadBuilder) RetrievePayload(
 	slot math.Slot,
 	parentBlockRoot common.Root,
 	expectedForkVersion common.Version,
+	proposerPubkey crypto.BLSPubkey,
 ) (ctypes.BuiltExecutionPayloadEnv, error) {
 	if !pb.Enabled() {
 		return nil, ErrPayloadBuilderDisabled
@@ -205,12 +265,12 @@ func (pb *PayloadBuilder) RetrievePayload(
 
 	// Minor validations and logging below
 	payload := envelope.GetExecutionPayload()
-	if payload.GetFeeRecipient() != pb.cfg.SuggestedFeeRecipient {
+	if suggested := pb.attributesFactory.FeeRecipient(proposerPubkey); payload.GetFeeRecipient() != suggested {
 		pb.logger.Warn(
 			"Payload fee recipient does not match suggested fee recipient - "+
 				"please check both your CL and EL configuration",
 			"payload_fee_recipient", payload.GetFeeRecipient(),
-			"suggested_fee_recipient", pb.cfg.SuggestedFeeRecipient,
+			"suggested_fee_recipient", suggested,
 		)
 	}
 
@@ -229,6 +289,72 @@ func (pb *PayloadBuilder) RetrievePayload(
 	return envelope, err
 }
 
+// FallbackPayload returns a payload for the given slot after building it the
+// regular way failed, e.g. because the execution client is slow. Payloads are
+// sourced, in order, from:
+//   - the latest verified payload, if it is for the slot, which is served
+//     without calling the execution client;
+//   - the payload built optimistically for the slot, if any;
+//   - a new build, retrieved right after the forkchoice update starting it.
+//
+// The beacon node can not build a payload on its own, as only the execution
+// client can compute the state root of a payload. The new build hence still
+// depends on the execution client, which serves the payload it assembled so
+// far: the withdrawals of the request and few or no transactions, as execution
+// clients start builds from an empty block. The caller is expected to bound
+// ctx with a hard deadline, which any call to the execution client honours, so
+// that a slow execution client fails the fallback rather than delaying it.
+func (pb *PayloadBuilder) FallbackPayload(
+	ctx context.Context,
+	r *RequestPayloadData,
+) (ctypes.BuiltExecutionPayloadEnv, error) {
+	if !pb.Enabled() {
+		return nil, ErrPayloadBuilderDisabled
+	}
+
+	forkVersion := pb.chainSpec.ActiveForkVersionForTimestamp(r.Timestamp)
+	if envelope := pb.getLatestVerifiedPayload(r.Slot, forkVersion); envelope != nil {
+		pb.logger.Info("Fallback payload reused from latest verified payload", "for_slot", r.Slot.Base10())
+		return envelope, nil
+	}
+	if payloadRes, found := pb.pc.Get(r.Slot, r.ParentBlockRoot); found &&
+		version.Equals(payloadRes.ForkVersion, forkVersion) {
+		envelope, err := pb.getPayload(ctx, payloadRes.PayloadID, payloadRes.ForkVersion)
+		if err == nil {
+			pb.logger.Info("Fallback payload retrieved from optimistic build", "for_slot", r.Slot.Base10())
+			return envelope, nil
+		}
+		pb.logger.Warn(
+			"Failed retrieving optimistically built payload for fallback",
+			"for_slot", r.Slot.Base10(), "error", err,
+		)
+		if ctx.Err() != nil {
+			// No time is left for a new build.
+			return nil, fmt.Errorf("failed retrieving optimistically built payload: %w", ctx.Err())
+		}
+	}
+
+	// Drop the unusable payload ID, if any, so that a new build is started.
+	pb.pc.Delete(r.Slot, r.ParentBlockRoot)
+	payloadID, forkVersion, err := pb.requestPayload(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if payloadID == nil {
+		return nil, ErrNilPayloadID
+	}
+	envelope, err := pb.getPayload(ctx, *payloadID, forkVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed retrieving minimal payload for ID %x: %w", *payloadID, err)
+	}
+	pb.logger.Info(
+		"Fallback payload built",
+		"for_slot", r.Slot.Base10(),
+		"num_txs", len(envelope.GetExecutionPayload().GetTransactions()),
+	)
+	return envelope, nil
+}
+
 func (pb *PayloadBuilder) CacheLatestVerifiedPayload(
 	latestEnvelopeSlot math.Slot,
 	latestEnvelope ctypes.BuiltExecutionPayloadEnv,
@@ -281,5 +407,31 @@ func (pb *PayloadBuilder) getPayload(
 	if envelope.GetExecutionPayload().Withdrawals == nil {
 		return nil, ErrNilWithdrawals
 	}
+	if start, found := pb.takeBuildStart(payloadID); found {
+		pb.metrics.measurePayloadBuild(start, envelope.GetExecutionPayload())
+	}
 	return envelope, nil
 }
+
+// markBuildStart records the start time of the payload build, dropping the
+// builds whose payload was never retrieved.
+func (pb *PayloadBuilder) markBuildStart(payloadID engineprimitives.PayloadID) {
+	pb.muBuilds.Lock()
+	defer pb.muBuilds.Unlock()
+	now := time.Now()
+	for id, start := range pb.buildStarts {
+		if now.Sub(start) > buildStartsRetention {
+			delete(pb.buildStarts, id)
+		}
+	}
+	pb.buildStarts[payloadID] = now
+}
+
+// takeBuildStart returns and forgets the start time of the payload build.
+func (pb *PayloadBuilder) takeBuildStart(payloadID engineprimitives.PayloadID) (time.Time, bool) {
+	pb.muBuilds.Lock()
+	defer pb.muBuilds.Unlock()
+	start, found := pb.buildStarts[payloadID]
+	delete(pb.buildStarts, payloadID)
+	return start, found
+}
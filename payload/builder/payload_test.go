@@ -23,6 +23,7 @@ package builder_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/berachain/beacon-kit/config/spec"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
@@ -135,6 +136,8 @@ func TestRetrievePayload(t *testing.T) {
 				ee,
 				pc,
 				&stubAttributesFactory{},
+				nil,
+				stubTelemetrySink{},
 			)
 
 			if tt.cachePayloadID != nil {
@@ -145,7 +148,210 @@ func TestRetrievePayload(t *testing.T) {
 			}
 
 			//nolint:govet // shadow err so that parallel tests do not overwrite err.
-			envelope, err := pb.RetrievePayload(t.Context(), slot, parentBlockRoot, tt.expectedForkVersion)
+			envelope, err := pb.RetrievePayload(t.Context(), slot, parentBlockRoot, tt.expectedForkVersion, crypto.BLSPubkey{})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantEnvelope, envelope)
+		})
+	}
+}
+
+func TestRequestPayloadAsyncSchedule(t *testing.T) {
+	t.Parallel()
+
+	chainSpec, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+
+	const buildTime = 50 * time.Millisecond
+	newBuilder := func(ee builder.ExecutionEngine, schedule builder.BlockSchedule) *builder.PayloadBuilder {
+		return builder.New(
+			&builder.Config{Enabled: true, PayloadBuildTime: buildTime},
+			chainSpec,
+			noop.NewLogger[any](),
+			ee,
+			cache.NewPayloadIDCache(),
+			&stubAttributesFactory{attributes: &engineprimitives.PayloadAttributes{}},
+			schedule,
+			stubTelemetrySink{},
+		)
+	}
+	r := &builder.RequestPayloadData{Slot: 10, Timestamp: 1_737_381_600}
+
+	t.Run("build starts before the expected proposal time", func(t *testing.T) {
+		t.Parallel()
+		ee := &stubExecutionEngine{payloadID: &engineprimitives.PayloadID{0x01}}
+		next := time.Now().Add(3 * buildTime)
+		pb := newBuilder(ee, &stubBlockSchedule{height: 10, next: next})
+
+		payloadID, _, err := pb.RequestPayloadAsync(t.Context(), r)
+		require.NoError(t, err)
+		require.Equal(t, ee.payloadID, payloadID)
+		require.False(t, ee.fcuAt.Before(next.Add(-buildTime)))
+		require.True(t, ee.fcuAt.Before(next))
+	})
+
+	t.Run("superseded slot is not built", func(t *testing.T) {
+		t.Parallel()
+		ee := &stubExecutionEngine{payloadID: &engineprimitives.PayloadID{0x01}}
+		pb := newBuilder(ee, &stubBlockSchedule{height: 11, next: time.Now()})
+
+		_, _, err := pb.RequestPayloadAsync(t.Context(), r)
+		require.ErrorIs(t, err, builder.ErrSlotAlreadyScheduled)
+		require.True(t, ee.fcuAt.IsZero())
+	})
+}
+
+func TestFallbackPayload(t *testing.T) {
+	t.Parallel()
+
+	chainSpec, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+
+	var (
+		slot            = math.Slot(10)
+		parentBlockRoot = common.Root{0x01}
+		denebTimestamp  = math.U64(1_737_381_600) // before mainnet Deneb1ForkTime
+		r               = &builder.RequestPayloadData{
+			Slot:            slot,
+			Timestamp:       denebTimestamp,
+			ParentBlockRoot: parentBlockRoot,
+		}
+	)
+	builtEnvelope := &mockExecutionPayloadEnvelope[*engineprimitives.BlobsBundleV1]{
+		ExecutionPayload: &ctypes.ExecutionPayload{
+			Timestamp:   denebTimestamp,
+			Withdrawals: engineprimitives.Withdrawals{},
+		},
+		BlobsBundle: &engineprimitives.BlobsBundleV1{},
+	}
+	verifiedEnvelope := &mockExecutionPayloadEnvelope[*engineprimitives.BlobsBundleV1]{
+		ExecutionPayload: &ctypes.ExecutionPayload{
+			Timestamp:   denebTimestamp,
+			Withdrawals: engineprimitives.Withdrawals{},
+			GasUsed:     1,
+		},
+		BlobsBundle: &engineprimitives.BlobsBundleV1{},
+	}
+	errGetPayload := errors.New("get payload timeout")
+	const fallbackDeadline = 100 * time.Millisecond
+
+	tests := []struct {
+		name string
+
+		// If true, seed the PayloadIDCache as if the payload was built
+		// optimistically.
+		optimistic bool
+		// If true, cache the latest verified payload for the same slot.
+		verified bool
+		// Stub behaviour of the execution engine.
+		ee *stubExecutionEngine
+
+		wantEnvelope ctypes.BuiltExecutionPayloadEnv
+		// If true, a payload is expected to be built and retrieved
+		// respectively.
+		wantFCU bool
+		wantGet bool
+		wantErr error
+	}{
+		{
+			name:         "reuses the latest verified payload",
+			optimistic:   true,
+			verified:     true,
+			ee:           &stubExecutionEngine{payloadEnvToReturn: builtEnvelope},
+			wantEnvelope: verifiedEnvelope,
+		},
+		{
+			name:         "reuses the latest verified payload with a slow execution client",
+			optimistic:   true,
+			verified:     true,
+			ee:           &stubExecutionEngine{payloadEnvToReturn: builtEnvelope, delay: time.Hour},
+			wantEnvelope: verifiedEnvelope,
+		},
+		{
+			name:         "retries the optimistically built payload",
+			optimistic:   true,
+			ee:           &stubExecutionEngine{payloadEnvToReturn: builtEnvelope},
+			wantEnvelope: builtEnvelope,
+			wantGet:      true,
+		},
+		{
+			name: "builds a minimal payload",
+			ee: &stubExecutionEngine{
+				payloadEnvToReturn: builtEnvelope,
+				payloadID:          &engineprimitives.PayloadID{0x02},
+			},
+			wantEnvelope: builtEnvelope,
+			wantFCU:      true,
+			wantGet:      true,
+		},
+		{
+			name:       "fails if the minimal payload can not be retrieved",
+			optimistic: true,
+			ee: &stubExecutionEngine{
+				errToReturn: errGetPayload,
+				payloadID:   &engineprimitives.PayloadID{0x02},
+			},
+			wantFCU: true,
+			wantGet: true,
+			wantErr: errGetPayload,
+		},
+		{
+			name:       "fails within the deadline with a slow execution client",
+			optimistic: true,
+			ee: &stubExecutionEngine{
+				payloadEnvToReturn: builtEnvelope,
+				payloadID:          &engineprimitives.PayloadID{0x02},
+				delay:              time.Hour,
+			},
+			wantGet: true,
+			wantErr: context.DeadlineExceeded,
+		},
+		{
+			name: "fails within the deadline when building with a slow execution client",
+			ee: &stubExecutionEngine{
+				payloadEnvToReturn: builtEnvelope,
+				payloadID:          &engineprimitives.PayloadID{0x02},
+				delay:              time.Hour,
+			},
+			wantErr: context.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pc := cache.NewPayloadIDCache()
+			pb := builder.New(
+				&builder.Config{Enabled: true},
+				chainSpec,
+				noop.NewLogger[any](),
+				tt.ee,
+				pc,
+				&stubAttributesFactory{attributes: &engineprimitives.PayloadAttributes{}},
+				nil,
+				stubTelemetrySink{},
+			)
+			if tt.optimistic {
+				pc.Set(slot, parentBlockRoot, engineprimitives.PayloadID{0x01}, version.Deneb())
+			}
+			if tt.verified {
+				pb.CacheLatestVerifiedPayload(slot, verifiedEnvelope)
+			}
+
+			// The fallback is bounded by the deadline of the caller, however
+			// slow the execution client is.
+			ctx, cancel := context.WithTimeout(t.Context(), fallbackDeadline)
+			defer cancel()
+			start := time.Now()
+			//nolint:govet // shadow err so that parallel tests do not overwrite err.
+			envelope, err := pb.FallbackPayload(ctx, r)
+			require.Less(t, time.Since(start), time.Second)
+			require.Equal(t, tt.wantFCU, !tt.ee.fcuAt.IsZero())
+			require.Equal(t, tt.wantGet, tt.ee.getPayloadCalled)
 			if tt.wantErr != nil {
 				require.ErrorIs(t, err, tt.wantErr)
 				return
@@ -191,24 +397,80 @@ var errStubNotImplemented = errors.New("stub not implemented")
 type stubExecutionEngine struct {
 	payloadEnvToReturn ctypes.BuiltExecutionPayloadEnv
 	errToReturn        error
+	payloadID          *engineprimitives.PayloadID
+	// delay is how long every call takes, unless its context is done first.
+	delay time.Duration
+
+	fcuAt            time.Time
+	getPayloadCalled bool
 }
 
 func (ee *stubExecutionEngine) GetPayload(
-	context.Context, *ctypes.GetPayloadRequest,
+	ctx context.Context, _ *ctypes.GetPayloadRequest,
 ) (ctypes.BuiltExecutionPayloadEnv, error) {
+	ee.getPayloadCalled = true
+	if err := ee.wait(ctx); err != nil {
+		return nil, err
+	}
 	return ee.payloadEnvToReturn, ee.errToReturn
 }
 
 func (ee *stubExecutionEngine) NotifyForkchoiceUpdate(
-	context.Context, *ctypes.ForkchoiceUpdateRequest,
+	ctx context.Context, _ *ctypes.ForkchoiceUpdateRequest,
 ) (*engineprimitives.PayloadID, error) {
-	return nil, errStubNotImplemented
+	if ee.payloadID == nil {
+		return nil, errStubNotImplemented
+	}
+	if err := ee.wait(ctx); err != nil {
+		return nil, err
+	}
+	ee.fcuAt = time.Now()
+	return ee.payloadID, nil
 }
 
-type stubAttributesFactory struct{}
+// wait simulates the delay of a call to the execution client.
+func (ee *stubExecutionEngine) wait(ctx context.Context) error {
+	if ee.delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(ee.delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+type stubAttributesFactory struct {
+	attributes *engineprimitives.PayloadAttributes
+}
 
 func (ee *stubAttributesFactory) BuildPayloadAttributes(
-	math.U64, engineprimitives.Withdrawals, common.Bytes32, common.Root, *crypto.BLSPubkey,
+	math.U64, engineprimitives.Withdrawals, common.Bytes32, common.Root, crypto.BLSPubkey, *crypto.BLSPubkey,
 ) (*engineprimitives.PayloadAttributes, error) {
-	return nil, errStubNotImplemented
+	if ee.attributes == nil {
+		return nil, errStubNotImplemented
+	}
+	return ee.attributes, nil
+}
+
+func (ee *stubAttributesFactory) FeeRecipient(crypto.BLSPubkey) common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+type stubBlockSchedule struct {
+	height int64
+	next   time.Time
+}
+
+func (s *stubBlockSchedule) Wait(_ context.Context, height int64) (time.Time, bool) {
+	return s.next, s.height == height
 }
+
+type stubTelemetrySink struct{}
+
+func (stubTelemetrySink) SetGauge(string, int64, ...string) {}
+
+func (stubTelemetrySink) MeasureSince(string, time.Time, ...string) {}
@@ -0,0 +1,251 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feerecipient
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+var (
+	// ErrInvalidMapping is returned when the fee recipient mapping file holds
+	// an invalid entry.
+	ErrInvalidMapping = errors.New("invalid fee recipient mapping")
+	// ErrInvalidRegistration is returned when a persisted registration cannot
+	// be decoded.
+	ErrInvalidRegistration = errors.New("invalid fee recipient registration")
+)
+
+const (
+	// recipientSize is the size of a fee recipient.
+	recipientSize = len(common.ExecutionAddress{})
+	// registrationSize is the size of a persisted registration: the fee
+	// recipient followed by the big endian unix nanoseconds of its expiry.
+	registrationSize = recipientSize + 8
+)
+
+// Registry maps validator public keys to the fee recipient of the payloads
+// they propose. Recipients are resolved, in order of precedence, from:
+//   - the mapping file configured by the operator;
+//   - the registrations received through the prepare_beacon_proposer API,
+//     which are persisted, so that they survive restarts, until they expire;
+//   - the node-wide suggested fee recipient.
+type Registry struct {
+	// mu protects registered and db for concurrent access.
+	mu sync.RWMutex
+	// registered holds the fee recipients registered through the API.
+	registered map[crypto.BLSPubkey]registration
+	// db persists the registrations held by registered.
+	db dbm.DB
+	// closeOnce guarantees db is closed at most once.
+	closeOnce sync.Once
+	// configured holds the fee recipients read from the mapping file.
+	configured map[crypto.BLSPubkey]common.ExecutionAddress
+	// defaultRecipient is the fee recipient of any unmapped public key.
+	defaultRecipient common.ExecutionAddress
+	// ttl is how long an API registration is used for if not renewed.
+	ttl time.Duration
+}
+
+// registration is a fee recipient registered through the API.
+type registration struct {
+	recipient common.ExecutionAddress
+	expiry    time.Time
+}
+
+// NewRegistry creates a new fee recipient registry, loading the registrations
+// persisted in db which have not expired yet. Registrations received through
+// the API expire after ttl, as validator clients are expected to renew them
+// every epoch.
+func NewRegistry(
+	defaultRecipient common.ExecutionAddress,
+	configured map[crypto.BLSPubkey]common.ExecutionAddress,
+	db dbm.DB,
+	ttl time.Duration,
+) (*Registry, error) {
+	r := &Registry{
+		registered:       make(map[crypto.BLSPubkey]registration),
+		db:               db,
+		configured:       configured,
+		defaultRecipient: defaultRecipient,
+		ttl:              ttl,
+	}
+	if r.configured == nil {
+		r.configured = make(map[crypto.BLSPubkey]common.ExecutionAddress)
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// load reads the registrations persisted in the database into memory.
+func (r *Registry) load() error {
+	it, err := r.db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var pubkey crypto.BLSPubkey
+		if len(it.Key()) != len(pubkey) || len(it.Value()) != registrationSize {
+			return errors.Wrapf(ErrInvalidRegistration, "key %x", it.Key())
+		}
+		copy(pubkey[:], it.Key())
+		r.registered[pubkey] = decodeRegistration(it.Value())
+	}
+	return it.Error()
+}
+
+// Name returns the name of the service.
+func (r *Registry) Name() string {
+	return "fee-recipient-registry"
+}
+
+// Start is a no-op, registrations are loaded on creation.
+func (r *Registry) Start(context.Context) error {
+	return nil
+}
+
+// Stop closes the database of the registrations. It is safe to call multiple
+// times.
+func (r *Registry) Stop() error {
+	var err error
+	r.closeOnce.Do(func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		err = r.db.Close()
+	})
+	return err
+}
+
+// FeeRecipient returns the fee recipient of the payloads proposed by the
+// validator with the given public key.
+func (r *Registry) FeeRecipient(pubkey crypto.BLSPubkey) common.ExecutionAddress {
+	if recipient, ok := r.configured[pubkey]; ok {
+		return recipient
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if reg, ok := r.registered[pubkey]; ok && time.Now().Before(reg.expiry) {
+		return reg.recipient
+	}
+	return r.defaultRecipient
+}
+
+// SetFeeRecipient registers the fee recipient of the validator with the given
+// public key until the registration expires. It is overridden by the mapping
+// file, so that API clients cannot redirect the fees of configured validators.
+func (r *Registry) SetFeeRecipient(pubkey crypto.BLSPubkey, recipient common.ExecutionAddress) error {
+	now := time.Now()
+	reg := registration{
+		recipient: recipient,
+		expiry:    now.Add(r.ttl),
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	batch := r.db.NewBatch()
+	defer batch.Close()
+
+	// Drop the expired registrations while at it, so that the registry only
+	// grows with the validators renewing their registration.
+	var expired []crypto.BLSPubkey
+	for key, old := range r.registered {
+		if !now.Before(old.expiry) {
+			if err := batch.Delete(key[:]); err != nil {
+				return err
+			}
+			expired = append(expired, key)
+		}
+	}
+	if err := batch.Set(pubkey[:], encodeRegistration(reg)); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("failed persisting fee recipient registration: %w", err)
+	}
+
+	for _, key := range expired {
+		delete(r.registered, key)
+	}
+	r.registered[pubkey] = reg
+	return nil
+}
+
+// encodeRegistration encodes a registration to be persisted.
+func encodeRegistration(reg registration) []byte {
+	bz := make([]byte, registrationSize)
+	copy(bz, reg.recipient[:])
+	// #nosec G115 -- expiries are after the unix epoch.
+	binary.BigEndian.PutUint64(bz[recipientSize:], uint64(reg.expiry.UnixNano()))
+	return bz
+}
+
+// decodeRegistration decodes a persisted registration. The given bytes are
+// expected to be registrationSize long.
+func decodeRegistration(bz []byte) registration {
+	var reg registration
+	copy(reg.recipient[:], bz)
+	// #nosec G115 -- expiries are after the unix epoch.
+	reg.expiry = time.Unix(0, int64(binary.BigEndian.Uint64(bz[recipientSize:])))
+	return reg
+}
+
+// ReadMappingFile reads a JSON object mapping hex encoded validator public
+// keys to hex encoded fee recipients, e.g. {"0x8a2f...": "0x71c7..."}.
+func ReadMappingFile(path string) (map[crypto.BLSPubkey]common.ExecutionAddress, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading fee recipient mapping: %w", err)
+	}
+	var raw map[string]string
+	if err = json.Unmarshal(bz, &raw); err != nil {
+		return nil, fmt.Errorf("failed decoding fee recipient mapping: %w", err)
+	}
+
+	mapping := make(map[crypto.BLSPubkey]common.ExecutionAddress, len(raw))
+	for rawPubkey, rawRecipient := range raw {
+		var pubkey crypto.BLSPubkey
+		if err = pubkey.UnmarshalText([]byte(rawPubkey)); err != nil {
+			return nil, errors.Wrapf(ErrInvalidMapping, "public key %q: %v", rawPubkey, err)
+		}
+		var recipient common.ExecutionAddress
+		if err = recipient.UnmarshalText([]byte(rawRecipient)); err != nil {
+			return nil, errors.Wrapf(ErrInvalidMapping, "fee recipient %q: %v", rawRecipient, err)
+		}
+		mapping[pubkey] = recipient
+	}
+	return mapping, nil
+}
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feerecipient_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/payload/feerecipient"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+	var (
+		defaultRecipient = common.ExecutionAddress{0xde}
+		mappedKey        = crypto.BLSPubkey{0x01}
+		mappedRecipient  = common.ExecutionAddress{0x01}
+		otherKey         = crypto.BLSPubkey{0x02}
+		apiRecipient     = common.ExecutionAddress{0x0a}
+	)
+
+	r, err := feerecipient.NewRegistry(
+		defaultRecipient,
+		map[crypto.BLSPubkey]common.ExecutionAddress{mappedKey: mappedRecipient},
+		dbm.NewMemDB(),
+		time.Hour,
+	)
+	require.NoError(t, err)
+	require.Equal(t, mappedRecipient, r.FeeRecipient(mappedKey))
+	require.Equal(t, defaultRecipient, r.FeeRecipient(otherKey))
+
+	// The mapping file takes precedence over API registrations.
+	require.NoError(t, r.SetFeeRecipient(mappedKey, apiRecipient))
+	require.NoError(t, r.SetFeeRecipient(otherKey, apiRecipient))
+	require.Equal(t, mappedRecipient, r.FeeRecipient(mappedKey))
+	require.Equal(t, apiRecipient, r.FeeRecipient(otherKey))
+	require.Equal(t, defaultRecipient, r.FeeRecipient(crypto.BLSPubkey{0x03}))
+}
+
+func TestRegistryExpiry(t *testing.T) {
+	t.Parallel()
+	var (
+		defaultRecipient = common.ExecutionAddress{0xde}
+		key              = crypto.BLSPubkey{0x01}
+		apiRecipient     = common.ExecutionAddress{0x0a}
+		ttl              = 50 * time.Millisecond
+	)
+
+	r, err := feerecipient.NewRegistry(defaultRecipient, nil, dbm.NewMemDB(), ttl)
+	require.NoError(t, err)
+	require.NoError(t, r.SetFeeRecipient(key, apiRecipient))
+	require.Equal(t, apiRecipient, r.FeeRecipient(key))
+
+	// Registrations not renewed fall back to the default recipient.
+	require.Eventually(t, func() bool {
+		return r.FeeRecipient(key) == defaultRecipient
+	}, time.Second, ttl/5)
+
+	// Renewing a registration makes it effective again.
+	require.NoError(t, r.SetFeeRecipient(key, apiRecipient))
+	require.Equal(t, apiRecipient, r.FeeRecipient(key))
+}
+
+func TestRegistryPersistence(t *testing.T) {
+	t.Parallel()
+	var (
+		defaultRecipient = common.ExecutionAddress{0xde}
+		key              = crypto.BLSPubkey{0x01}
+		expiringKey      = crypto.BLSPubkey{0x02}
+		apiRecipient     = common.ExecutionAddress{0x0a}
+		ttl              = 50 * time.Millisecond
+		db               = dbm.NewMemDB()
+	)
+
+	r, err := feerecipient.NewRegistry(defaultRecipient, nil, db, ttl)
+	require.NoError(t, err)
+	require.NoError(t, r.SetFeeRecipient(expiringKey, apiRecipient))
+	require.Eventually(t, func() bool {
+		return r.FeeRecipient(expiringKey) == defaultRecipient
+	}, time.Second, ttl/5)
+
+	// Registrations expired before a restart are not used after it.
+	r, err = feerecipient.NewRegistry(defaultRecipient, nil, db, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, defaultRecipient, r.FeeRecipient(expiringKey))
+	require.NoError(t, r.SetFeeRecipient(key, apiRecipient))
+
+	// Registrations survive restarts, while expired ones are dropped from the
+	// database.
+	r, err = feerecipient.NewRegistry(defaultRecipient, nil, db, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, apiRecipient, r.FeeRecipient(key))
+	has, err := db.Has(expiringKey[:])
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, r.Stop())
+	require.NoError(t, r.Stop())
+}
+
+func TestReadMappingFile(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	pubkey := crypto.BLSPubkey{0xaa, 0xbb}
+	recipient := common.ExecutionAddress{0xcc}
+
+	valid := filepath.Join(dir, "valid.json")
+	content := `{"` + pubkey.String() + `": "` + recipient.String() + `"}`
+	require.NoError(t, os.WriteFile(valid, []byte(content), 0o600))
+	mapping, err := feerecipient.ReadMappingFile(valid)
+	require.NoError(t, err)
+	require.Equal(t, map[crypto.BLSPubkey]common.ExecutionAddress{pubkey: recipient}, mapping)
+
+	invalid := filepath.Join(dir, "invalid.json")
+	content = `{"` + pubkey.String() + `": "0x1234"}`
+	require.NoError(t, os.WriteFile(invalid, []byte(content), 0o600))
+	_, err = feerecipient.ReadMappingFile(invalid)
+	require.ErrorIs(t, err, feerecipient.ErrInvalidMapping)
+
+	_, err = feerecipient.ReadMappingFile(filepath.Join(dir, "missing.json"))
+	require.Error(t, err)
+}
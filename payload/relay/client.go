@@ -0,0 +1,464 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	sszenc "github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+const (
+	registerValidatorPath  = "/eth/v1/builder/validators"
+	getHeaderPath          = "/eth/v1/builder/header/%d/%s/%s"
+	submitBlindedBlockPath = "/eth/v1/builder/blinded_blocks"
+
+	consensusVersionHeader = "Eth-Consensus-Version"
+	octetStreamContentType = "application/octet-stream"
+	jsonContentType        = "application/json"
+
+	// percent is the denominator of the boost factor.
+	percent = 100
+)
+
+// ChainSpec defines the chain parameters required by the relay client.
+type ChainSpec interface {
+	GenesisForkVersion() common.Version
+	DomainTypeApplicationMask() common.DomainType
+}
+
+// FeeRecipients resolves the fee recipient registered for a validator.
+type FeeRecipients interface {
+	FeeRecipient(pubkey crypto.BLSPubkey) common.ExecutionAddress
+}
+
+// endpoint is a relay endpoint along with the public key the relay is
+// expected to sign its bids with.
+type endpoint struct {
+	url    string
+	pubkey crypto.BLSPubkey
+}
+
+// Client is a builder-API client sourcing execution payloads from one or more
+// external builder relays.
+type Client struct {
+	// cfg is the relay configuration.
+	cfg *Config
+	// logger is the logger for the client.
+	logger log.Logger
+	// httpClient is the client used to reach the relays.
+	httpClient *http.Client
+	// endpoints are the relays to query.
+	endpoints []endpoint
+	// signer signs validator registrations and verifies bid signatures.
+	signer crypto.BLSSigner
+	// domain is the builder domain registrations and bids are signed with.
+	domain common.Domain
+	// feeRecipients resolves the fee recipient registered with the relays.
+	feeRecipients FeeRecipients
+	// timeout bounds the relay round trip of a proposal, as well as every
+	// registration request.
+	timeout time.Duration
+}
+
+// New creates a new relay client. The given timeout is expected to be the
+// local payload timeout so that a slow relay never delays block proposals:
+// the bid and the reveal of a proposal share the deadline returned by
+// PayloadDeadline, which callers must set on the requests context.
+func New(
+	cfg *Config,
+	logger log.Logger,
+	chainSpec ChainSpec,
+	signer crypto.BLSSigner,
+	feeRecipients FeeRecipients,
+	timeout time.Duration,
+) (*Client, error) {
+	endpoints := make([]endpoint, 0, len(cfg.URLs))
+	for _, rawURL := range cfg.URLs {
+		e, err := parseEndpoint(rawURL)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, e)
+	}
+	if cfg.Enabled && len(endpoints) == 0 {
+		return nil, errors.New("relay client enabled without any relay url")
+	}
+
+	forkData := ctypes.NewForkData(chainSpec.GenesisForkVersion(), common.Root{})
+	return &Client{
+		cfg:           cfg,
+		logger:        logger,
+		httpClient:    &http.Client{},
+		endpoints:     endpoints,
+		signer:        signer,
+		domain:        forkData.ComputeDomain(chainSpec.DomainTypeApplicationMask()),
+		feeRecipients: feeRecipients,
+		timeout:       timeout,
+	}, nil
+}
+
+// parseEndpoint splits the relay public key from the relay URL. The public
+// key is required, as bids can only be authenticated against it.
+func parseEndpoint(rawURL string) (endpoint, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return endpoint{}, fmt.Errorf("invalid relay url %q: %w", rawURL, err)
+	}
+	if u.User == nil || u.User.Username() == "" {
+		return endpoint{}, fmt.Errorf("%w: %q", ErrMissingRelayPubkey, u.Host)
+	}
+	var e endpoint
+	if err = e.pubkey.UnmarshalText([]byte(u.User.Username())); err != nil {
+		return endpoint{}, fmt.Errorf("invalid relay public key in url %q: %w", u.Host, err)
+	}
+	u.User = nil
+	e.url = strings.TrimSuffix(u.String(), "/")
+	return e, nil
+}
+
+// Name returns the name of the service.
+func (c *Client) Name() string {
+	return "relay"
+}
+
+// Start starts the periodic validator registration with the relays, if the
+// client is enabled.
+func (c *Client) Start(ctx context.Context) error {
+	if !c.Enabled() {
+		return nil
+	}
+	go c.registrationLoop(ctx)
+	return nil
+}
+
+// Stop stops the client.
+func (c *Client) Stop() error {
+	return nil
+}
+
+// Enabled returns true if payloads should be sourced from the relays.
+func (c *Client) Enabled() bool {
+	return c.cfg.Enabled
+}
+
+// PayloadDeadline returns the deadline of the relay round trip of a proposal
+// started at the given time. Both the bid and the reveal of the proposal must
+// complete by then, so that the relay path is never slower than the local one.
+func (c *Client) PayloadDeadline(start time.Time) time.Time {
+	return start.Add(c.timeout)
+}
+
+// registrationLoop registers the validator with the relays right away and
+// then every registration interval, until the context is canceled.
+func (c *Client) registrationLoop(ctx context.Context) {
+	ticker := time.NewTicker(c.cfg.RegistrationInterval)
+	defer ticker.Stop()
+	for {
+		if err := c.RegisterValidator(ctx); err != nil {
+			c.logger.Warn("Failed registering validator with relays", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RegisterValidator signs a registration of this node's validator and sends
+// it to every relay.
+func (c *Client) RegisterValidator(ctx context.Context) error {
+	pubkey := c.signer.PublicKey()
+	reg := &ValidatorRegistration{
+		FeeRecipient: c.feeRecipients.FeeRecipient(pubkey),
+		GasLimit:     c.cfg.GasLimit,
+		Timestamp:    uint64(time.Now().Unix()), //#nosec:G115 // won't overflow in practice.
+		Pubkey:       pubkey,
+	}
+	signingRoot := ctypes.ComputeSigningRoot(reg, c.domain)
+	signature, err := c.signer.Sign(signingRoot[:])
+	if err != nil {
+		return fmt.Errorf("failed signing validator registration: %w", err)
+	}
+	body, err := json.Marshal([]*SignedValidatorRegistration{{Message: reg, Signature: signature}})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	errs := make([]error, len(c.endpoints))
+	var wg sync.WaitGroup
+	for i, e := range c.endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, postErr := c.do(ctx, http.MethodPost, e.url+registerValidatorPath, jsonContentType, body, nil)
+			if postErr != nil {
+				errs[i] = fmt.Errorf("relay %s: %w", e.url, postErr)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// GetHeader queries every relay for a bid for the given slot, on top of the
+// given parent execution block, and returns the most valuable bid correctly
+// signed by its relay. ErrNoBid is returned if no relay offered a valid bid.
+func (c *Client) GetHeader(
+	ctx context.Context,
+	slot math.Slot,
+	parentHash common.ExecutionHash,
+	forkVersion common.Version,
+) (*SignedBuilderBid, error) {
+	if !c.Enabled() {
+		return nil, ErrRelayDisabled
+	}
+
+	pubkey := c.signer.PublicKey()
+	bids := make([]*SignedBuilderBid, len(c.endpoints))
+	var wg sync.WaitGroup
+	for i, e := range c.endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			bid, err := c.getHeader(ctx, e, slot, parentHash, pubkey, forkVersion)
+			if err != nil {
+				c.logger.Debug("Failed retrieving bid from relay", "relay", e.url, "slot", slot.Base10(), "error", err)
+				return
+			}
+			bids[i] = bid
+		}()
+	}
+	wg.Wait()
+
+	var best *SignedBuilderBid
+	for _, bid := range bids {
+		if bid == nil {
+			continue
+		}
+		if best == nil || bid.Message.Value.Gt(best.Message.Value) {
+			best = bid
+		}
+	}
+	if best == nil {
+		return nil, ErrNoBid
+	}
+	return best, nil
+}
+
+// getHeader retrieves and verifies the bid of a single relay.
+func (c *Client) getHeader(
+	ctx context.Context,
+	e endpoint,
+	slot math.Slot,
+	parentHash common.ExecutionHash,
+	pubkey crypto.BLSPubkey,
+	forkVersion common.Version,
+) (*SignedBuilderBid, error) {
+	path := fmt.Sprintf(getHeaderPath, slot.Unwrap(), parentHash.Hex(), pubkey.String())
+	resp, err := c.do(ctx, http.MethodGet, e.url+path, "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, ErrNoBid
+	}
+	if err = checkConsensusVersion(resp, forkVersion); err != nil {
+		return nil, err
+	}
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	bid := NewEmptySignedBuilderBidWithVersion(forkVersion)
+	if err = sszenc.Unmarshal(buf, bid); err != nil {
+		return nil, err
+	}
+	if e.pubkey != bid.Message.Pubkey {
+		return nil, ErrRelayPubkeyMismatch
+	}
+	signingRoot := ctypes.ComputeSigningRoot(bid.Message, c.domain)
+	if err = c.signer.VerifySignature(e.pubkey, signingRoot[:], bid.Signature); err != nil {
+		return nil, fmt.Errorf("invalid bid signature: %w", err)
+	}
+	return bid, nil
+}
+
+// SubmitBlindedBlock submits the signed blinded block to every relay and
+// returns the first revealed payload matching the block's payload header.
+func (c *Client) SubmitBlindedBlock(
+	ctx context.Context,
+	blk *SignedBlindedBeaconBlock,
+) (*ExecutionPayloadAndBlobsBundle, error) {
+	if !c.Enabled() {
+		return nil, ErrRelayDisabled
+	}
+	body, err := blk.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+
+	forkVersion := blk.Message.GetForkVersion()
+	expectedRoot := blk.Message.Body.ExecutionPayloadHeader.HashTreeRoot()
+	results := make(chan *ExecutionPayloadAndBlobsBundle, len(c.endpoints))
+	errs := make([]error, len(c.endpoints))
+	var wg sync.WaitGroup
+	for i, e := range c.endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			revealed, submitErr := c.submitBlindedBlock(ctx, e, body, forkVersion)
+			if submitErr == nil && revealed.ExecutionPayload.HashTreeRoot() != expectedRoot {
+				submitErr = ErrPayloadMismatch
+			}
+			if submitErr != nil {
+				errs[i] = fmt.Errorf("relay %s: %w", e.url, submitErr)
+				return
+			}
+			results <- revealed
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	if revealed, ok := <-results; ok {
+		return revealed, nil
+	}
+	return nil, errors.Join(errs...)
+}
+
+// submitBlindedBlock submits the encoded blinded block to a single relay.
+func (c *Client) submitBlindedBlock(
+	ctx context.Context,
+	e endpoint,
+	body []byte,
+	forkVersion common.Version,
+) (*ExecutionPayloadAndBlobsBundle, error) {
+	headers := map[string]string{consensusVersionHeader: version.Name(forkVersion)}
+	resp, err := c.do(ctx, http.MethodPost, e.url+submitBlindedBlockPath, octetStreamContentType, body, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err = checkConsensusVersion(resp, forkVersion); err != nil {
+		return nil, err
+	}
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	revealed := NewEmptyExecutionPayloadAndBlobsBundleWithVersion(forkVersion)
+	if err = sszenc.Unmarshal(buf, revealed); err != nil {
+		return nil, err
+	}
+	return revealed, nil
+}
+
+// IsBetterThanLocal returns true if the bid, weighted by the boost factor,
+// pays strictly more than the local payload.
+func (c *Client) IsBetterThanLocal(bid *BuilderBid, localValue *math.U256) bool {
+	return IsBetterThanLocal(bid.Value, localValue, c.cfg.BoostFactor)
+}
+
+// IsBetterThanLocal returns true if bidValue * boostFactor / 100 is strictly
+// greater than localValue. A nil value counts as zero.
+func IsBetterThanLocal(bidValue, localValue *math.U256, boostFactor uint64) bool {
+	if bidValue == nil {
+		return false
+	}
+	boosted, overflow := new(math.U256).MulOverflow(bidValue, math.NewU256(boostFactor))
+	if overflow {
+		return true
+	}
+	boosted.Div(boosted, math.NewU256(percent))
+	if localValue == nil {
+		return !boosted.IsZero()
+	}
+	return boosted.Gt(localValue)
+}
+
+// do performs an HTTP request against a relay, requesting SSZ answers, and
+// returns the response if its status code is a success.
+func (c *Client) do(
+	ctx context.Context,
+	method, target, contentType string,
+	body []byte,
+	headers map[string]string,
+) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", octetStreamContentType)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512)) //nolint:mnd // enough for an error message.
+		resp.Body.Close()
+		return nil, fmt.Errorf("%w %d: %s", ErrUnexpectedStatus, resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	return resp, nil
+}
+
+// checkConsensusVersion ensures that the response, if versioned, is of the
+// expected fork version.
+func checkConsensusVersion(resp *http.Response, forkVersion common.Version) error {
+	got := resp.Header.Get(consensusVersionHeader)
+	if got != "" && !strings.EqualFold(got, version.Name(forkVersion)) {
+		return fmt.Errorf("%w: expected %s, got %s", ErrForkVersionMismatch, version.Name(forkVersion), got)
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/payload/relay"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/mocks"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// signatureBy returns the signature made by the given key in the tests, as
+// checked by the signer of newClient.
+func signatureBy(key crypto.BLSPubkey) crypto.BLSSignature {
+	var signature crypto.BLSSignature
+	copy(signature[:], key[:])
+	return signature
+}
+
+// newRelay starts a relay answering header requests with a bid of the given
+// value declaring the given key and signed by signingKey, or with no bid if
+// value is zero.
+func newRelay(t *testing.T, value uint64, relayKey, signingKey crypto.BLSPubkey) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/eth/v1/builder/header/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if value == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		header, err := testPayload(version.Electra()).ToHeader()
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		bid := relay.NewEmptySignedBuilderBidWithVersion(version.Electra())
+		bid.Message.Header = header
+		bid.Message.Value = math.NewU256(value)
+		bid.Message.Pubkey = relayKey
+		bid.Signature = signatureBy(signingKey)
+		buf, err := bid.MarshalSSZ()
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Eth-Consensus-Version", version.Name(version.Electra()))
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write(buf)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+type chainSpec struct{}
+
+func (chainSpec) GenesisForkVersion() common.Version { return version.Deneb() }
+
+func (chainSpec) DomainTypeApplicationMask() common.DomainType { return common.DomainType{0, 0, 0, 1} }
+
+type feeRecipients struct{}
+
+func (feeRecipients) FeeRecipient(crypto.BLSPubkey) common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+func newClient(t *testing.T, urls ...string) *relay.Client {
+	t.Helper()
+
+	signer := mocks.NewBlssigner(t)
+	signer.EXPECT().PublicKey().Return(crypto.BLSPubkey{0xff}).Maybe()
+	signer.EXPECT().VerifySignature(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(pubkey crypto.BLSPubkey, _ []byte, signature crypto.BLSSignature) error {
+			if signature != signatureBy(pubkey) {
+				return errors.New("invalid signature")
+			}
+			return nil
+		},
+	).Maybe()
+
+	cfg := relay.DefaultConfig()
+	cfg.Enabled = true
+	cfg.URLs = urls
+	c, err := relay.New(&cfg, noop.NewLogger[any](), chainSpec{}, signer, feeRecipients{}, time.Second)
+	require.NoError(t, err)
+	return c
+}
+
+// relayURL returns the URL of the relay carrying its public key.
+func relayURL(srv *httptest.Server, relayKey crypto.BLSPubkey) string {
+	return strings.Replace(srv.URL, "://", "://"+relayKey.String()+"@", 1)
+}
+
+func TestNewRequiresRelayPubkey(t *testing.T) {
+	t.Parallel()
+	cfg := relay.DefaultConfig()
+	cfg.Enabled = true
+	cfg.URLs = []string{
+		"https://" + crypto.BLSPubkey{0x01}.String() + "@relay.example.com",
+		"https://relay.example.com",
+	}
+	_, err := relay.New(
+		&cfg, noop.NewLogger[any](), chainSpec{}, mocks.NewBlssigner(t), feeRecipients{}, time.Second,
+	)
+	require.ErrorIs(t, err, relay.ErrMissingRelayPubkey)
+}
+
+func TestGetHeaderPicksBestBid(t *testing.T) {
+	t.Parallel()
+	var (
+		low   = newRelay(t, 100, crypto.BLSPubkey{0x01}, crypto.BLSPubkey{0x01})
+		high  = newRelay(t, 300, crypto.BLSPubkey{0x02}, crypto.BLSPubkey{0x02})
+		noBid = newRelay(t, 0, crypto.BLSPubkey{}, crypto.BLSPubkey{})
+		// The spoofed relay declares a key other than the configured one.
+		spoofed = newRelay(t, 1_000, crypto.BLSPubkey{0x03}, crypto.BLSPubkey{0x03})
+		// The forged relay declares the configured key but signs with another.
+		forged = newRelay(t, 2_000, crypto.BLSPubkey{0x05}, crypto.BLSPubkey{0x06})
+	)
+
+	c := newClient(t,
+		relayURL(low, crypto.BLSPubkey{0x01}),
+		relayURL(high, crypto.BLSPubkey{0x02}),
+		relayURL(noBid, crypto.BLSPubkey{0x07}),
+		relayURL(spoofed, crypto.BLSPubkey{0x04}),
+		relayURL(forged, crypto.BLSPubkey{0x05}),
+	)
+	bid, err := c.GetHeader(context.Background(), 1, common.ExecutionHash{}, version.Electra())
+	require.NoError(t, err)
+	require.Equal(t, math.NewU256(300), bid.Message.Value)
+	require.Equal(t, crypto.BLSPubkey{0x02}, bid.Message.Pubkey)
+}
+
+func TestGetHeaderNoBid(t *testing.T) {
+	t.Parallel()
+	noBid := newRelay(t, 0, crypto.BLSPubkey{}, crypto.BLSPubkey{})
+
+	c := newClient(t, relayURL(noBid, crypto.BLSPubkey{0x01}))
+	_, err := c.GetHeader(context.Background(), 1, common.ExecutionHash{}, version.Electra())
+	require.ErrorIs(t, err, relay.ErrNoBid)
+
+	// A relay answering for another fork is ignored too.
+	electra := newRelay(t, 100, crypto.BLSPubkey{0x01}, crypto.BLSPubkey{0x01})
+	c = newClient(t, relayURL(electra, crypto.BLSPubkey{0x01}))
+	_, err = c.GetHeader(context.Background(), 1, common.ExecutionHash{}, version.Deneb1())
+	require.ErrorIs(t, err, relay.ErrNoBid)
+}
+
+func TestGetHeaderPayloadDeadline(t *testing.T) {
+	t.Parallel()
+	block := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		<-block
+	}))
+	t.Cleanup(slow.Close)
+	t.Cleanup(func() { close(block) })
+
+	c := newClient(t, relayURL(slow, crypto.BLSPubkey{0x01}))
+	start := time.Now()
+	require.Equal(t, start.Add(time.Second), c.PayloadDeadline(start))
+
+	// The request is bounded by the context deadline only.
+	ctx, cancel := context.WithDeadline(context.Background(), start.Add(50*time.Millisecond))
+	defer cancel()
+	_, err := c.GetHeader(ctx, 1, common.ExecutionHash{}, version.Electra())
+	require.ErrorIs(t, err, relay.ErrNoBid)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestIsBetterThanLocal(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		bid, local  *math.U256
+		boostFactor uint64
+		expected    bool
+	}{
+		{"nil bid", nil, math.NewU256(1), 100, false},
+		{"nil local", math.NewU256(1), nil, 100, true},
+		{"higher bid", math.NewU256(101), math.NewU256(100), 100, true},
+		{"equal bid", math.NewU256(100), math.NewU256(100), 100, false},
+		{"discounted bid", math.NewU256(105), math.NewU256(100), 90, false},
+		{"boosted bid", math.NewU256(95), math.NewU256(100), 110, true},
+		{"zero boost", math.NewU256(1_000), math.NewU256(0), 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.expected, relay.IsBetterThanLocal(tt.bid, tt.local, tt.boostFactor))
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "time"
+
+const (
+	// defaultBoostFactor is the default boost factor, in percent, applied to
+	// relay bids before comparing them against the local payload value.
+	defaultBoostFactor = 100
+	// defaultGasLimit is the default gas limit registered with the relays.
+	defaultGasLimit = 36_000_000
+	// defaultRegistrationInterval is the default interval between two
+	// validator registrations.
+	defaultRegistrationInterval = 6 * time.Minute
+)
+
+// Config is the configuration for the external builder relay client.
+type Config struct {
+	// Enabled determines if payloads are also sourced from external builders.
+	Enabled bool `mapstructure:"enabled"`
+	// URLs is the list of builder-API relay endpoints to query. Each URL must
+	// carry the relay public key as its user, e.g. https://0xabc...@relay.xyz,
+	// and bids not signed by that key are rejected.
+	URLs []string `mapstructure:"urls"`
+	// BoostFactor is the percentage applied to the relay bid value before it
+	// is compared against the local payload value. A value of 100 picks the
+	// relay payload only if it pays strictly more than the local one, while
+	// e.g. 90 requires the relay bid to pay at least ~11% more.
+	BoostFactor uint64 `mapstructure:"boost-factor"`
+	// GasLimit is the gas limit the validator registers with the relays.
+	GasLimit uint64 `mapstructure:"gas-limit"`
+	// RegistrationInterval is the interval between two validator
+	// registrations with the relays.
+	RegistrationInterval time.Duration `mapstructure:"registration-interval"`
+}
+
+// DefaultConfig returns the default relay configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:              false,
+		URLs:                 []string{},
+		BoostFactor:          defaultBoostFactor,
+		GasLimit:             defaultGasLimit,
+		RegistrationInterval: defaultRegistrationInterval,
+	}
+}
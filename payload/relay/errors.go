@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrRelayDisabled is returned when the relay client is used while
+	// disabled.
+	ErrRelayDisabled = errors.New("relay client is disabled")
+
+	// ErrNoBid is returned when none of the relays returned a valid bid.
+	ErrNoBid = errors.New("no valid bid received from relays")
+
+	// ErrUnexpectedStatus is returned when a relay answers with an unexpected
+	// HTTP status code.
+	ErrUnexpectedStatus = errors.New("unexpected relay response status")
+
+	// ErrForkVersionMismatch is returned when a relay answers with an object of
+	// a different fork version than the requested one.
+	ErrForkVersionMismatch = errors.New("relay response fork version mismatch")
+
+	// ErrMissingRelayPubkey is returned when a relay URL does not carry the
+	// relay public key.
+	ErrMissingRelayPubkey = errors.New("relay url without relay public key")
+
+	// ErrRelayPubkeyMismatch is returned when a bid is not signed by the key
+	// configured for the relay.
+	ErrRelayPubkeyMismatch = errors.New("bid not signed by the configured relay key")
+
+	// ErrPayloadMismatch is returned when the payload revealed by a relay does
+	// not match the header it committed to.
+	ErrPayloadMismatch = errors.New("revealed payload does not match the bid header")
+)
@@ -0,0 +1,498 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/karalabe/ssz"
+)
+
+// Compile-time assertions to ensure the builder-API types implement the
+// necessary interfaces.
+var (
+	_ ssz.StaticObject  = (*ValidatorRegistration)(nil)
+	_ ssz.DynamicObject = (*BuilderBid)(nil)
+	_ ssz.DynamicObject = (*SignedBuilderBid)(nil)
+	_ ssz.DynamicObject = (*BlindedBeaconBlockBody)(nil)
+	_ ssz.DynamicObject = (*BlindedBeaconBlock)(nil)
+	_ ssz.DynamicObject = (*SignedBlindedBeaconBlock)(nil)
+	_ ssz.DynamicObject = (*BlobsBundle)(nil)
+	_ ssz.DynamicObject = (*ExecutionPayloadAndBlobsBundle)(nil)
+)
+
+/* -------------------------------------------------------------------------- */
+/*                            ValidatorRegistration                           */
+/* -------------------------------------------------------------------------- */
+
+// ValidatorRegistration is the message a validator signs to let the relays
+// know its fee recipient and preferred gas limit.
+type ValidatorRegistration struct {
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+	GasLimit     uint64                  `json:"gas_limit,string"`
+	Timestamp    uint64                  `json:"timestamp,string"`
+	Pubkey       crypto.BLSPubkey        `json:"pubkey"`
+}
+
+// SizeSSZ returns the size of the ValidatorRegistration in SSZ.
+func (*ValidatorRegistration) SizeSSZ(*ssz.Sizer) uint32 {
+	//nolint:mnd // 20 + 8 + 8 + 48.
+	return 84
+}
+
+// DefineSSZ defines the SSZ serialization of the ValidatorRegistration.
+func (r *ValidatorRegistration) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &r.FeeRecipient)
+	ssz.DefineUint64(codec, &r.GasLimit)
+	ssz.DefineUint64(codec, &r.Timestamp)
+	ssz.DefineStaticBytes(codec, &r.Pubkey)
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the ValidatorRegistration.
+func (r *ValidatorRegistration) HashTreeRoot() common.Root {
+	return ssz.HashSequential(r)
+}
+
+// SignedValidatorRegistration is a ValidatorRegistration signed with the
+// builder domain. Registrations are only ever sent as JSON.
+type SignedValidatorRegistration struct {
+	Message   *ValidatorRegistration `json:"message"`
+	Signature crypto.BLSSignature    `json:"signature"`
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 BuilderBid                                 */
+/* -------------------------------------------------------------------------- */
+
+// BuilderBid is the offer of an external builder for the payload of a slot.
+type BuilderBid struct {
+	constraints.Versionable `json:"-"`
+
+	// Header is the header of the offered execution payload.
+	Header *ctypes.ExecutionPayloadHeader
+	// BlobKzgCommitments are the commitments of the blobs in the payload.
+	BlobKzgCommitments []eip4844.KZGCommitment
+	// ExecutionRequests are the requests of the payload, from Electra on.
+	ExecutionRequests *ctypes.ExecutionRequests
+	// Value is the amount, in wei, paid to the fee recipient.
+	Value *math.U256
+	// Pubkey is the public key of the relay signing the bid.
+	Pubkey crypto.BLSPubkey
+}
+
+// NewEmptyBuilderBidWithVersion returns an empty BuilderBid ready to be
+// decoded for the given fork version.
+func NewEmptyBuilderBidWithVersion(forkVersion common.Version) *BuilderBid {
+	return &BuilderBid{
+		Versionable:       ctypes.NewVersionable(forkVersion),
+		Header:            ctypes.NewEmptyExecutionPayloadHeaderWithVersion(forkVersion),
+		ExecutionRequests: &ctypes.ExecutionRequests{},
+		Value:             &math.U256{},
+	}
+}
+
+// SizeSSZ returns the size of the BuilderBid in SSZ.
+func (b *BuilderBid) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	//nolint:mnd // 4 + 4 + 32 + 48.
+	size := uint32(88)
+	includeExecRequests := version.EqualsOrIsAfter(b.GetForkVersion(), version.Electra())
+	if includeExecRequests {
+		size += constants.SSZOffsetSize
+	}
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(siz, b.Header)
+	size += ssz.SizeSliceOfStaticBytes(siz, b.BlobKzgCommitments)
+	if includeExecRequests {
+		size += ssz.SizeDynamicObject(siz, b.ExecutionRequests)
+	}
+	return size
+}
+
+// DefineSSZ defines the SSZ serialization of the BuilderBid.
+func (b *BuilderBid) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineDynamicObjectOffset(codec, &b.Header)
+	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlobKzgCommitments, constants.MaxBlobCommitmentsPerBlock)
+	includeExecRequests := version.EqualsOrIsAfter(b.GetForkVersion(), version.Electra())
+	if includeExecRequests {
+		ssz.DefineDynamicObjectOffset(codec, &b.ExecutionRequests)
+	}
+	ssz.DefineUint256(codec, &b.Value)
+	ssz.DefineStaticBytes(codec, &b.Pubkey)
+
+	ssz.DefineDynamicObjectContent(codec, &b.Header)
+	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlobKzgCommitments, constants.MaxBlobCommitmentsPerBlock)
+	if includeExecRequests {
+		ssz.DefineDynamicObjectContent(codec, &b.ExecutionRequests)
+	}
+}
+
+// MarshalSSZ serializes the BuilderBid to SSZ-encoded bytes.
+func (b *BuilderBid) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(b))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+// ValidateAfterDecodingSSZ validates the BuilderBid after decoding.
+func (*BuilderBid) ValidateAfterDecodingSSZ() error { return nil }
+
+// HashTreeRoot returns the SSZ hash tree root of the BuilderBid.
+func (b *BuilderBid) HashTreeRoot() common.Root {
+	return ssz.HashSequential(b)
+}
+
+// SignedBuilderBid is a BuilderBid signed by the relay with the builder domain.
+type SignedBuilderBid struct {
+	Message   *BuilderBid
+	Signature crypto.BLSSignature
+}
+
+// NewEmptySignedBuilderBidWithVersion returns an empty SignedBuilderBid ready
+// to be decoded for the given fork version.
+func NewEmptySignedBuilderBidWithVersion(forkVersion common.Version) *SignedBuilderBid {
+	return &SignedBuilderBid{Message: NewEmptyBuilderBidWithVersion(forkVersion)}
+}
+
+// SizeSSZ returns the size of the SignedBuilderBid in SSZ.
+func (b *SignedBuilderBid) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	size := constants.SSZOffsetSize + uint32(len(b.Signature))
+	if fixed {
+		return size
+	}
+	return size + ssz.SizeDynamicObject(siz, b.Message)
+}
+
+// DefineSSZ defines the SSZ serialization of the SignedBuilderBid.
+func (b *SignedBuilderBid) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineDynamicObjectOffset(codec, &b.Message)
+	ssz.DefineStaticBytes(codec, &b.Signature)
+	ssz.DefineDynamicObjectContent(codec, &b.Message)
+}
+
+// MarshalSSZ serializes the SignedBuilderBid to SSZ-encoded bytes.
+func (b *SignedBuilderBid) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(b))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+// ValidateAfterDecodingSSZ validates the SignedBuilderBid after decoding.
+func (*SignedBuilderBid) ValidateAfterDecodingSSZ() error { return nil }
+
+/* -------------------------------------------------------------------------- */
+/*                               Blinded blocks                               */
+/* -------------------------------------------------------------------------- */
+
+// BlindedBeaconBlockBody is a BeaconBlockBody carrying the execution payload
+// header in place of the execution payload. Since the header and the payload
+// share their hash tree root, so do the blinded and the full bodies.
+type BlindedBeaconBlockBody struct {
+	constraints.Versionable `json:"-"`
+
+	RandaoReveal           crypto.BLSSignature
+	Eth1Data               *ctypes.Eth1Data
+	Graffiti               [32]byte
+	ProposerSlashings      []*ctypes.ProposerSlashing
+	AttesterSlashings      []*ctypes.AttesterSlashing
+	Attestations           []*ctypes.Attestation
+	Deposits               []*ctypes.Deposit
+	VoluntaryExits         []*ctypes.VoluntaryExit
+	SyncAggregate          *ctypes.SyncAggregate
+	ExecutionPayloadHeader *ctypes.ExecutionPayloadHeader
+	BlsToExecutionChanges  []*ctypes.BlsToExecutionChange
+	BlobKzgCommitments     []eip4844.KZGCommitment
+	ExecutionRequests      *ctypes.ExecutionRequests
+}
+
+// SizeSSZ returns the size of the BlindedBeaconBlockBody in SSZ.
+func (b *BlindedBeaconBlockBody) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	//nolint:mnd // mirrors the BeaconBlockBody layout.
+	var size = 96 + 72 + 32 + 4 + 4 + 4 + 4 + 4 + b.SyncAggregate.SizeSSZ(siz) + 4 + 4 + 4
+	includeExecRequests := version.EqualsOrIsAfter(b.GetForkVersion(), version.Electra())
+	if includeExecRequests {
+		size += constants.SSZOffsetSize
+	}
+	if fixed {
+		return size
+	}
+	size += ssz.SizeSliceOfStaticObjects(siz, b.ProposerSlashings)
+	size += ssz.SizeSliceOfStaticObjects(siz, b.AttesterSlashings)
+	size += ssz.SizeSliceOfStaticObjects(siz, b.Attestations)
+	size += ssz.SizeSliceOfStaticObjects(siz, b.Deposits)
+	size += ssz.SizeSliceOfStaticObjects(siz, b.VoluntaryExits)
+	size += ssz.SizeDynamicObject(siz, b.ExecutionPayloadHeader)
+	size += ssz.SizeSliceOfStaticObjects(siz, b.BlsToExecutionChanges)
+	size += ssz.SizeSliceOfStaticBytes(siz, b.BlobKzgCommitments)
+	if includeExecRequests {
+		size += ssz.SizeDynamicObject(siz, b.ExecutionRequests)
+	}
+	return size
+}
+
+// DefineSSZ defines the SSZ serialization of the BlindedBeaconBlockBody.
+func (b *BlindedBeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &b.RandaoReveal)
+	ssz.DefineStaticObject(codec, &b.Eth1Data)
+	ssz.DefineStaticBytes(codec, &b.Graffiti)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.ProposerSlashings, constants.MaxProposerSlashings)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.AttesterSlashings, constants.MaxAttesterSlashings)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.Attestations, constants.MaxAttestations)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.Deposits, constants.MaxDeposits)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.VoluntaryExits, constants.MaxVoluntaryExits)
+	ssz.DefineStaticObject(codec, &b.SyncAggregate)
+	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayloadHeader)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.BlsToExecutionChanges, constants.MaxBlsToExecutionChanges)
+	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlobKzgCommitments, constants.MaxBlobCommitmentsPerBlock)
+	includeExecRequests := version.EqualsOrIsAfter(b.GetForkVersion(), version.Electra())
+	if includeExecRequests {
+		ssz.DefineDynamicObjectOffset(codec, &b.ExecutionRequests)
+	}
+
+	ssz.DefineSliceOfStaticObjectsContent(codec, &b.ProposerSlashings, constants.MaxProposerSlashings)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &b.AttesterSlashings, constants.MaxAttesterSlashings)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &b.Attestations, constants.MaxAttestations)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &b.Deposits, constants.MaxDeposits)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &b.VoluntaryExits, constants.MaxVoluntaryExits)
+	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayloadHeader)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &b.BlsToExecutionChanges, constants.MaxBlsToExecutionChanges)
+	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlobKzgCommitments, constants.MaxBlobCommitmentsPerBlock)
+	if includeExecRequests {
+		ssz.DefineDynamicObjectContent(codec, &b.ExecutionRequests)
+	}
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the BlindedBeaconBlockBody.
+func (b *BlindedBeaconBlockBody) HashTreeRoot() common.Root {
+	return ssz.HashConcurrent(b)
+}
+
+// BlindedBeaconBlock is a BeaconBlock whose body is blinded.
+type BlindedBeaconBlock struct {
+	constraints.Versionable `json:"-"`
+
+	Slot          math.Slot
+	ProposerIndex math.ValidatorIndex
+	ParentRoot    common.Root
+	StateRoot     common.Root
+	Body          *BlindedBeaconBlockBody
+}
+
+// NewBlindedBeaconBlock blinds the given block, replacing its execution
+// payload with the given header.
+func NewBlindedBeaconBlock(
+	blk *ctypes.BeaconBlock,
+	header *ctypes.ExecutionPayloadHeader,
+) (*BlindedBeaconBlock, error) {
+	body := blk.GetBody()
+	requests := &ctypes.ExecutionRequests{}
+	if version.EqualsOrIsAfter(blk.GetForkVersion(), version.Electra()) {
+		var err error
+		if requests, err = body.GetExecutionRequests(); err != nil {
+			return nil, err
+		}
+	}
+	return &BlindedBeaconBlock{
+		Versionable:   ctypes.NewVersionable(blk.GetForkVersion()),
+		Slot:          blk.GetSlot(),
+		ProposerIndex: blk.GetProposerIndex(),
+		ParentRoot:    blk.GetParentBlockRoot(),
+		StateRoot:     blk.GetStateRoot(),
+		Body: &BlindedBeaconBlockBody{
+			Versionable:            ctypes.NewVersionable(blk.GetForkVersion()),
+			RandaoReveal:           body.GetRandaoReveal(),
+			Eth1Data:               body.GetEth1Data(),
+			Graffiti:               body.GetGraffiti(),
+			ProposerSlashings:      body.GetProposerSlashings(),
+			AttesterSlashings:      body.GetAttesterSlashings(),
+			Attestations:           body.GetAttestations(),
+			Deposits:               body.GetDeposits(),
+			VoluntaryExits:         body.GetVoluntaryExits(),
+			SyncAggregate:          body.GetSyncAggregate(),
+			ExecutionPayloadHeader: header,
+			BlsToExecutionChanges:  body.GetBlsToExecutionChanges(),
+			BlobKzgCommitments:     body.GetBlobKzgCommitments(),
+			ExecutionRequests:      requests,
+		},
+	}, nil
+}
+
+// SizeSSZ returns the size of the BlindedBeaconBlock in SSZ.
+func (b *BlindedBeaconBlock) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	//nolint:mnd // 8 + 8 + 32 + 32 + 4.
+	var size = uint32(84)
+	if fixed {
+		return size
+	}
+	return size + ssz.SizeDynamicObject(siz, b.Body)
+}
+
+// DefineSSZ defines the SSZ serialization of the BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &b.Slot)
+	ssz.DefineUint64(codec, &b.ProposerIndex)
+	ssz.DefineStaticBytes(codec, &b.ParentRoot)
+	ssz.DefineStaticBytes(codec, &b.StateRoot)
+	ssz.DefineDynamicObjectOffset(codec, &b.Body)
+	ssz.DefineDynamicObjectContent(codec, &b.Body)
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the BlindedBeaconBlock, which
+// is the same as the one of the corresponding unblinded block.
+func (b *BlindedBeaconBlock) HashTreeRoot() common.Root {
+	return ssz.HashConcurrent(b)
+}
+
+// SignedBlindedBeaconBlock is a BlindedBeaconBlock signed by its proposer.
+type SignedBlindedBeaconBlock struct {
+	Message   *BlindedBeaconBlock
+	Signature crypto.BLSSignature
+}
+
+// SizeSSZ returns the size of the SignedBlindedBeaconBlock in SSZ.
+func (b *SignedBlindedBeaconBlock) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	size := constants.SSZOffsetSize + uint32(len(b.Signature))
+	if fixed {
+		return size
+	}
+	return size + ssz.SizeDynamicObject(siz, b.Message)
+}
+
+// DefineSSZ defines the SSZ serialization of the SignedBlindedBeaconBlock.
+func (b *SignedBlindedBeaconBlock) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineDynamicObjectOffset(codec, &b.Message)
+	ssz.DefineStaticBytes(codec, &b.Signature)
+	ssz.DefineDynamicObjectContent(codec, &b.Message)
+}
+
+// MarshalSSZ serializes the SignedBlindedBeaconBlock to SSZ-encoded bytes.
+func (b *SignedBlindedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(b))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                       ExecutionPayloadAndBlobsBundle                       */
+/* -------------------------------------------------------------------------- */
+
+// BlobsBundle is the builder-API blobs bundle, revealed along with the
+// execution payload.
+type BlobsBundle struct {
+	Commitments []eip4844.KZGCommitment
+	Proofs      []eip4844.KZGProof
+	Blobs       []eip4844.Blob
+}
+
+// SizeSSZ returns the size of the BlobsBundle in SSZ.
+func (b *BlobsBundle) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	size := 3 * constants.SSZOffsetSize
+	if fixed {
+		return size
+	}
+	size += ssz.SizeSliceOfStaticBytes(siz, b.Commitments)
+	size += ssz.SizeSliceOfStaticBytes(siz, b.Proofs)
+	size += ssz.SizeSliceOfStaticBytes(siz, b.Blobs)
+	return size
+}
+
+// DefineSSZ defines the SSZ serialization of the BlobsBundle.
+func (b *BlobsBundle) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineSliceOfStaticBytesOffset(codec, &b.Commitments, constants.MaxBlobCommitmentsPerBlock)
+	ssz.DefineSliceOfStaticBytesOffset(codec, &b.Proofs, constants.MaxBlobCommitmentsPerBlock)
+	ssz.DefineSliceOfStaticBytesOffset(codec, &b.Blobs, constants.MaxBlobCommitmentsPerBlock)
+
+	ssz.DefineSliceOfStaticBytesContent(codec, &b.Commitments, constants.MaxBlobCommitmentsPerBlock)
+	ssz.DefineSliceOfStaticBytesContent(codec, &b.Proofs, constants.MaxBlobCommitmentsPerBlock)
+	ssz.DefineSliceOfStaticBytesContent(codec, &b.Blobs, constants.MaxBlobCommitmentsPerBlock)
+}
+
+// GetCommitments returns the commitments in the bundle.
+func (b *BlobsBundle) GetCommitments() []eip4844.KZGCommitment {
+	return b.Commitments
+}
+
+// GetProofs returns the proofs in the bundle.
+func (b *BlobsBundle) GetProofs() []eip4844.KZGProof {
+	return b.Proofs
+}
+
+// GetBlobs returns the blobs in the bundle.
+func (b *BlobsBundle) GetBlobs() []*eip4844.Blob {
+	blobs := make([]*eip4844.Blob, len(b.Blobs))
+	for i := range b.Blobs {
+		blobs[i] = &b.Blobs[i]
+	}
+	return blobs
+}
+
+// ExecutionPayloadAndBlobsBundle is the relay answer to a blinded block
+// submission, revealing the execution payload and its blobs.
+type ExecutionPayloadAndBlobsBundle struct {
+	ExecutionPayload *ctypes.ExecutionPayload
+	BlobsBundle      *BlobsBundle
+}
+
+// NewEmptyExecutionPayloadAndBlobsBundleWithVersion returns an empty
+// ExecutionPayloadAndBlobsBundle ready to be decoded for the given fork version.
+func NewEmptyExecutionPayloadAndBlobsBundleWithVersion(
+	forkVersion common.Version,
+) *ExecutionPayloadAndBlobsBundle {
+	return &ExecutionPayloadAndBlobsBundle{
+		ExecutionPayload: ctypes.NewEmptyExecutionPayloadWithVersion(forkVersion),
+		BlobsBundle:      &BlobsBundle{},
+	}
+}
+
+// SizeSSZ returns the size of the ExecutionPayloadAndBlobsBundle in SSZ.
+func (p *ExecutionPayloadAndBlobsBundle) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	size := 2 * constants.SSZOffsetSize
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(siz, p.ExecutionPayload)
+	size += ssz.SizeDynamicObject(siz, p.BlobsBundle)
+	return size
+}
+
+// DefineSSZ defines the SSZ serialization of the ExecutionPayloadAndBlobsBundle.
+func (p *ExecutionPayloadAndBlobsBundle) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineDynamicObjectOffset(codec, &p.ExecutionPayload)
+	ssz.DefineDynamicObjectOffset(codec, &p.BlobsBundle)
+	ssz.DefineDynamicObjectContent(codec, &p.ExecutionPayload)
+	ssz.DefineDynamicObjectContent(codec, &p.BlobsBundle)
+}
+
+// MarshalSSZ serializes the ExecutionPayloadAndBlobsBundle to SSZ-encoded bytes.
+func (p *ExecutionPayloadAndBlobsBundle) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(p))
+	return buf, ssz.EncodeToBytes(buf, p)
+}
+
+// ValidateAfterDecodingSSZ validates the ExecutionPayloadAndBlobsBundle after
+// decoding.
+func (p *ExecutionPayloadAndBlobsBundle) ValidateAfterDecodingSSZ() error {
+	return p.ExecutionPayload.ValidateAfterDecodingSSZ()
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay_test
+
+import (
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/payload/relay"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	sszenc "github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func testPayload(forkVersion common.Version) *ctypes.ExecutionPayload {
+	payload := ctypes.NewEmptyExecutionPayloadWithVersion(forkVersion)
+	payload.ParentHash = common.ExecutionHash{0x01}
+	payload.BlockHash = common.ExecutionHash{0x02}
+	payload.Number = 10
+	payload.Timestamp = 1_000
+	payload.ExtraData = []byte("relay")
+	payload.BaseFeePerGas = math.NewU256(7)
+	payload.Transactions = [][]byte{{0xaa, 0xbb}, {0xcc}}
+	payload.Withdrawals = []*engineprimitives.Withdrawal{
+		engineprimitives.NewWithdrawal(0, 1, common.ExecutionAddress{0x03}, 32),
+	}
+	return payload
+}
+
+func TestBuilderBidSSZRoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, v := range []common.Version{version.Deneb1(), version.Electra()} {
+		header, err := testPayload(v).ToHeader()
+		require.NoError(t, err)
+
+		bid := relay.NewEmptySignedBuilderBidWithVersion(v)
+		bid.Message.Header = header
+		bid.Message.BlobKzgCommitments = []eip4844.KZGCommitment{{0x04}}
+		bid.Message.Value = math.NewU256(1_000_000)
+		bid.Message.Pubkey = [48]byte{0x05}
+		bid.Signature = [96]byte{0x06}
+
+		buf, err := bid.MarshalSSZ()
+		require.NoError(t, err)
+
+		decoded := relay.NewEmptySignedBuilderBidWithVersion(v)
+		require.NoError(t, sszenc.Unmarshal(buf, decoded))
+		require.Equal(t, bid.Message.HashTreeRoot(), decoded.Message.HashTreeRoot())
+		require.Equal(t, header.HashTreeRoot(), decoded.Message.Header.HashTreeRoot())
+		require.Equal(t, bid.Message.Value, decoded.Message.Value)
+		require.Equal(t, bid.Signature, decoded.Signature)
+	}
+}
+
+func TestBlindedBeaconBlockHashTreeRoot(t *testing.T) {
+	t.Parallel()
+	for _, v := range []common.Version{version.Deneb1(), version.Electra()} {
+		blk, err := ctypes.NewBeaconBlockWithVersion(3, 1, common.Root{0x07}, v)
+		require.NoError(t, err)
+		blk.SetStateRoot(common.Root{0x08})
+		blk.GetBody().SetExecutionPayload(testPayload(v))
+		blk.GetBody().SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash]{{0x09}})
+		if version.EqualsOrIsAfter(v, version.Electra()) {
+			require.NoError(t, blk.GetBody().SetExecutionRequests(&ctypes.ExecutionRequests{}))
+		}
+
+		header, err := blk.GetBody().GetExecutionPayload().ToHeader()
+		require.NoError(t, err)
+		blinded, err := relay.NewBlindedBeaconBlock(blk, header)
+		require.NoError(t, err)
+
+		// The proposer signs the blinded block root, which must be the one of
+		// the block it is eventually unblinded into.
+		require.Equal(t, blk.HashTreeRoot(), blinded.HashTreeRoot())
+		require.Equal(t, blk.GetBody().HashTreeRoot(), blinded.Body.HashTreeRoot())
+
+		signed := &relay.SignedBlindedBeaconBlock{Message: blinded}
+		_, err = signed.MarshalSSZ()
+		require.NoError(t, err)
+	}
+}
+
+func TestExecutionPayloadAndBlobsBundleSSZRoundTrip(t *testing.T) {
+	t.Parallel()
+	revealed := relay.NewEmptyExecutionPayloadAndBlobsBundleWithVersion(version.Electra())
+	revealed.ExecutionPayload = testPayload(version.Electra())
+	revealed.BlobsBundle = &relay.BlobsBundle{
+		Commitments: []eip4844.KZGCommitment{{0x01}},
+		Proofs:      []eip4844.KZGProof{{0x02}},
+		Blobs:       []eip4844.Blob{{0x03}},
+	}
+
+	buf, err := revealed.MarshalSSZ()
+	require.NoError(t, err)
+
+	decoded := relay.NewEmptyExecutionPayloadAndBlobsBundleWithVersion(version.Electra())
+	require.NoError(t, sszenc.Unmarshal(buf, decoded))
+	require.Equal(t, revealed.ExecutionPayload.HashTreeRoot(), decoded.ExecutionPayload.HashTreeRoot())
+	require.Equal(t, revealed.BlobsBundle.GetCommitments(), decoded.BlobsBundle.GetCommitments())
+	require.Equal(t, revealed.BlobsBundle.GetProofs(), decoded.BlobsBundle.GetProofs())
+	require.Len(t, decoded.BlobsBundle.GetBlobs(), 1)
+	require.Equal(t, byte(0x03), decoded.BlobsBundle.GetBlobs()[0][0])
+}
@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package eip2333 implements the BLS12-381 hierarchical key derivation of
+// EIP-2333 together with the EIP-2334 validator key paths.
+// https://eips.ethereum.org/EIPS/eip-2333
+// https://eips.ethereum.org/EIPS/eip-2334
+package eip2333
+
+import (
+	"crypto/hkdf"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/errors"
+)
+
+const (
+	// SecretKeySize is the size in bytes of a serialized BLS secret key.
+	SecretKeySize = 32
+
+	// minSeedSize is the minimum seed length mandated by EIP-2333.
+	minSeedSize = 32
+
+	// okmSize is the HKDF output length L = ceil((3 * ceil(log2(r))) / 16).
+	okmSize = 48
+
+	// lamportChunks is the number of 32-byte chunks in a Lamport secret key.
+	lamportChunks = 255
+
+	// pathPurpose and pathCoinType are the fixed EIP-2334 path prefix levels.
+	pathPurpose  = 12381
+	pathCoinType = 3600
+)
+
+var (
+	// ErrSeedTooShort is returned when the seed is shorter than 32 bytes.
+	ErrSeedTooShort = errors.New("seed must be at least 32 bytes")
+
+	// ErrInvalidPath is returned when a derivation path is malformed.
+	ErrInvalidPath = errors.New("invalid derivation path")
+)
+
+//nolint:gochecknoglobals // immutable constants.
+var (
+	// curveOrder is the order r of the BLS12-381 subgroup.
+	curveOrder, _ = new(big.Int).SetString(
+		"52435875175126190479447740508185965837690552500527637822603658699938581184513", 10,
+	)
+
+	// keygenSalt is the initial salt of HKDF_mod_r.
+	keygenSalt = []byte("BLS-SIG-KEYGEN-SALT-")
+)
+
+// SigningKeyPath returns the EIP-2334 path of the signing key of the
+// validator with the given index.
+func SigningKeyPath(index uint32) string {
+	return fmt.Sprintf("m/%d/%d/%d/0/0", pathPurpose, pathCoinType, index)
+}
+
+// WithdrawalKeyPath returns the EIP-2334 path of the withdrawal key of the
+// validator with the given index.
+func WithdrawalKeyPath(index uint32) string {
+	return fmt.Sprintf("m/%d/%d/%d/0", pathPurpose, pathCoinType, index)
+}
+
+// DeriveMasterSK derives the master secret key from the seed.
+func DeriveMasterSK(seed []byte) (*big.Int, error) {
+	if len(seed) < minSeedSize {
+		return nil, ErrSeedTooShort
+	}
+	return hkdfModR(seed, nil)
+}
+
+// DeriveChildSK derives the child secret key at the given index from the
+// parent secret key.
+func DeriveChildSK(parentSK *big.Int, index uint32) (*big.Int, error) {
+	lamportPK, err := parentSKToLamportPK(parentSK, index)
+	if err != nil {
+		return nil, err
+	}
+	return hkdfModR(lamportPK, nil)
+}
+
+// DeriveSecretKey derives the secret key at the given path, e.g.
+// "m/12381/3600/0/0/0", from the seed and returns it serialized big-endian.
+func DeriveSecretKey(seed []byte, path string) ([SecretKeySize]byte, error) {
+	var out [SecretKeySize]byte
+	indices, err := ParsePath(path)
+	if err != nil {
+		return out, err
+	}
+
+	sk, err := DeriveMasterSK(seed)
+	if err != nil {
+		return out, err
+	}
+	for _, index := range indices {
+		if sk, err = DeriveChildSK(sk, index); err != nil {
+			return out, err
+		}
+	}
+	sk.FillBytes(out[:])
+	return out, nil
+}
+
+// ParsePath parses a derivation path of the form "m/a/b/c" into its indices.
+func ParsePath(path string) ([]uint32, error) {
+	parts := strings.Split(path, "/")
+	if len(parts) == 0 || parts[0] != "m" {
+		return nil, fmt.Errorf("%w: %q must start with \"m\"", ErrInvalidPath, path)
+	}
+
+	indices := make([]uint32, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		index, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %w", ErrInvalidPath, path, err)
+		}
+		indices = append(indices, uint32(index))
+	}
+	return indices, nil
+}
+
+// hkdfModR implements HKDF_mod_r, retrying with a re-hashed salt in the
+// negligible case that the result is zero.
+func hkdfModR(ikm, keyInfo []byte) (*big.Int, error) {
+	info := make([]byte, len(keyInfo)+2) //nolint:mnd // I2OSP(L, 2).
+	copy(info, keyInfo)
+	binary.BigEndian.PutUint16(info[len(keyInfo):], okmSize)
+
+	// IKM || I2OSP(0, 1)
+	secret := append(append(make([]byte, 0, len(ikm)+1), ikm...), 0)
+
+	salt := keygenSalt
+	sk := new(big.Int)
+	for sk.Sign() == 0 {
+		digest := sha256.Sum256(salt)
+		salt = digest[:]
+		prk, err := hkdf.Extract(sha256.New, secret, salt)
+		if err != nil {
+			return nil, err
+		}
+		okm, err := hkdf.Expand(sha256.New, prk, string(info), okmSize)
+		if err != nil {
+			return nil, err
+		}
+		sk.SetBytes(okm).Mod(sk, curveOrder)
+	}
+	return sk, nil
+}
+
+// parentSKToLamportPK computes the compressed Lamport public key used to
+// derive the child at the given index.
+func parentSKToLamportPK(parentSK *big.Int, index uint32) ([]byte, error) {
+	var salt [4]byte
+	binary.BigEndian.PutUint32(salt[:], index)
+
+	ikm := make([]byte, SecretKeySize)
+	parentSK.FillBytes(ikm)
+	notIKM := make([]byte, SecretKeySize)
+	for i, b := range ikm {
+		notIKM[i] = ^b
+	}
+
+	lamport0, err := ikmToLamportSK(ikm, salt[:])
+	if err != nil {
+		return nil, err
+	}
+	lamport1, err := ikmToLamportSK(notIKM, salt[:])
+	if err != nil {
+		return nil, err
+	}
+
+	h := sha256.New()
+	for _, lamport := range [][]byte{lamport0, lamport1} {
+		for i := 0; i < lamportChunks; i++ {
+			chunk := sha256.Sum256(lamport[i*sha256.Size : (i+1)*sha256.Size])
+			h.Write(chunk[:])
+		}
+	}
+	return h.Sum(nil), nil
+}
+
+// ikmToLamportSK expands the input key material into the 255 chunks of a
+// Lamport secret key, returned concatenated.
+func ikmToLamportSK(ikm, salt []byte) ([]byte, error) {
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	if err != nil {
+		return nil, err
+	}
+	return hkdf.Expand(sha256.New, prk, "", lamportChunks*sha256.Size)
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package eip2333_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/crypto/eip2333"
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors from https://eips.ethereum.org/EIPS/eip-2333#test-cases.
+func TestDeriveVectors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		seed       string
+		masterSK   string
+		childIndex uint32
+		childSK    string
+	}{
+		{
+			name: "case 0",
+			seed: "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e5349553" +
+				"1f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+			masterSK:   "6083874454709270928345386274498605044986640685124978867557563392430687146096",
+			childIndex: 0,
+			childSK:    "20397789859736650942317412262472558107875392172444076792671091975210932703118",
+		},
+		{
+			name:       "case 1",
+			seed:       "3141592653589793238462643383279502884197169399375105820974944592",
+			masterSK:   "29757020647961307431480504535336562678282505419141012933316116377660817309383",
+			childIndex: 3141592653,
+			childSK:    "25457201688850691947727629385191704516744796114925897962676248250929345014287",
+		},
+		{
+			name:       "case 2",
+			seed:       "0099ff991111002299dd7744ee3355bbdd8844115566cc55663355668888cc00",
+			masterSK:   "27580842291869792442942448775674722299803720648445448686099262467207037398656",
+			childIndex: 4294967295,
+			childSK:    "29358610794459428860402234341874281240803786294062035874021252734817515685787",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			seed, err := hex.DecodeString(tc.seed)
+			require.NoError(t, err)
+
+			master, err := eip2333.DeriveMasterSK(seed)
+			require.NoError(t, err)
+			require.Equal(t, tc.masterSK, master.String())
+
+			child, err := eip2333.DeriveChildSK(master, tc.childIndex)
+			require.NoError(t, err)
+			require.Equal(t, tc.childSK, child.String())
+		})
+	}
+}
+
+func TestDeriveSecretKey(t *testing.T) {
+	t.Parallel()
+	seed := make([]byte, 32)
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+
+	master, err := eip2333.DeriveMasterSK(seed)
+	require.NoError(t, err)
+	expected := master
+	for _, index := range []uint32{12381, 3600, 7, 0, 0} {
+		expected, err = eip2333.DeriveChildSK(expected, index)
+		require.NoError(t, err)
+	}
+
+	sk, err := eip2333.DeriveSecretKey(seed, eip2333.SigningKeyPath(7))
+	require.NoError(t, err)
+	require.Equal(t, 0, expected.Cmp(new(big.Int).SetBytes(sk[:])))
+
+	_, err = eip2333.DeriveSecretKey(seed[:31], "m/0")
+	require.ErrorIs(t, err, eip2333.ErrSeedTooShort)
+}
+
+func TestParsePath(t *testing.T) {
+	t.Parallel()
+	indices, err := eip2333.ParsePath("m/12381/3600/0/0/0")
+	require.NoError(t, err)
+	require.Equal(t, []uint32{12381, 3600, 0, 0, 0}, indices)
+
+	indices, err = eip2333.ParsePath("m")
+	require.NoError(t, err)
+	require.Empty(t, indices)
+
+	for _, path := range []string{"", "12381/3600", "m/-1", "m/4294967296", "m//0"} {
+		_, err = eip2333.ParsePath(path)
+		require.ErrorIs(t, err, eip2333.ErrInvalidPath, path)
+	}
+}
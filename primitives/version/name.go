@@ -47,3 +47,15 @@ func Name(v common.Version) string {
 		return "unknown"
 	}
 }
+
+// FromName returns the fork version with the given name, as returned by Name.
+func FromName(name string) (common.Version, bool) {
+	for _, v := range []common.Version{
+		phase0, altair, bellatrix, capella, deneb, deneb1, electra, electra1, fulu,
+	} {
+		if Name(v) == name {
+			return v, true
+		}
+	}
+	return common.Version{}, false
+}
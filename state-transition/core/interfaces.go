@@ -86,6 +86,7 @@ type ChainSpec interface {
 	EpochsPerHistoricalVector() uint64
 	GenesisForkVersion() common.Version
 	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
+	HasValidatorLookupIndexes(timestamp math.U64) bool
 	ValidatorSetCap() uint64
 	HistoricalRootsLimit() uint64
 	IsMainnet() bool
@@ -450,6 +450,79 @@ func (s *StateDB) GetMarshallable() (*ctypes.BeaconState, error) {
 	return beaconState, nil
 }
 
+// SetMarshallable writes the given beacon state into the underlying store. It is the
+// inverse of GetMarshallable and expects the store to be empty, since validators are
+// appended to the registry.
+//
+//nolint:gocognit // mirrors GetMarshallable
+func (s *StateDB) SetMarshallable(st *ctypes.BeaconState) error {
+	if err := s.SetSlot(st.Slot); err != nil {
+		return err
+	}
+	if err := s.SetFork(st.Fork); err != nil {
+		return err
+	}
+	if err := s.SetGenesisValidatorsRoot(st.GenesisValidatorsRoot); err != nil {
+		return err
+	}
+	if err := s.SetLatestBlockHeader(st.LatestBlockHeader); err != nil {
+		return err
+	}
+	for i, root := range st.BlockRoots {
+		if err := s.UpdateBlockRootAtIndex(uint64(i), root); err != nil {
+			return err
+		}
+	}
+	for i, root := range st.StateRoots {
+		if err := s.UpdateStateRootAtIndex(uint64(i), root); err != nil {
+			return err
+		}
+	}
+	if err := s.SetLatestExecutionPayloadHeader(st.LatestExecutionPayloadHeader); err != nil {
+		return err
+	}
+	if err := s.SetEth1Data(st.Eth1Data); err != nil {
+		return err
+	}
+	if err := s.SetEth1DepositIndex(st.Eth1DepositIndex); err != nil {
+		return err
+	}
+	for _, val := range st.Validators {
+		if err := s.AddValidator(val); err != nil {
+			return err
+		}
+	}
+	for i, balance := range st.Balances {
+		if err := s.SetBalance(math.ValidatorIndex(i), math.Gwei(balance)); err != nil {
+			return err
+		}
+	}
+	for i, mix := range st.RandaoMixes {
+		if err := s.UpdateRandaoMixAtIndex(uint64(i), mix); err != nil {
+			return err
+		}
+	}
+	if err := s.SetNextWithdrawalIndex(st.NextWithdrawalIndex); err != nil {
+		return err
+	}
+	if err := s.SetNextWithdrawalValidatorIndex(st.NextWithdrawalValidatorIndex); err != nil {
+		return err
+	}
+	for i, slashing := range st.Slashings {
+		if err := s.SetSlashingAtIndex(uint64(i), slashing); err != nil {
+			return err
+		}
+	}
+	if err := s.SetTotalSlashing(st.TotalSlashing); err != nil {
+		return err
+	}
+
+	if version.EqualsOrIsAfter(st.GetForkVersion(), version.Electra()) {
+		return s.SetPendingPartialWithdrawals(st.PendingPartialWithdrawals)
+	}
+	return nil
+}
+
 // HashTreeRoot is the interface for the beacon store.
 func (s *StateDB) HashTreeRoot() common.Root {
 	st, err := s.GetMarshallable()
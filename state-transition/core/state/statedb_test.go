@@ -32,7 +32,9 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/berachain/beacon-kit/state-transition/core/state"
 	"github.com/berachain/beacon-kit/storage"
 	"github.com/berachain/beacon-kit/storage/beacondb"
@@ -105,4 +107,60 @@ func TestStateProtect(t *testing.T) {
 	require.Equal(t, wantEthIdx, gotEthIdx)
 }
 
+func TestStateSetMarshallable(t *testing.T) {
+	t.Parallel()
+
+	db, err := db.OpenDB("", dbm.MemDBBackend)
+	require.NoError(t, err)
+
+	cs, errSpec := spec.MainnetChainSpec()
+	require.NoError(t, errSpec)
+
+	nopLog := log.NewNopLogger()
+	cms := store.NewCommitMultiStore(db, nopLog, sdkmetrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	kvStore := beacondb.New(&storage.KVStoreService{Key: testStoreKey})
+	sdkCtx := sdk.NewContext(cms.CacheMultiStore(), true, nopLog)
+	st := state.NewBeaconStateFromDB(
+		kvStore.WithContext(sdkCtx),
+		cs,
+		sdkCtx.Logger(),
+		metrics.NewNoOpTelemetrySink(),
+	)
+
+	want := ctypes.NewEmptyBeaconStateWithVersion(version.Electra())
+	want.Slot = 1234
+	want.GenesisValidatorsRoot = common.Root{0x01}
+	want.Fork = &ctypes.Fork{CurrentVersion: version.Electra(), PreviousVersion: version.Deneb1()}
+	want.LatestBlockHeader = &ctypes.BeaconBlockHeader{Slot: 1234, ProposerIndex: 1}
+	want.BlockRoots = make([]common.Root, cs.SlotsPerHistoricalRoot())
+	want.BlockRoots[1234%cs.SlotsPerHistoricalRoot()] = common.Root{0x02}
+	want.StateRoots = make([]common.Root, cs.SlotsPerHistoricalRoot())
+	want.StateRoots[1233%cs.SlotsPerHistoricalRoot()] = common.Root{0x03}
+	want.Eth1Data = &ctypes.Eth1Data{DepositCount: 2}
+	want.Eth1DepositIndex = 2
+	want.LatestExecutionPayloadHeader = ctypes.NewEmptyExecutionPayloadHeaderWithVersion(version.Electra())
+	want.LatestExecutionPayloadHeader.Number = 1000
+	want.Validators = []*ctypes.Validator{
+		{Pubkey: [48]byte{0x01}, EffectiveBalance: 32e9, ExitEpoch: constants.FarFutureEpoch},
+		{Pubkey: [48]byte{0x02}, EffectiveBalance: 31e9, ExitEpoch: constants.FarFutureEpoch},
+	}
+	want.Balances = []uint64{32e9, 31e9 + 1}
+	want.RandaoMixes = make([]common.Bytes32, cs.EpochsPerHistoricalVector())
+	want.RandaoMixes[1] = common.Bytes32{0x04}
+	want.NextWithdrawalIndex = 5
+	want.NextWithdrawalValidatorIndex = 1
+	want.PendingPartialWithdrawals = []*ctypes.PendingPartialWithdrawal{
+		{ValidatorIndex: 1, Amount: 1e9, WithdrawableEpoch: 10},
+	}
+
+	require.NoError(t, st.SetMarshallable(want))
+
+	got, err := st.GetMarshallable()
+	require.NoError(t, err)
+	require.Equal(t, want.HashTreeRoot(), got.HashTreeRoot())
+}
+
 var testStoreKey = storetypes.NewKVStoreKey("test-stateDB")
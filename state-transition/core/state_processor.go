@@ -91,8 +91,28 @@ func (sp *StateProcessor) Transition(
 		return nil, nil
 	}
 
+	var validatorUpdates transition.ValidatorUpdates
+	err := traceStep(ctx, "StateProcessor.Transition", func(ctx ReadOnlyContext) error {
+		var err error
+		validatorUpdates, err = sp.transition(ctx, st, blk)
+		return err
+	})
+	return validatorUpdates, err
+}
+
+// transition processes the slot, the fork and the block.
+func (sp *StateProcessor) transition(
+	ctx ReadOnlyContext,
+	st *state.StateDB,
+	blk *ctypes.BeaconBlock,
+) (transition.ValidatorUpdates, error) {
 	// Process the next slot.
-	validatorUpdates, err := sp.ProcessSlots(st, blk.GetSlot())
+	var validatorUpdates transition.ValidatorUpdates
+	err := traceStep(ctx, "StateProcessor.ProcessSlots", func(ReadOnlyContext) error {
+		var err error
+		validatorUpdates, err = sp.ProcessSlots(st, blk.GetSlot())
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -220,7 +240,9 @@ func (sp *StateProcessor) ProcessBlock(
 		return err
 	}
 
-	if err = sp.processBlockHeader(ctx, st, blk); err != nil {
+	if err = traceStep(ctx, "StateProcessor.processBlockHeader", func(ctx ReadOnlyContext) error {
+		return sp.processBlockHeader(ctx, st, blk)
+	}); err != nil {
 		return err
 	}
 
@@ -232,19 +254,27 @@ func (sp *StateProcessor) ProcessBlock(
 	}
 	prevBlockForkVersion := sp.cs.ActiveForkVersionForTimestamp(lph.GetTimestamp())
 
-	if err = sp.processExecutionPayload(ctx, st, blk, parentProposerPubkey); err != nil {
+	if err = traceStep(ctx, "StateProcessor.processExecutionPayload", func(ctx ReadOnlyContext) error {
+		return sp.processExecutionPayload(ctx, st, blk, parentProposerPubkey)
+	}); err != nil {
 		return err
 	}
 
-	if err = sp.processWithdrawals(st, blk); err != nil {
+	if err = traceStep(ctx, "StateProcessor.processWithdrawals", func(ReadOnlyContext) error {
+		return sp.processWithdrawals(st, blk)
+	}); err != nil {
 		return err
 	}
 
-	if err = sp.processRandaoReveal(ctx, st, blk); err != nil {
+	if err = traceStep(ctx, "StateProcessor.processRandaoReveal", func(ctx ReadOnlyContext) error {
+		return sp.processRandaoReveal(ctx, st, blk)
+	}); err != nil {
 		return err
 	}
 
-	if err = sp.processOperations(ctx, st, blk, prevBlockForkVersion); err != nil {
+	if err = traceStep(ctx, "StateProcessor.processOperations", func(ctx ReadOnlyContext) error {
+		return sp.processOperations(ctx, st, blk, prevBlockForkVersion)
+	}); err != nil {
 		return err
 	}
 
@@ -256,15 +286,16 @@ func (sp *StateProcessor) ProcessBlock(
 
 	// Ensure the calculated state root matches the state root on
 	// the block.
-	stateRoot := st.HashTreeRoot()
-	if blk.GetStateRoot() != stateRoot {
-		return errors.Wrapf(
-			ErrStateRootMismatch, "expected %s, got %s",
-			stateRoot, blk.GetStateRoot(),
-		)
-	}
-
-	return nil
+	return traceStep(ctx, "StateProcessor.verifyStateRoot", func(ReadOnlyContext) error {
+		stateRoot := st.HashTreeRoot()
+		if blk.GetStateRoot() != stateRoot {
+			return errors.Wrapf(
+				ErrStateRootMismatch, "expected %s, got %s",
+				stateRoot, blk.GetStateRoot(),
+			)
+		}
+		return nil
+	})
 }
 
 // processEpoch processes the epoch and ensures it matches the local state.
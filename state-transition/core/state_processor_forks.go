@@ -65,7 +65,17 @@ func (sp *StateProcessor) ProcessFork(
 		return fmt.Errorf(
 			"cannot downgrade state from %s to %s", stateFork.CurrentVersion, forkVersion,
 		)
-	} else if slot > 0 && version.Equals(forkVersion, stateFork.CurrentVersion) {
+	}
+
+	// The validator lookup indexes have their own fork, independent of the fork versions. They
+	// are backfilled on the first state processed from that fork and maintained afterwards.
+	if sp.cs.HasValidatorLookupIndexes(timestamp) {
+		if err = st.IndexValidatorLookups(); err != nil {
+			return err
+		}
+	}
+
+	if slot > 0 && version.Equals(forkVersion, stateFork.CurrentVersion) {
 		// If we are past genesis and the fork version remains consistent, do nothing.
 		return nil
 	}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+const defaultCheckpointInterval = 1024
+
+// Config is the configuration for the beacon state archive.
+type Config struct {
+	// Enabled determines if the beacon state of every finalized block is archived.
+	Enabled bool `mapstructure:"enabled"`
+	// CheckpointInterval is the number of slots between two full state checkpoints.
+	// States in between are stored as diffs against the previous slot.
+	CheckpointInterval uint64 `mapstructure:"checkpoint-interval"`
+}
+
+// DefaultConfig returns the default configuration for the beacon state archive.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:            false,
+		CheckpointInterval: defaultCheckpointInterval,
+	}
+}
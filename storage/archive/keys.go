@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+const (
+	checkpointPrefix byte = iota
+	diffPrefix
+)
+
+// Fields of a state diff. They are ordered so that, when iterating over the
+// diff of a slot, the base fields and the registry size are applied before
+// any indexed entry.
+const (
+	baseField byte = iota
+	registrySizeField
+	validatorField
+	balanceField
+	blockRootField
+	stateRootField
+	randaoMixField
+)
+
+const (
+	slotSize       = 8
+	versionsSize   = 8
+	diffKeySize    = 1 + slotSize + 1 + slotSize
+	diffKeyIdxFrom = 1 + slotSize + 1
+)
+
+func encodeU64(v uint64) []byte {
+	return binary.BigEndian.AppendUint64(make([]byte, 0, slotSize), v)
+}
+
+func decodeU64(bz []byte) (uint64, error) {
+	if len(bz) != slotSize {
+		return 0, fmt.Errorf("invalid archive value size: %d", len(bz))
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+func checkpointKey(slot math.Slot) []byte {
+	return append([]byte{checkpointPrefix}, encodeU64(slot.Unwrap())...)
+}
+
+func diffSlotKey(slot math.Slot) []byte {
+	return append([]byte{diffPrefix}, encodeU64(slot.Unwrap())...)
+}
+
+func diffKey(slot math.Slot, field byte, index uint64) []byte {
+	key := make([]byte, 0, diffKeySize)
+	key = append(key, diffSlotKey(slot)...)
+	key = append(key, field)
+	return binary.BigEndian.AppendUint64(key, index)
+}
+
+// parseDiffKey returns the slot, field and index encoded in a diff key.
+func parseDiffKey(key []byte) (math.Slot, byte, uint64, error) {
+	if len(key) != diffKeySize || key[0] != diffPrefix {
+		return 0, 0, 0, fmt.Errorf("invalid archive diff key: %x", key)
+	}
+	slot := math.Slot(binary.BigEndian.Uint64(key[1 : 1+slotSize]))
+	return slot, key[1+slotSize], binary.BigEndian.Uint64(key[diffKeyIdxFrom:]), nil
+}
+
+// marshalState encodes a state prefixed by its fork version and the fork
+// version of its latest execution payload header, which are needed to decode it.
+func marshalState(st *ctypes.BeaconState) ([]byte, error) {
+	bz, err := st.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, 0, versionsSize+len(bz))
+	out = binary.BigEndian.AppendUint32(out, st.GetForkVersion().ToUint32())
+	out = binary.BigEndian.AppendUint32(out, st.LatestExecutionPayloadHeader.GetForkVersion().ToUint32())
+	return append(out, bz...), nil
+}
+
+func unmarshalState(bz []byte) (*ctypes.BeaconState, error) {
+	if len(bz) < versionsSize {
+		return nil, fmt.Errorf("invalid archived state size: %d", len(bz))
+	}
+	var (
+		stateVersion   = bytes.FromUint32(binary.BigEndian.Uint32(bz[:4]))
+		payloadVersion = bytes.FromUint32(binary.BigEndian.Uint32(bz[4:versionsSize]))
+	)
+	st := ctypes.NewEmptyBeaconStateWithVersion(stateVersion)
+	st.LatestExecutionPayloadHeader = ctypes.NewEmptyExecutionPayloadHeaderWithVersion(payloadVersion)
+	if err := st.UnmarshalSSZ(bz[versionsSize:]); err != nil {
+		return nil, fmt.Errorf("failed decoding archived state: %w", err)
+	}
+	return st, nil
+}
+
+func decodeRoot(bz []byte) (common.Root, error) {
+	if len(bz) != len(common.Root{}) {
+		return common.Root{}, fmt.Errorf("invalid archived root size: %d", len(bz))
+	}
+	return common.Root(bz), nil
+}
@@ -0,0 +1,333 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"fmt"
+	"sync"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	"github.com/berachain/beacon-kit/primitives/math"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+var (
+	ErrArchiveNotEnabled = errors.New("state archive not enabled")
+	ErrStateNotArchived  = errors.New("state not archived")
+)
+
+// Store archives the beacon state of every finalized block, so that states can be
+// served at any height regardless of the IAVL pruning strategy. Every
+// checkpointInterval slots a full state is stored, while states in between are stored
+// as the diff against the state of the previous slot.
+type Store struct {
+	enabled            bool
+	checkpointInterval uint64
+
+	db dbm.DB
+
+	// mu guards latest, the last archived state, which diffs are computed against.
+	mu     sync.Mutex
+	latest *ctypes.BeaconState
+
+	// closeOnce guarantees db is closed at most once.
+	closeOnce sync.Once
+
+	logger log.Logger
+}
+
+// NewStore creates a new state archive persisting states in db.
+func NewStore(db dbm.DB, logger log.Logger, cfg Config) *Store {
+	return &Store{
+		enabled:            cfg.Enabled,
+		checkpointInterval: max(cfg.CheckpointInterval, 1),
+		db:                 db,
+		logger:             logger,
+	}
+}
+
+// Enabled returns whether the archive stores states.
+func (s *Store) Enabled() bool {
+	return s != nil && s.enabled
+}
+
+// Close closes the underlying database. It is safe to call multiple times.
+func (s *Store) Close() error {
+	var err error
+	s.closeOnce.Do(func() { err = s.db.Close() })
+	return err
+}
+
+// Append archives the state of a finalized block. States are expected to be appended
+// slot after slot; a full checkpoint is stored whenever the previous slot is unknown.
+func (s *Store) Append(st *ctypes.BeaconState) error {
+	if !s.enabled {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	slot := st.Slot
+	isCheckpoint := s.latest == nil ||
+		s.latest.Slot+1 != slot ||
+		slot.Unwrap()%s.checkpointInterval == 0
+	if isCheckpoint {
+		bz, err := marshalState(st)
+		if err != nil {
+			return fmt.Errorf("failed encoding state checkpoint at slot %d: %w", slot, err)
+		}
+		if err = batch.Set(checkpointKey(slot), bz); err != nil {
+			return err
+		}
+	} else if err := writeDiff(batch, s.latest, st); err != nil {
+		return fmt.Errorf("failed encoding state diff at slot %d: %w", slot, err)
+	}
+
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("failed archiving state at slot %d: %w", slot, err)
+	}
+	s.latest = st
+	return nil
+}
+
+// StateAt reconstructs the state at the given slot from the closest previous
+// checkpoint and the diffs stored since.
+func (s *Store) StateAt(slot math.Slot) (*ctypes.BeaconState, error) {
+	if !s.enabled {
+		return nil, ErrArchiveNotEnabled
+	}
+
+	cpSlot, st, err := s.checkpointAtOrBefore(slot)
+	if err != nil {
+		return nil, err
+	}
+	if cpSlot == slot {
+		return st, nil
+	}
+
+	// Make sure the requested slot was archived before replaying diffs on top of
+	// the checkpoint, otherwise we'd serve the state of a previous slot.
+	found, err := s.db.Has(diffKey(slot, baseField, 0))
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: slot %d", ErrStateNotArchived, slot)
+	}
+
+	it, err := s.db.Iterator(diffSlotKey(cpSlot+1), diffSlotKey(slot+1))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if err = applyDiffEntry(st, it.Key(), it.Value()); err != nil {
+			return nil, err
+		}
+	}
+	if err = it.Error(); err != nil {
+		return nil, err
+	}
+	if st.Slot != slot {
+		return nil, fmt.Errorf("%w: slot %d, found gap after slot %d", ErrStateNotArchived, slot, st.Slot)
+	}
+	return st, nil
+}
+
+// checkpointAtOrBefore returns the closest checkpoint at or before slot.
+func (s *Store) checkpointAtOrBefore(slot math.Slot) (math.Slot, *ctypes.BeaconState, error) {
+	it, err := s.db.ReverseIterator(checkpointKey(0), checkpointKey(slot+1))
+	if err != nil {
+		return 0, nil, err
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		if err = it.Error(); err != nil {
+			return 0, nil, err
+		}
+		return 0, nil, fmt.Errorf("%w: slot %d", ErrStateNotArchived, slot)
+	}
+	st, err := unmarshalState(it.Value())
+	if err != nil {
+		return 0, nil, err
+	}
+	return st.Slot, st, nil
+}
+
+// writeDiff adds to batch the entries of cur that differ from prev. Small fields are
+// stored in full as the base of the diff, while validators, balances and the roots
+// and randao mixes ring buffers are stored by index.
+func writeDiff(batch dbm.Batch, prev, cur *ctypes.BeaconState) error {
+	slot := cur.Slot
+
+	bz, err := marshalState(baseOf(cur))
+	if err != nil {
+		return err
+	}
+	if err = batch.Set(diffKey(slot, baseField, 0), bz); err != nil {
+		return err
+	}
+
+	if len(cur.Validators) != len(prev.Validators) || len(cur.Balances) != len(prev.Balances) {
+		size := append(encodeU64(uint64(len(cur.Validators))), encodeU64(uint64(len(cur.Balances)))...)
+		if err = batch.Set(diffKey(slot, registrySizeField, 0), size); err != nil {
+			return err
+		}
+	}
+
+	for i, val := range cur.Validators {
+		if i < len(prev.Validators) && *prev.Validators[i] == *val {
+			continue
+		}
+		if bz, err = val.MarshalSSZ(); err != nil {
+			return err
+		}
+		if err = batch.Set(diffKey(slot, validatorField, uint64(i)), bz); err != nil {
+			return err
+		}
+	}
+
+	for i, balance := range cur.Balances {
+		if i < len(prev.Balances) && prev.Balances[i] == balance {
+			continue
+		}
+		if err = batch.Set(diffKey(slot, balanceField, uint64(i)), encodeU64(balance)); err != nil {
+			return err
+		}
+	}
+
+	if err = writeRootsDiff(batch, slot, blockRootField, prev.BlockRoots, cur.BlockRoots); err != nil {
+		return err
+	}
+	if err = writeRootsDiff(batch, slot, stateRootField, prev.StateRoots, cur.StateRoots); err != nil {
+		return err
+	}
+	return writeRootsDiff(batch, slot, randaoMixField, prev.RandaoMixes, cur.RandaoMixes)
+}
+
+func writeRootsDiff[RootT ~[32]byte](batch dbm.Batch, slot math.Slot, field byte, prev, cur []RootT) error {
+	for i, root := range cur {
+		if i < len(prev) && prev[i] == root {
+			continue
+		}
+		if err := batch.Set(diffKey(slot, field, uint64(i)), root[:]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyDiffEntry applies a single diff entry to st.
+func applyDiffEntry(st *ctypes.BeaconState, key, value []byte) error {
+	_, field, idx, err := parseDiffKey(key)
+	if err != nil {
+		return err
+	}
+
+	switch field {
+	case baseField:
+		var base *ctypes.BeaconState
+		if base, err = unmarshalState(value); err != nil {
+			return err
+		}
+		if base.Slot != st.Slot+1 {
+			return fmt.Errorf("%w: missing diff between slot %d and %d", ErrStateNotArchived, st.Slot, base.Slot)
+		}
+		base.Validators = st.Validators
+		base.Balances = st.Balances
+		base.BlockRoots = st.BlockRoots
+		base.StateRoots = st.StateRoots
+		base.RandaoMixes = st.RandaoMixes
+		*st = *base
+	case registrySizeField:
+		if len(value) != 2*slotSize {
+			return fmt.Errorf("invalid archived registry size: %x", value)
+		}
+		numVals, _ := decodeU64(value[:slotSize])
+		numBalances, _ := decodeU64(value[slotSize:])
+		st.Validators = resize(st.Validators, numVals)
+		st.Balances = resize(st.Balances, numBalances)
+	case validatorField:
+		if idx >= uint64(len(st.Validators)) {
+			return fmt.Errorf("archived validator index %d out of range", idx)
+		}
+		val := ctypes.NewEmptyValidator()
+		if err = ssz.Unmarshal(value, val); err != nil {
+			return err
+		}
+		st.Validators[idx] = val
+	case balanceField:
+		if idx >= uint64(len(st.Balances)) {
+			return fmt.Errorf("archived balance index %d out of range", idx)
+		}
+		if st.Balances[idx], err = decodeU64(value); err != nil {
+			return err
+		}
+	case blockRootField:
+		return setRoot(st.BlockRoots, idx, value)
+	case stateRootField:
+		return setRoot(st.StateRoots, idx, value)
+	case randaoMixField:
+		return setRoot(st.RandaoMixes, idx, value)
+	default:
+		return fmt.Errorf("unknown archived diff field %d", field)
+	}
+	return nil
+}
+
+// baseOf returns a shallow copy of st without the fields stored by index in diffs.
+func baseOf(st *ctypes.BeaconState) *ctypes.BeaconState {
+	base := *st
+	base.Validators = nil
+	base.Balances = nil
+	base.BlockRoots = nil
+	base.StateRoots = nil
+	base.RandaoMixes = nil
+	return &base
+}
+
+func resize[T any](s []T, size uint64) []T {
+	if size <= uint64(len(s)) {
+		return s[:size]
+	}
+	return append(s, make([]T, size-uint64(len(s)))...)
+}
+
+func setRoot[RootT ~[32]byte](roots []RootT, idx uint64, value []byte) error {
+	if idx >= uint64(len(roots)) {
+		return fmt.Errorf("archived root index %d out of range", idx)
+	}
+	root, err := decodeRoot(value)
+	if err != nil {
+		return err
+	}
+	roots[idx] = RootT(root)
+	return nil
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive_test
+
+import (
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/storage/archive"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+const historySize = 8
+
+// stateAtSlot deterministically generates the state at the given slot. The registry
+// grows every few slots and a single validator, balance and roots entry change per slot.
+func stateAtSlot(slot math.Slot) *ctypes.BeaconState {
+	st := ctypes.NewEmptyBeaconStateWithVersion(version.Electra())
+	st.Slot = slot
+	st.GenesisValidatorsRoot = common.Root{0x01}
+	st.Fork = &ctypes.Fork{CurrentVersion: version.Electra()}
+	st.LatestBlockHeader = &ctypes.BeaconBlockHeader{Slot: slot, ProposerIndex: slot % 3}
+	st.Eth1Data = &ctypes.Eth1Data{DepositCount: math.U64(slot/3 + 1)}
+	st.Eth1DepositIndex = slot.Unwrap()/3 + 1
+	st.LatestExecutionPayloadHeader = ctypes.NewEmptyExecutionPayloadHeaderWithVersion(version.Electra())
+	st.LatestExecutionPayloadHeader.Number = slot
+	st.LatestExecutionPayloadHeader.Timestamp = 10 * slot
+	st.NextWithdrawalIndex = slot.Unwrap()
+	st.NextWithdrawalValidatorIndex = math.ValidatorIndex(slot % 2)
+	st.Slashings = []math.Gwei{0, 0}
+	st.PendingPartialWithdrawals = []*ctypes.PendingPartialWithdrawal{}
+
+	numValidators := slot.Unwrap()/3 + 1
+	for i := range numValidators {
+		st.Validators = append(st.Validators, &ctypes.Validator{
+			Pubkey:           [48]byte{byte(i)},
+			EffectiveBalance: math.Gwei(32e9),
+			ExitEpoch:        math.Epoch(max(i, slot.Unwrap()%numValidators)),
+		})
+		st.Balances = append(st.Balances, 32e9+i*slot.Unwrap())
+	}
+
+	st.BlockRoots = make([]common.Root, historySize)
+	st.StateRoots = make([]common.Root, historySize)
+	st.RandaoMixes = make([]common.Bytes32, historySize)
+	for s := range min(slot.Unwrap()+1, historySize) {
+		prev := slot.Unwrap() - s
+		st.BlockRoots[prev%historySize] = common.Root{0xb, byte(prev)}
+		st.StateRoots[prev%historySize] = common.Root{0x5, byte(prev)}
+		st.RandaoMixes[prev%historySize] = common.Bytes32{0x7, byte(prev)}
+	}
+	return st
+}
+
+func TestStateArchive(t *testing.T) {
+	t.Parallel()
+	db := dbm.NewMemDB()
+	store := archive.NewStore(db, noop.NewLogger[any](), archive.Config{Enabled: true, CheckpointInterval: 4})
+
+	for slot := math.Slot(1); slot <= 13; slot++ {
+		require.NoError(t, store.Append(stateAtSlot(slot)))
+	}
+
+	// Every slot can be reconstructed, both at and in between checkpoints.
+	for slot := math.Slot(1); slot <= 13; slot++ {
+		st, err := store.StateAt(slot)
+		require.NoError(t, err)
+		require.Equal(t, slot, st.Slot)
+		require.Equal(t, stateAtSlot(slot).HashTreeRoot(), st.HashTreeRoot())
+	}
+
+	// Slots that were never archived cannot be served.
+	_, err := store.StateAt(0)
+	require.ErrorIs(t, err, archive.ErrStateNotArchived)
+	_, err = store.StateAt(14)
+	require.ErrorIs(t, err, archive.ErrStateNotArchived)
+
+	// After a restart the archive starts from a new checkpoint and keeps serving history.
+	store = archive.NewStore(db, noop.NewLogger[any](), archive.Config{Enabled: true, CheckpointInterval: 4})
+	for slot := math.Slot(14); slot <= 15; slot++ {
+		require.NoError(t, store.Append(stateAtSlot(slot)))
+	}
+	for _, slot := range []math.Slot{2, 13, 14, 15} {
+		st, err := store.StateAt(slot)
+		require.NoError(t, err)
+		require.Equal(t, stateAtSlot(slot).HashTreeRoot(), st.HashTreeRoot())
+	}
+}
+
+func TestStateArchiveDisabled(t *testing.T) {
+	t.Parallel()
+	store := archive.NewStore(dbm.NewMemDB(), noop.NewLogger[any](), archive.DefaultConfig())
+	require.False(t, store.Enabled())
+
+	require.NoError(t, store.Append(stateAtSlot(1)))
+	_, err := store.StateAt(1)
+	require.ErrorIs(t, err, archive.ErrArchiveNotEnabled)
+}
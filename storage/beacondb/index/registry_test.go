@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package index_test
+
+import (
+	"testing"
+
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/collections/indexes"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/beacondb/index"
+	"github.com/berachain/beacon-kit/storage/encoding"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorLookupIndexes(t *testing.T) {
+	t.Parallel()
+	storeService, ctx := colltest.MockStore()
+	sb := sdkcollections.NewSchemaBuilder(storeService)
+	validators := sdkcollections.NewIndexedMap(
+		sb,
+		sdkcollections.NewPrefix([]byte{0}),
+		"validators",
+		sdkcollections.Uint64Key,
+		encoding.SSZValueCodec[*ctypes.Validator]{NewEmptyF: ctypes.NewEmptyValidator},
+		index.NewValidatorsIndex[*ctypes.Validator](sb),
+	)
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	var (
+		credsA = ctypes.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x0a})
+		credsB = ctypes.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x0b})
+		epoch  = math.Epoch(10)
+	)
+	newValidator := func(
+		pubkey byte, credentials ctypes.WithdrawalCredentials, activation, exit math.Epoch,
+	) *ctypes.Validator {
+		return &ctypes.Validator{
+			Pubkey:                     bytes.B48{pubkey},
+			WithdrawalCredentials:      credentials,
+			EffectiveBalance:           math.Gwei(32e9),
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            activation,
+			ExitEpoch:                  exit,
+			WithdrawableEpoch:          constants.FarFutureEpoch,
+		}
+	}
+	vals := []*ctypes.Validator{
+		newValidator(0x01, credsA, 0, constants.FarFutureEpoch),
+		newValidator(0x02, credsB, 0, constants.FarFutureEpoch),
+		newValidator(0x03, credsA, epoch+1, constants.FarFutureEpoch),
+		newValidator(0x04, credsA, 0, epoch),
+	}
+	for i, val := range vals {
+		require.NoError(t, validators.Set(ctx, uint64(i), val))
+	}
+	matchCredentials := func(credentials ctypes.WithdrawalCredentials) []uint64 {
+		iter, iterErr := validators.Indexes.WithdrawalCredentials.MatchExact(ctx, credentials[:])
+		require.NoError(t, iterErr)
+		pks, iterErr := iter.PrimaryKeys()
+		require.NoError(t, iterErr)
+		return pks
+	}
+	matchEpoch := func(idx *indexes.Multi[uint64, uint64, *ctypes.Validator], e math.Epoch) []uint64 {
+		iter, iterErr := idx.MatchExact(ctx, e.Unwrap())
+		require.NoError(t, iterErr)
+		pks, iterErr := iter.PrimaryKeys()
+		require.NoError(t, iterErr)
+		return pks
+	}
+
+	// Only the indexes of the list are maintained along with the validators.
+	idx, err := validators.Indexes.Pubkey.MatchExact(ctx, vals[1].Pubkey[:])
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), idx)
+	require.Empty(t, matchCredentials(credsA))
+	require.Empty(t, matchEpoch(validators.Indexes.ExitEpoch, epoch))
+
+	// Referencing the validators fills the lookup indexes.
+	notFound := func() (*ctypes.Validator, error) { return nil, sdkcollections.ErrNotFound }
+	for i, val := range vals {
+		for _, lookup := range validators.Indexes.LookupIndexesList() {
+			require.NoError(t, lookup.Reference(ctx, uint64(i), val, notFound))
+		}
+	}
+	require.Equal(t, []uint64{0, 2, 3}, matchCredentials(credsA))
+	require.Equal(t, []uint64{1}, matchCredentials(credsB))
+	require.Equal(t, []uint64{0, 1, 3}, matchEpoch(validators.Indexes.ActivationEpoch, 0))
+	require.Equal(t, []uint64{2}, matchEpoch(validators.Indexes.ActivationEpoch, epoch+1))
+	require.Equal(t, []uint64{3}, matchEpoch(validators.Indexes.ExitEpoch, epoch))
+
+	// Referencing a replaced validator moves it across the lookup indexes.
+	updated := newValidator(0x02, credsA, 0, epoch)
+	for _, lookup := range validators.Indexes.LookupIndexesList() {
+		require.NoError(t, lookup.Reference(ctx, 1, updated, func() (*ctypes.Validator, error) {
+			return vals[1], nil
+		}))
+	}
+	require.Equal(t, []uint64{0, 1, 2, 3}, matchCredentials(credsA))
+	require.Empty(t, matchCredentials(credsB))
+	require.Equal(t, []uint64{1, 3}, matchEpoch(validators.Indexes.ExitEpoch, epoch))
+}
@@ -23,6 +23,7 @@ package index
 import (
 	sdkcollections "cosmossdk.io/collections"
 	"cosmossdk.io/collections/indexes"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -35,6 +36,9 @@ const (
 	validatorPubkeyToIndexPrefix           = "val_pk_to_idx"
 	validatorConsAddrToIndexPrefix         = "val_cons_addr_to_idx"
 	validatorEffectiveBalanceToIndexPrefix = "val_eff_bal_to_idx"
+	validatorCredentialsToIndexPrefix      = "val_creds_to_idx"
+	validatorActivationEpochToIndexPrefix  = "val_act_epoch_to_idx"
+	validatorExitEpochToIndexPrefix        = "val_exit_epoch_to_idx"
 )
 
 // Validator is an interface that combines the ssz.Marshaler and
@@ -45,6 +49,13 @@ type Validator interface {
 	GetPubkey() crypto.BLSPubkey
 	// GetEffectiveBalance returns the effective balance of the validator.
 	GetEffectiveBalance() math.Gwei
+	// GetWithdrawalCredentials returns the withdrawal credentials of the
+	// validator.
+	GetWithdrawalCredentials() ctypes.WithdrawalCredentials
+	// GetActivationEpoch returns the activation epoch of the validator.
+	GetActivationEpoch() math.Epoch
+	// GetExitEpoch returns the exit epoch of the validator.
+	GetExitEpoch() math.Epoch
 }
 
 // ValidatorsIndex is a struct that holds a unique index for validators based
@@ -59,10 +70,19 @@ type ValidatorsIndex[ValidatorT Validator] struct {
 	// CometBFTAddress is a unique index mapping a validator's Comet BFT address
 	// to their numeric ID.
 	CometBFTAddress *indexes.Unique[[]byte, uint64, ValidatorT]
+	// WithdrawalCredentials is a multi-index mapping a validator's withdrawal
+	// credentials to their numeric ID. It is a lookup index.
+	WithdrawalCredentials *indexes.Multi[[]byte, uint64, ValidatorT]
+	// ActivationEpoch is a multi-index mapping a validator's activation epoch
+	// to their numeric ID. It is a lookup index.
+	ActivationEpoch *indexes.Multi[uint64, uint64, ValidatorT]
+	// ExitEpoch is a multi-index mapping a validator's exit epoch to their
+	// numeric ID. It is a lookup index.
+	ExitEpoch *indexes.Multi[uint64, uint64, ValidatorT]
 }
 
-// IndexesList returns a list of all indexes associated with the
-// validatorsIndex.
+// IndexesList returns a list of the indexes maintained along with the
+// validators. The lookup indexes are not part of it.
 func (a ValidatorsIndex[ValidatorT]) IndexesList() []sdkcollections.Index[
 	uint64, ValidatorT,
 ] {
@@ -73,6 +93,19 @@ func (a ValidatorsIndex[ValidatorT]) IndexesList() []sdkcollections.Index[
 	}
 }
 
+// LookupIndexesList returns a list of the lookup indexes. Since they were
+// added to an existing state, they are only maintained from the fork that
+// introduces them and must be referenced explicitly.
+func (a ValidatorsIndex[ValidatorT]) LookupIndexesList() []sdkcollections.Index[
+	uint64, ValidatorT,
+] {
+	return []sdkcollections.Index[uint64, ValidatorT]{
+		a.WithdrawalCredentials,
+		a.ActivationEpoch,
+		a.ExitEpoch,
+	}
+}
+
 // NewValidatorsIndex creates a new validatorsIndex with a unique index for
 // validator public keys.
 func NewValidatorsIndex[ValidatorT Validator](
@@ -111,5 +144,36 @@ func NewValidatorsIndex[ValidatorT Validator](
 				return cmtcrypto.AddressHash(pk[:]).Bytes(), nil
 			},
 		),
+		WithdrawalCredentials: indexes.NewMulti(
+			sb,
+			sdkcollections.NewPrefix(validatorCredentialsToIndexPrefix),
+			validatorCredentialsToIndexPrefix,
+			sdkcollections.BytesKey,
+			sdkcollections.Uint64Key,
+			func(_ uint64, validator ValidatorT) ([]byte, error) {
+				creds := validator.GetWithdrawalCredentials()
+				return creds[:], nil
+			},
+		),
+		ActivationEpoch: indexes.NewMulti(
+			sb,
+			sdkcollections.NewPrefix(validatorActivationEpochToIndexPrefix),
+			validatorActivationEpochToIndexPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Key,
+			func(_ uint64, validator ValidatorT) (uint64, error) {
+				return validator.GetActivationEpoch().Unwrap(), nil
+			},
+		),
+		ExitEpoch: indexes.NewMulti(
+			sb,
+			sdkcollections.NewPrefix(validatorExitEpochToIndexPrefix),
+			validatorExitEpochToIndexPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Key,
+			func(_ uint64, validator ValidatorT) (uint64, error) {
+				return validator.GetExitEpoch().Unwrap(), nil
+			},
+		),
 	}
 }
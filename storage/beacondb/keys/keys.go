@@ -45,6 +45,7 @@ const (
 	NextWithdrawalValidatorIndexPrefix
 	ForkPrefix
 	PendingPartialWithdrawalsPrefix
+	ValidatorLookupIndexedPrefix
 )
 
 const (
@@ -72,4 +73,5 @@ const (
 	NextWithdrawalValidatorIndexPrefixHumanReadable     = "NextWithdrawalValidatorIndexPrefix"
 	ForkPrefixHumanReadable                             = "ForkPrefix"
 	PendingPartialWithdrawalsPrefixHumanReadable        = "PendingPartialWithdrawalsPrefix"
+	ValidatorLookupIndexedPrefixHumanReadable           = "ValidatorLookupIndexedPrefix"
 )
@@ -71,6 +71,9 @@ type KVStore struct {
 	validators *sdkcollections.IndexedMap[
 		uint64, *ctypes.Validator, index.ValidatorsIndex[*ctypes.Validator],
 	]
+	// validatorLookupIndexed stores whether the lookup indexes of the
+	// validators are maintained.
+	validatorLookupIndexed sdkcollections.Item[bool]
 	// balances stores the list of balances.
 	balances sdkcollections.Map[uint64, uint64]
 	// nextWithdrawalIndex stores the next global withdrawal index.
@@ -195,6 +198,12 @@ func New(kss store.KVStoreService) *KVStore {
 			},
 			index.NewValidatorsIndex[*ctypes.Validator](schemaBuilder),
 		),
+		validatorLookupIndexed: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.ValidatorLookupIndexedPrefix}),
+			keys.ValidatorLookupIndexedPrefixHumanReadable,
+			sdkcollections.BoolValue,
+		),
 		balances: sdkcollections.NewMap(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte{keys.BalancesPrefix}),
@@ -22,8 +22,12 @@ package beacondb
 
 import (
 	"errors"
+	"slices"
 
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
@@ -40,6 +44,9 @@ func (kv *KVStore) AddValidator(val *ctypes.Validator) error {
 	if err = kv.validators.Set(kv.ctx, idx, val); err != nil {
 		return err
 	}
+	if err = kv.referenceValidatorLookups(idx, val, false); err != nil {
+		return err
+	}
 
 	kv.validatorsCache = nil
 	return kv.balances.Set(kv.ctx, idx, 0)
@@ -51,9 +58,71 @@ func (kv *KVStore) UpdateValidatorAtIndex(
 	val *ctypes.Validator,
 ) error {
 	kv.validatorsCache = nil
+	// The lookup indexes are referenced first, as they load the validator
+	// currently stored at the index to unreference it.
+	if err := kv.referenceValidatorLookups(index.Unwrap(), val, true); err != nil {
+		return err
+	}
 	return kv.validators.Set(kv.ctx, index.Unwrap(), val)
 }
 
+// referenceValidatorLookups references the validator stored at the given index
+// in the lookup indexes, if they are maintained. If replaced is true, the
+// validator currently stored at the index is unreferenced.
+func (kv *KVStore) referenceValidatorLookups(
+	idx uint64, val *ctypes.Validator, replaced bool,
+) error {
+	indexed, err := kv.validatorLookupIndexed.Has(kv.ctx)
+	if err != nil || !indexed {
+		return err
+	}
+
+	var old *ctypes.Validator
+	if replaced {
+		old, err = kv.validators.Get(kv.ctx, idx)
+		if err != nil && !errors.Is(err, sdkcollections.ErrNotFound) {
+			return err
+		}
+	}
+	lazyOldValue := func() (*ctypes.Validator, error) {
+		if old == nil {
+			return nil, sdkcollections.ErrNotFound
+		}
+		return old, nil
+	}
+	for _, lookup := range kv.validators.Indexes.LookupIndexesList() {
+		if err = lookup.Reference(kv.ctx, idx, val, lazyOldValue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexValidatorLookups starts maintaining the lookup indexes of the
+// validators and references the validators already in the registry. It does
+// nothing if the lookup indexes are already maintained.
+func (kv *KVStore) IndexValidatorLookups() error {
+	indexed, err := kv.validatorLookupIndexed.Has(kv.ctx)
+	if err != nil || indexed {
+		return err
+	}
+	if err = kv.validatorLookupIndexed.Set(kv.ctx, true); err != nil {
+		return err
+	}
+
+	vals, err := kv.GetValidators()
+	if err != nil {
+		return err
+	}
+	for i, val := range vals {
+		// #nosec:G115 // Safe as i comes from range loop
+		if err = kv.referenceValidatorLookups(uint64(i), val, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ValidatorIndexByPubkey returns the validator address by index.
 func (kv *KVStore) ValidatorIndexByPubkey(
 	pubkey crypto.BLSPubkey,
@@ -82,6 +151,156 @@ func (kv *KVStore) ValidatorIndexByCometBFTAddress(
 	return math.ValidatorIndex(idx), nil
 }
 
+// ValidatorIndicesByWithdrawalCredentials returns the indices of the validators
+// with the given withdrawal credentials, in ascending order.
+func (kv *KVStore) ValidatorIndicesByWithdrawalCredentials(
+	credentials ctypes.WithdrawalCredentials,
+) ([]math.ValidatorIndex, error) {
+	indexed, err := kv.validatorLookupIndexed.Has(kv.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !indexed {
+		return kv.scanValidatorIndices(func(val *ctypes.Validator) (bool, error) {
+			return val.GetWithdrawalCredentials() == credentials, nil
+		})
+	}
+
+	iter, err := kv.validators.Indexes.WithdrawalCredentials.MatchExact(
+		kv.ctx,
+		credentials[:],
+	)
+	if err != nil {
+		return nil, err
+	}
+	return primaryKeysToIndices(iter)
+}
+
+// ValidatorIndicesByStatus returns the indices of the validators having any of
+// the given statuses at the given epoch, in ascending order. Since statuses
+// depend on the epoch they are not indexed themselves: candidates are looked
+// up through the activation and exit epoch indexes and only those are loaded
+// to compute their status. Before the lookup indexes are maintained, the whole
+// registry is scanned instead.
+func (kv *KVStore) ValidatorIndicesByStatus(
+	epoch math.Epoch,
+	statuses ...string,
+) ([]math.ValidatorIndex, error) {
+	hasStatus := func(val *ctypes.Validator) (bool, error) {
+		status, err := val.Status(epoch)
+		if err != nil {
+			return false, err
+		}
+		return slices.Contains(statuses, status), nil
+	}
+	indexed, err := kv.validatorLookupIndexed.Has(kv.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !indexed {
+		return kv.scanValidatorIndices(hasStatus)
+	}
+
+	var (
+		candidates []math.ValidatorIndex
+		// Epochs are compared through the first epoch after the given one, so
+		// that ranges only need inclusive starts and exclusive ends.
+		next = sdkcollections.PairPrefix[uint64, uint64](epoch.Unwrap() + 1)
+	)
+	for _, lookup := range []struct {
+		statuses []string
+		index    *indexes.Multi[uint64, uint64, *ctypes.Validator]
+		ranger   *sdkcollections.Range[sdkcollections.Pair[uint64, uint64]]
+	}{
+		{
+			// Pending validators activate after the epoch.
+			statuses: []string{
+				constants.ValidatorStatusPendingInitialized,
+				constants.ValidatorStatusPendingQueued,
+			},
+			index:  kv.validators.Indexes.ActivationEpoch,
+			ranger: new(sdkcollections.Range[sdkcollections.Pair[uint64, uint64]]).StartInclusive(next),
+		},
+		{
+			// Active validators exit after the epoch.
+			statuses: []string{
+				constants.ValidatorStatusActiveOngoing,
+				constants.ValidatorStatusActiveExiting,
+				constants.ValidatorStatusActiveSlashed,
+			},
+			index:  kv.validators.Indexes.ExitEpoch,
+			ranger: new(sdkcollections.Range[sdkcollections.Pair[uint64, uint64]]).StartInclusive(next),
+		},
+		{
+			// Exited and withdrawable validators exited by the epoch.
+			statuses: []string{
+				constants.ValidatorStatusExitedUnslashed,
+				constants.ValidatorStatusExitedSlashed,
+				constants.ValidatorStatusWithdrawalPossible,
+				constants.ValidatorStatusWithdrawalDone,
+			},
+			index:  kv.validators.Indexes.ExitEpoch,
+			ranger: new(sdkcollections.Range[sdkcollections.Pair[uint64, uint64]]).EndExclusive(next),
+		},
+	} {
+		if !slices.ContainsFunc(lookup.statuses, func(status string) bool {
+			return slices.Contains(statuses, status)
+		}) {
+			continue
+		}
+		iter, err := lookup.index.Iterate(kv.ctx, lookup.ranger)
+		if err != nil {
+			return nil, err
+		}
+		indices, err := primaryKeysToIndices(iter)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, indices...)
+	}
+	slices.Sort(candidates)
+	candidates = slices.Compact(candidates)
+
+	matching := candidates[:0]
+	for _, idx := range candidates {
+		val, err := kv.ValidatorByIndex(idx)
+		if err != nil {
+			return nil, err
+		}
+		match, err := hasStatus(val)
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			matching = append(matching, idx)
+		}
+	}
+	return matching, nil
+}
+
+// scanValidatorIndices returns the indices of the validators of the registry
+// matching the given predicate, in ascending order.
+func (kv *KVStore) scanValidatorIndices(
+	match func(*ctypes.Validator) (bool, error),
+) ([]math.ValidatorIndex, error) {
+	vals, err := kv.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	var indices []math.ValidatorIndex
+	for i, val := range vals {
+		ok, err := match(val)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			// #nosec:G115 // Safe as i comes from range loop
+			indices = append(indices, math.ValidatorIndex(i))
+		}
+	}
+	return indices, nil
+}
+
 // ValidatorByIndex returns the validator address by index.
 func (kv *KVStore) ValidatorByIndex(
 	index math.ValidatorIndex,
@@ -193,3 +412,19 @@ func (kv *KVStore) SetPendingPartialWithdrawals(pendingPartialWithdrawals []*cty
 	ppw := ctypes.PendingPartialWithdrawals(pendingPartialWithdrawals)
 	return kv.pendingPartialWithdrawals.Set(kv.ctx, &ppw)
 }
+
+// primaryKeysToIndices consumes a validators index iterator and returns the
+// validator indices it references.
+func primaryKeysToIndices[ReferenceKey any](
+	iter indexes.MultiIterator[ReferenceKey, uint64],
+) ([]math.ValidatorIndex, error) {
+	keys, err := iter.PrimaryKeys()
+	if err != nil {
+		return nil, err
+	}
+	indices := make([]math.ValidatorIndex, len(keys))
+	for i, key := range keys {
+		indices[i] = math.ValidatorIndex(key)
+	}
+	return indices, nil
+}
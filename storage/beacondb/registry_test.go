@@ -32,6 +32,8 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/storage"
 	"github.com/berachain/beacon-kit/storage/beacondb"
@@ -194,6 +196,110 @@ func TestValidators(t *testing.T) {
 	require.Equal(t, inUpdatedVal2, res[1])
 }
 
+func TestValidatorIndicesByWithdrawalCredentialsAndStatus(t *testing.T) {
+	t.Parallel()
+
+	var (
+		credsA = types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x0a})
+		credsB = types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x0b})
+		epoch  = math.Epoch(10)
+	)
+	newValidator := func(
+		pubkey byte, credentials types.WithdrawalCredentials, activation, exit math.Epoch,
+	) *types.Validator {
+		return &types.Validator{
+			Pubkey:                     bytes.B48{pubkey},
+			WithdrawalCredentials:      credentials,
+			EffectiveBalance:           math.Gwei(32e9),
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            activation,
+			ExitEpoch:                  exit,
+			WithdrawableEpoch:          constants.FarFutureEpoch,
+		}
+	}
+
+	tests := []struct {
+		name string
+		// indexAfter is the number of validators added before the lookup
+		// indexes are maintained, or -1 if they never are.
+		indexAfter int
+	}{
+		{name: "before lookup indexes fork", indexAfter: -1},
+		{name: "from genesis", indexAfter: 0},
+		{name: "backfilled at lookup indexes fork", indexAfter: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			store, err := initTestStore()
+			require.NoError(t, err)
+
+			validators := []*types.Validator{
+				newValidator(0x01, credsA, 0, constants.FarFutureEpoch),
+				newValidator(0x02, credsB, 0, constants.FarFutureEpoch),
+				newValidator(0x03, credsA, epoch+1, constants.FarFutureEpoch),
+				newValidator(0x04, types.WithdrawalCredentials{0x00, 0x01}, 0, epoch),
+				newValidator(0x05, credsA, 0, epoch),
+			}
+			for i, val := range validators {
+				if i == tt.indexAfter {
+					require.NoError(t, store.IndexValidatorLookups())
+				}
+				require.NoError(t, store.AddValidator(val))
+			}
+			if tt.indexAfter >= 0 {
+				// Indexing again is a no-op.
+				require.NoError(t, store.IndexValidatorLookups())
+			}
+
+			// lookup by withdrawal credentials
+			indices, err := store.ValidatorIndicesByWithdrawalCredentials(credsA)
+			require.NoError(t, err)
+			require.Equal(t, []math.ValidatorIndex{0, 2, 4}, indices)
+			indices, err = store.ValidatorIndicesByWithdrawalCredentials(credsB)
+			require.NoError(t, err)
+			require.Equal(t, []math.ValidatorIndex{1}, indices)
+			indices, err = store.ValidatorIndicesByWithdrawalCredentials(types.WithdrawalCredentials{})
+			require.NoError(t, err)
+			require.Empty(t, indices)
+
+			// lookup by status
+			indices, err = store.ValidatorIndicesByStatus(epoch, constants.ValidatorStatusActiveOngoing)
+			require.NoError(t, err)
+			require.Equal(t, []math.ValidatorIndex{0, 1}, indices)
+			indices, err = store.ValidatorIndicesByStatus(
+				epoch,
+				constants.ValidatorStatusExitedUnslashed,
+				constants.ValidatorStatusPendingQueued,
+				constants.ValidatorStatusExitedUnslashed,
+			)
+			require.NoError(t, err)
+			require.Equal(t, []math.ValidatorIndex{2, 3, 4}, indices)
+			indices, err = store.ValidatorIndicesByStatus(epoch, constants.ValidatorStatusActiveSlashed)
+			require.NoError(t, err)
+			require.Empty(t, indices)
+
+			// statuses are computed at the requested epoch
+			indices, err = store.ValidatorIndicesByStatus(epoch-1, constants.ValidatorStatusActiveExiting)
+			require.NoError(t, err)
+			require.Equal(t, []math.ValidatorIndex{3, 4}, indices)
+
+			// updates move validators across the indexes
+			updated := newValidator(0x02, credsA, 0, epoch)
+			require.NoError(t, store.UpdateValidatorAtIndex(1, updated))
+			indices, err = store.ValidatorIndicesByWithdrawalCredentials(credsA)
+			require.NoError(t, err)
+			require.Equal(t, []math.ValidatorIndex{0, 1, 2, 4}, indices)
+			indices, err = store.ValidatorIndicesByWithdrawalCredentials(credsB)
+			require.NoError(t, err)
+			require.Empty(t, indices)
+			indices, err = store.ValidatorIndicesByStatus(epoch, constants.ValidatorStatusExitedUnslashed)
+			require.NoError(t, err)
+			require.Equal(t, []math.ValidatorIndex{1, 3, 4}, indices)
+		})
+	}
+}
+
 // TestPendingPartialWithdrawals_Nil verifies that if no pending partial withdrawals
 // have been set, then GetPendingPartialWithdrawals returns an error.
 func TestPendingPartialWithdrawals_Nil(t *testing.T) {
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+const (
+	slotPrefix byte = iota
+	blockRootPrefix
+	stateRootPrefix
+	timestampPrefix
+)
+
+const (
+	slotSize  = 8
+	rootSize  = 32
+	entrySize = 2 * rootSize
+)
+
+// entry is the record stored for each indexed slot. The timestamp is nil for
+// slots backfilled from the beacon state.
+type entry struct {
+	blockRoot common.Root
+	stateRoot common.Root
+	timestamp *math.U64
+}
+
+func (e *entry) marshal() []byte {
+	bz := make([]byte, 0, entrySize+slotSize)
+	bz = append(bz, e.blockRoot[:]...)
+	bz = append(bz, e.stateRoot[:]...)
+	if e.timestamp != nil {
+		bz = binary.BigEndian.AppendUint64(bz, e.timestamp.Unwrap())
+	}
+	return bz
+}
+
+func unmarshalEntry(bz []byte) (*entry, error) {
+	if len(bz) != entrySize && len(bz) != entrySize+slotSize {
+		return nil, fmt.Errorf("invalid block store entry size: %d", len(bz))
+	}
+	e := &entry{
+		blockRoot: common.Root(bz[:rootSize]),
+		stateRoot: common.Root(bz[rootSize:entrySize]),
+	}
+	if len(bz) > entrySize {
+		ts := math.U64(binary.BigEndian.Uint64(bz[entrySize:]))
+		e.timestamp = &ts
+	}
+	return e, nil
+}
+
+// Slots and timestamps are encoded big endian to retain ordering.
+func encodeSlot(slot math.Slot) []byte {
+	return binary.BigEndian.AppendUint64(make([]byte, 0, slotSize), slot.Unwrap())
+}
+
+func decodeSlot(bz []byte) (math.Slot, error) {
+	if len(bz) != slotSize {
+		return 0, fmt.Errorf("invalid block store slot size: %d", len(bz))
+	}
+	return math.Slot(binary.BigEndian.Uint64(bz)), nil
+}
+
+func slotKey(slot math.Slot) []byte {
+	return append([]byte{slotPrefix}, encodeSlot(slot)...)
+}
+
+func blockRootKey(root common.Root) []byte {
+	return append([]byte{blockRootPrefix}, root[:]...)
+}
+
+func stateRootKey(root common.Root) []byte {
+	return append([]byte{stateRootPrefix}, root[:]...)
+}
+
+func timestampKey(timestamp math.U64) []byte {
+	return append([]byte{timestampPrefix}, encodeSlot(timestamp)...)
+}
@@ -22,73 +22,81 @@ package block
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
-	lru "github.com/hashicorp/golang-lru/v2"
+	dbm "github.com/cosmos/cosmos-db"
 )
 
 var ErrBlockStoreNotEnabled = errors.New("block store not enabled")
 
-// KVStore is a simple memory store based implementation that stores metadata of
-// beacon blocks.
+// KVStore is a key-value store based implementation that stores metadata of
+// beacon blocks. Entries are persisted in the underlying database so that
+// lookups keep working across restarts, and are pruned once they fall out of
+// the availability window.
 type KVStore[BeaconBlockT BeaconBlock] struct {
 	// Setting availabilityWindow to zero upon construction
-	// causes caches to not be instantiated. Setter will fail
+	// causes the store to be disabled. Setter will fail
 	// silently while Getters will err.
 	enabled bool
 
-	// Beacon block root to slot mapping is injective for finalized blocks.
-	blockRoots *lru.Cache[common.Root, math.Slot]
+	// availabilityWindow is the number of slots kept in the store.
+	availabilityWindow uint64
 
-	// Timestamp to slot mapping is injective for finalized blocks. This is
-	// guaranteed by CometBFT consensus. So each slot will be associated with a
-	// different timestamp (no overwriting) as we store only finalized blocks.
-	timestamps *lru.Cache[math.U64, math.Slot]
+	// db holds the indices. It maps:
+	//   - block root to slot, injective for finalized blocks.
+	//   - timestamp to slot, injective for finalized blocks. This is
+	//     guaranteed by CometBFT consensus. So each slot will be associated
+	//     with a different timestamp (no overwriting) as we store only
+	//     finalized blocks.
+	//   - state root to slot, injective for finalized blocks.
+	//   - slot to the record above, used to prune the reverse indices.
+	db dbm.DB
 
-	// Beacon state root to slot mapping is injective for finalized blocks.
-	stateRoots *lru.Cache[common.Root, math.Slot]
+	// closeOnce guarantees db is closed at most once.
+	closeOnce sync.Once
 
 	// Logger for the store.
 	logger log.Logger
 }
 
-// NewStore creates a new block store.
+// NewStore creates a new block store backed by an in-memory database.
 func NewStore[BeaconBlockT BeaconBlock](
 	logger log.Logger,
 	availabilityWindow int,
 ) *KVStore[BeaconBlockT] {
-	kvStore := &KVStore[BeaconBlockT]{
-		enabled: availabilityWindow != 0,
-		logger:  logger,
+	return NewStoreFromDB[BeaconBlockT](dbm.NewMemDB(), logger, availabilityWindow)
+}
+
+// NewStoreFromDB creates a new block store persisting its indices in db.
+func NewStoreFromDB[BeaconBlockT BeaconBlock](
+	db dbm.DB,
+	logger log.Logger,
+	availabilityWindow int,
+) *KVStore[BeaconBlockT] {
+	if availabilityWindow < 0 {
+		panic(fmt.Errorf("invalid block store availability window: %d", availabilityWindow))
 	}
-	if !kvStore.enabled {
-		// caches instantiations would fail with zero size
-		// so we return early
-		return kvStore
+	return &KVStore[BeaconBlockT]{
+		enabled:            availabilityWindow != 0,
+		availabilityWindow: uint64(availabilityWindow),
+		db:                 db,
+		logger:             logger,
 	}
+}
 
+// Close closes the underlying database. It is safe to call multiple times.
+func (kv *KVStore[BeaconBlockT]) Close() error {
 	var err error
-	kvStore.blockRoots, err = lru.New[common.Root, math.Slot](availabilityWindow)
-	if err != nil {
-		panic(fmt.Errorf("failed instantiating kvStore blockRoots: %w", err))
-	}
-	kvStore.timestamps, err = lru.New[math.U64, math.Slot](availabilityWindow)
-	if err != nil {
-		panic(fmt.Errorf("failed instantiating kvStore timestamps: %w", err))
-	}
-	kvStore.stateRoots, err = lru.New[common.Root, math.Slot](availabilityWindow)
-	if err != nil {
-		panic(fmt.Errorf("failed instantiating kvStore stateRoots: %w", err))
-	}
-	return kvStore
+	kv.closeOnce.Do(func() { err = kv.db.Close() })
+	return err
 }
 
 // Set sets the block in the store, storing the block root, timestamp, and state root.
-// Only this function may potentially evict entries from the store if the availability
-// window is reached.
+// Entries falling out of the availability window are pruned.
 func (kv *KVStore[BeaconBlockT]) Set(blk BeaconBlockT) error {
 	if !kv.enabled {
 		// nothing to do if store is disabled
@@ -96,11 +104,38 @@ func (kv *KVStore[BeaconBlockT]) Set(blk BeaconBlockT) error {
 		return nil
 	}
 
-	slot := blk.GetSlot()
-	kv.blockRoots.Add(blk.HashTreeRoot(), slot)
-	kv.timestamps.Add(blk.GetTimestamp(), slot)
-	kv.stateRoots.Add(blk.GetStateRoot(), slot)
-	return nil
+	ts := blk.GetTimestamp()
+	return kv.set(blk.GetSlot(), &entry{
+		blockRoot: blk.HashTreeRoot(),
+		stateRoot: blk.GetStateRoot(),
+		timestamp: &ts,
+	})
+}
+
+// Backfill indexes the block and state roots of the given slot, unless the slot is
+// already indexed or falls out of the availability window of head. It is used to
+// rebuild indices from the beacon state, which does not keep block timestamps, so
+// backfilled slots cannot be looked up by timestamp.
+func (kv *KVStore[BeaconBlockT]) Backfill(
+	head math.Slot, slot math.Slot, blockRoot, stateRoot common.Root,
+) error {
+	if !kv.enabled || slot > head || head.Unwrap()-slot.Unwrap() >= kv.availabilityWindow {
+		return nil
+	}
+
+	found, err := kv.db.Has(slotKey(slot))
+	if err != nil {
+		return fmt.Errorf("failed checking block store at slot %d: %w", slot, err)
+	}
+	if found {
+		return nil
+	}
+	return kv.set(slot, &entry{blockRoot: blockRoot, stateRoot: stateRoot})
+}
+
+// AvailabilityWindow returns the number of slots kept in the store.
+func (kv *KVStore[BeaconBlockT]) AvailabilityWindow() uint64 {
+	return kv.availabilityWindow
 }
 
 // GetSlotByBlockRoot retrieves the slot by a given block root from the store.
@@ -109,7 +144,10 @@ func (kv *KVStore[BeaconBlockT]) GetSlotByBlockRoot(blockRoot common.Root) (math
 		return math.Slot(0), ErrBlockStoreNotEnabled
 	}
 
-	slot, ok := kv.blockRoots.Peek(blockRoot)
+	slot, ok, err := kv.getSlot(blockRootKey(blockRoot))
+	if err != nil {
+		return 0, err
+	}
 	if !ok {
 		return 0, fmt.Errorf("slot not found at block root: %s", blockRoot)
 	}
@@ -123,7 +161,10 @@ func (kv *KVStore[BeaconBlockT]) GetParentSlotByTimestamp(timestamp math.U64) (m
 		return math.Slot(0), ErrBlockStoreNotEnabled
 	}
 
-	slot, ok := kv.timestamps.Peek(timestamp)
+	slot, ok, err := kv.getSlot(timestampKey(timestamp))
+	if err != nil {
+		return 0, err
+	}
 	if !ok {
 		return slot, fmt.Errorf("slot not found at timestamp: %d", timestamp)
 	}
@@ -140,9 +181,92 @@ func (kv *KVStore[BeaconBlockT]) GetSlotByStateRoot(stateRoot common.Root) (math
 		return math.Slot(0), ErrBlockStoreNotEnabled
 	}
 
-	slot, ok := kv.stateRoots.Peek(stateRoot)
+	slot, ok, err := kv.getSlot(stateRootKey(stateRoot))
+	if err != nil {
+		return 0, err
+	}
 	if !ok {
 		return 0, fmt.Errorf("slot not found at state root: %s", stateRoot)
 	}
 	return slot, nil
 }
+
+// set atomically writes the entry of the given slot along with its reverse
+// indices, and prunes entries falling out of the availability window.
+func (kv *KVStore[BeaconBlockT]) set(slot math.Slot, e *entry) error {
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	// Prune first, so that reverse indices shared with a pruned entry are
+	// rewritten rather than deleted.
+	if slot.Unwrap() >= kv.availabilityWindow {
+		if err := kv.prune(batch, slot-math.Slot(kv.availabilityWindow)+1); err != nil {
+			return err
+		}
+	}
+
+	slotBz := encodeSlot(slot)
+	if err := batch.Set(slotKey(slot), e.marshal()); err != nil {
+		return err
+	}
+	if err := batch.Set(blockRootKey(e.blockRoot), slotBz); err != nil {
+		return err
+	}
+	if err := batch.Set(stateRootKey(e.stateRoot), slotBz); err != nil {
+		return err
+	}
+	if e.timestamp != nil {
+		if err := batch.Set(timestampKey(*e.timestamp), slotBz); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("failed writing block store at slot %d: %w", slot, err)
+	}
+	return nil
+}
+
+// prune adds to batch the deletion of all entries strictly below the given slot.
+func (kv *KVStore[BeaconBlockT]) prune(batch dbm.Batch, below math.Slot) error {
+	it, err := kv.db.Iterator(slotKey(0), slotKey(below))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var e *entry
+		if e, err = unmarshalEntry(it.Value()); err != nil {
+			return err
+		}
+		if err = batch.Delete(it.Key()); err != nil {
+			return err
+		}
+		if err = batch.Delete(blockRootKey(e.blockRoot)); err != nil {
+			return err
+		}
+		if err = batch.Delete(stateRootKey(e.stateRoot)); err != nil {
+			return err
+		}
+		if e.timestamp != nil {
+			if err = batch.Delete(timestampKey(*e.timestamp)); err != nil {
+				return err
+			}
+		}
+	}
+	return it.Error()
+}
+
+// getSlot returns the slot stored at the given index key, if any.
+func (kv *KVStore[BeaconBlockT]) getSlot(key []byte) (math.Slot, bool, error) {
+	bz, err := kv.db.Get(key)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed reading block store: %w", err)
+	}
+	if bz == nil {
+		return 0, false, nil
+	}
+	slot, err := decodeSlot(bz)
+	return slot, err == nil, err
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/storage/block"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 )
 
@@ -117,3 +118,71 @@ func TestBlockStoreZeroSize(t *testing.T) {
 	_, err = blockStore.GetParentSlotByTimestamp(2)
 	require.ErrorIs(t, err, block.ErrBlockStoreNotEnabled)
 }
+
+func TestBlockStorePersistence(t *testing.T) {
+	t.Parallel()
+	db := dbm.NewMemDB()
+	blockStore := block.NewStoreFromDB[*MockBeaconBlock](db, noop.NewLogger[any](), 5)
+	for i := 1; i <= 7; i++ {
+		require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)}))
+	}
+
+	// Reopening the store on the same database must retain the indices.
+	blockStore = block.NewStoreFromDB[*MockBeaconBlock](db, noop.NewLogger[any](), 5)
+	for i := math.Slot(3); i <= 7; i++ {
+		slot, err := blockStore.GetSlotByBlockRoot([32]byte{byte(i)})
+		require.NoError(t, err)
+		require.Equal(t, i, slot)
+
+		slot, err = blockStore.GetParentSlotByTimestamp(i)
+		require.NoError(t, err)
+		require.Equal(t, i-1, slot)
+
+		slot, err = blockStore.GetSlotByStateRoot([32]byte{byte(i)})
+		require.NoError(t, err)
+		require.Equal(t, i, slot)
+	}
+
+	// Pruned entries must be gone from every index.
+	_, err := blockStore.GetSlotByBlockRoot([32]byte{byte(2)})
+	require.ErrorContains(t, err, "not found")
+	_, err = blockStore.GetSlotByStateRoot([32]byte{byte(2)})
+	require.ErrorContains(t, err, "not found")
+	_, err = blockStore.GetParentSlotByTimestamp(2)
+	require.ErrorContains(t, err, "not found")
+
+	// Setting a new block keeps pruning entries left over from before the restart.
+	require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: 8}))
+	_, err = blockStore.GetSlotByBlockRoot([32]byte{byte(3)})
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestBlockStoreBackfill(t *testing.T) {
+	t.Parallel()
+	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 5)
+	require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: 10}))
+
+	// Backfill slots 1 to 10. Only the ones in the window and not yet indexed are stored.
+	for i := math.Slot(1); i <= 10; i++ {
+		require.NoError(t, blockStore.Backfill(10, i, [32]byte{byte(i)}, [32]byte{byte(i)}))
+	}
+
+	for i := math.Slot(6); i <= 10; i++ {
+		slot, err := blockStore.GetSlotByBlockRoot([32]byte{byte(i)})
+		require.NoError(t, err)
+		require.Equal(t, i, slot)
+
+		slot, err = blockStore.GetSlotByStateRoot([32]byte{byte(i)})
+		require.NoError(t, err)
+		require.Equal(t, i, slot)
+	}
+	_, err := blockStore.GetSlotByBlockRoot([32]byte{byte(5)})
+	require.ErrorContains(t, err, "not found")
+
+	// Timestamps are not known for backfilled slots, only for the block that was set.
+	_, err = blockStore.GetParentSlotByTimestamp(9)
+	require.ErrorContains(t, err, "not found")
+	slot, err := blockStore.GetParentSlotByTimestamp(10)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(9), slot)
+}
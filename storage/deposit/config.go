@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+// Config is the configuration for the deposit store.
+type Config struct {
+	// Pruning determines if deposits are replaced by their EIP-4881 snapshot once
+	// the deposit queue is exhausted at Fulu. Archive nodes should keep it disabled
+	// to serve every deposit from the node-api.
+	Pruning bool `mapstructure:"pruning"`
+	// SnapshotPath is the path to an EIP-4881 deposit snapshot, in the JSON format
+	// served by the deposit_snapshot endpoint, used to initialize an empty store.
+	SnapshotPath string `mapstructure:"snapshot-path"`
+}
+
+// DefaultConfig returns the default configuration for the deposit store.
+func DefaultConfig() Config {
+	return Config{
+		Pruning:      false,
+		SnapshotPath: "",
+	}
+}
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapshot
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
+	"github.com/berachain/beacon-kit/primitives/merkle/zero"
+)
+
+// ErrRootMismatch is returned when a snapshot deposit root does not match the
+// root of its finalized branch.
+var ErrRootMismatch = errors.New("deposit snapshot root mismatch")
+
+// Snapshot is an EIP-4881 snapshot of the deposit tree, summarizing its first
+// DepositCount deposits with the roots of the complete subtrees covering them.
+//
+// Leaves of the deposit tree are the hash tree roots of the deposits, so that
+// DepositRoot matches the hash tree root of the list of the first DepositCount
+// deposits, as carried in Eth1Data.
+type Snapshot struct {
+	Finalized            []common.Root        `json:"finalized"`
+	DepositRoot          common.Root          `json:"deposit_root"`
+	DepositCount         uint64               `json:"deposit_count,string"`
+	ExecutionBlockHash   common.ExecutionHash `json:"execution_block_hash"`
+	ExecutionBlockHeight uint64               `json:"execution_block_height,string"`
+}
+
+// Tree is an append-only deposit tree which, as per EIP-4881, only keeps the
+// roots of the complete subtrees covering its leaves.
+type Tree struct {
+	// finalized holds the roots of the complete subtrees, largest first.
+	// There is one per bit set in count, the largest matching the highest bit.
+	finalized []common.Root
+	count     uint64
+}
+
+// NewTree returns an empty deposit tree.
+func NewTree() *Tree {
+	return &Tree{}
+}
+
+// FromSnapshot rebuilds the deposit tree from a snapshot, verifying that its
+// finalized branch matches the snapshot deposit root.
+func FromSnapshot(s *Snapshot) (*Tree, error) {
+	if len(s.Finalized) != onesCount(s.DepositCount) {
+		return nil, fmt.Errorf(
+			"invalid deposit snapshot, %d finalized roots for %d deposits", len(s.Finalized), s.DepositCount,
+		)
+	}
+	t := &Tree{
+		finalized: append([]common.Root(nil), s.Finalized...),
+		count:     s.DepositCount,
+	}
+	if root := t.Root(); root != s.DepositRoot {
+		return nil, fmt.Errorf("%w, expected %s, computed %s", ErrRootMismatch, s.DepositRoot, root)
+	}
+	return t, nil
+}
+
+// Push appends the hash tree root of a deposit to the tree.
+func (t *Tree) Push(leaf common.Root) {
+	node := leaf
+	for height := 0; (t.count>>height)&1 == 1; height++ {
+		last := len(t.finalized) - 1
+		node = hashPair(t.finalized[last], node)
+		t.finalized = t.finalized[:last]
+	}
+	t.finalized = append(t.finalized, node)
+	t.count++
+}
+
+// Count returns the number of deposits in the tree.
+func (t *Tree) Count() uint64 {
+	return t.count
+}
+
+// Root returns the root of the deposit tree, mixed in with the deposit count.
+func (t *Tree) Root() common.Root {
+	var (
+		node = common.Root(zero.Hashes[0])
+		idx  = len(t.finalized) - 1
+	)
+	for height := range constants.DepositContractDepth {
+		if (t.count>>height)&1 == 1 {
+			node = hashPair(t.finalized[idx], node)
+			idx--
+		} else {
+			node = hashPair(node, zero.Hashes[height])
+		}
+	}
+
+	var length common.Root
+	binary.LittleEndian.PutUint64(length[:], t.count)
+	return hashPair(node, length)
+}
+
+// Snapshot returns the snapshot of the tree, tagged with the execution block
+// of its latest deposit.
+func (t *Tree) Snapshot(blockHash common.ExecutionHash, blockHeight uint64) *Snapshot {
+	return &Snapshot{
+		Finalized:            append([]common.Root(nil), t.finalized...),
+		DepositRoot:          t.Root(),
+		DepositCount:         t.count,
+		ExecutionBlockHash:   blockHash,
+		ExecutionBlockHeight: blockHeight,
+	}
+}
+
+func hashPair[L, R ~[32]byte](left L, right R) common.Root {
+	var buf [64]byte
+	copy(buf[:32], left[:])
+	copy(buf[32:], right[:])
+	return sha256.Hash(buf[:])
+}
+
+func onesCount(n uint64) int {
+	count := 0
+	for ; n > 0; n &= n - 1 {
+		count++
+	}
+	return count
+}
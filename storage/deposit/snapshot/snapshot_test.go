@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapshot_test
+
+import (
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/storage/deposit/snapshot"
+	"github.com/stretchr/testify/require"
+)
+
+func testDeposits(n int) ctypes.Deposits {
+	deposits := make(ctypes.Deposits, 0, n)
+	for i := range n {
+		b := uint8(i % 255)
+		deposits = append(deposits, &ctypes.Deposit{
+			Pubkey:      [48]byte{b},
+			Credentials: ctypes.NewCredentialsFromExecutionAddress(common.ExecutionAddress{b}),
+			Amount:      10_000,
+			Signature:   crypto.BLSSignature{b},
+			Index:       uint64(i),
+		})
+	}
+	return deposits
+}
+
+func TestTreeRootMatchesDepositsRoot(t *testing.T) {
+	t.Parallel()
+
+	deposits := testDeposits(40)
+	tree := snapshot.NewTree()
+	require.Equal(t, ctypes.Deposits{}.HashTreeRoot(), tree.Root())
+
+	for i, dep := range deposits {
+		tree.Push(dep.HashTreeRoot())
+		require.Equal(t, uint64(i+1), tree.Count())
+		require.Equal(t, deposits[:i+1].HashTreeRoot(), tree.Root(), "count %d", i+1)
+	}
+}
+
+func TestFromSnapshot(t *testing.T) {
+	t.Parallel()
+
+	deposits := testDeposits(13)
+	tree := snapshot.NewTree()
+	for _, dep := range deposits[:11] {
+		tree.Push(dep.HashTreeRoot())
+	}
+	s := tree.Snapshot(common.ExecutionHash{0x01}, 42)
+	require.Equal(t, uint64(11), s.DepositCount)
+	require.Len(t, s.Finalized, 3)
+	require.Equal(t, uint64(42), s.ExecutionBlockHeight)
+
+	restored, err := snapshot.FromSnapshot(s)
+	require.NoError(t, err)
+	for _, dep := range deposits[11:] {
+		restored.Push(dep.HashTreeRoot())
+	}
+	require.Equal(t, deposits.HashTreeRoot(), restored.Root())
+
+	// Snapshots are not altered by further pushes.
+	require.Len(t, s.Finalized, 3)
+
+	// A snapshot whose root does not match its finalized branch is rejected.
+	s.DepositRoot = common.Root{0xff}
+	_, err = snapshot.FromSnapshot(s)
+	require.ErrorIs(t, err, snapshot.ErrRootMismatch)
+
+	// A snapshot with the wrong number of finalized roots is rejected.
+	s.Finalized = s.Finalized[:2]
+	_, err = snapshot.FromSnapshot(s)
+	require.Error(t, err)
+}
@@ -29,6 +29,7 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/storage/deposit/snapshot"
 	depositstorev1 "github.com/berachain/beacon-kit/storage/deposit/v1"
 	dbm "github.com/cosmos/cosmos-db"
 )
@@ -41,12 +42,24 @@ const (
 type Store interface {
 	GetDepositsByIndex(ctx context.Context, startIndex uint64, depRange uint64) (ctypes.Deposits, common.Root, error)
 	EnqueueDeposits(ctx context.Context, deposits []*ctypes.Deposit) error
+	EnqueueDepositsFromBlock(
+		ctx context.Context, deposits []*ctypes.Deposit, blockNumber uint64, blockHash common.ExecutionHash,
+	) error
+	GetDepositBlockNumber(ctx context.Context, index uint64) (uint64, error)
 	Prune(ctx context.Context, start, end uint64) error
 	Close() error
 }
 
 type StoreManager interface {
 	Store
+	// GetSnapshot returns the EIP-4881 snapshot of the first maxCount deposits,
+	// or of all the deposits in store if there are fewer.
+	GetSnapshot(ctx context.Context, maxCount uint64) (*snapshot.Snapshot, error)
+	// InitFromSnapshot initializes an empty store from an EIP-4881 snapshot.
+	InitFromSnapshot(ctx context.Context, s *snapshot.Snapshot) error
+	// PruneToSnapshot replaces the first count deposits with their EIP-4881
+	// snapshot. It is a no-op unless deposit pruning is enabled.
+	PruneToSnapshot(ctx context.Context, count uint64) error
 }
 
 var (
@@ -63,16 +76,18 @@ type generalStore struct {
 	mu             sync.RWMutex
 	currentVersion uint8
 	storeV1        *depositstorev1.KVStore
+	cfg            Config
 	logger         log.Logger
 }
 
-func NewStore(dbV1 dbm.DB, logger log.Logger) StoreManager {
+func NewStore(dbV1 dbm.DB, logger log.Logger, cfg Config) StoreManager {
 	storeV1 := depositstorev1.NewStore(dbV1, logger)
 
 	currentVersion := v1
 	return &generalStore{
 		currentVersion: currentVersion,
 		storeV1:        storeV1,
+		cfg:            cfg,
 		logger:         logger,
 	}
 }
@@ -105,6 +120,35 @@ func (gs *generalStore) EnqueueDeposits(ctx context.Context, deposits []*ctypes.
 	}
 }
 
+func (gs *generalStore) EnqueueDepositsFromBlock(
+	ctx context.Context,
+	deposits []*ctypes.Deposit,
+	blockNumber uint64,
+	blockHash common.ExecutionHash,
+) error {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	switch gs.currentVersion {
+	case v1:
+		return gs.storeV1.EnqueueDepositsFromBlock(ctx, deposits, blockNumber, blockHash)
+	default:
+		return fmt.Errorf("%w, version %d", ErrUnknownStoreVersion, gs.currentVersion)
+	}
+}
+
+func (gs *generalStore) GetDepositBlockNumber(ctx context.Context, index uint64) (uint64, error) {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+
+	switch gs.currentVersion {
+	case v1:
+		return gs.storeV1.GetDepositBlockNumber(ctx, index)
+	default:
+		return 0, fmt.Errorf("%w, version %d", ErrUnknownStoreVersion, gs.currentVersion)
+	}
+}
+
 func (gs *generalStore) Close() error {
 	gs.mu.Lock()
 	defer gs.mu.Unlock()
@@ -123,3 +167,43 @@ func (gs *generalStore) Prune(ctx context.Context, start, end uint64) error {
 		return fmt.Errorf("%w, version %d", ErrUnknownStoreVersion, gs.currentVersion)
 	}
 }
+
+func (gs *generalStore) GetSnapshot(ctx context.Context, maxCount uint64) (*snapshot.Snapshot, error) {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+
+	switch gs.currentVersion {
+	case v1:
+		return gs.storeV1.GetSnapshot(ctx, maxCount)
+	default:
+		return nil, fmt.Errorf("%w, version %d", ErrUnknownStoreVersion, gs.currentVersion)
+	}
+}
+
+func (gs *generalStore) InitFromSnapshot(ctx context.Context, s *snapshot.Snapshot) error {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	switch gs.currentVersion {
+	case v1:
+		return gs.storeV1.InitFromSnapshot(ctx, s)
+	default:
+		return fmt.Errorf("%w, version %d", ErrUnknownStoreVersion, gs.currentVersion)
+	}
+}
+
+func (gs *generalStore) PruneToSnapshot(ctx context.Context, count uint64) error {
+	if !gs.cfg.Pruning {
+		return nil
+	}
+
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	switch gs.currentVersion {
+	case v1:
+		return gs.storeV1.PruneToSnapshot(ctx, count)
+	default:
+		return fmt.Errorf("%w, version %d", ErrUnknownStoreVersion, gs.currentVersion)
+	}
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// depositBlock is the execution block a deposit was read from.
+type depositBlock struct {
+	Number uint64
+	Hash   common.ExecutionHash
+}
+
+// depositBlockCodec encodes a depositBlock as its big endian number followed
+// by its hash. Entries stored before the hash was recorded only hold the
+// number, and decode with an empty hash.
+type depositBlockCodec struct{}
+
+// Encode marshals the deposit block.
+func (depositBlockCodec) Encode(value depositBlock) ([]byte, error) {
+	bz := make([]byte, 8, 8+len(value.Hash))
+	binary.BigEndian.PutUint64(bz, value.Number)
+	return append(bz, value.Hash[:]...), nil
+}
+
+// Decode unmarshals the deposit block.
+func (depositBlockCodec) Decode(bz []byte) (depositBlock, error) {
+	var value depositBlock
+	switch len(bz) {
+	case 8:
+	case 8 + len(value.Hash):
+		copy(value.Hash[:], bz[8:])
+	default:
+		return value, fmt.Errorf("invalid deposit block length %d", len(bz))
+	}
+	value.Number = binary.BigEndian.Uint64(bz[:8])
+	return value, nil
+}
+
+// EncodeJSON is not implemented and will panic if called.
+func (depositBlockCodec) EncodeJSON(depositBlock) ([]byte, error) {
+	panic("not implemented")
+}
+
+// DecodeJSON is not implemented and will panic if called.
+func (depositBlockCodec) DecodeJSON([]byte) (depositBlock, error) {
+	panic("not implemented")
+}
+
+// Stringify returns the string representation of the deposit block.
+func (depositBlockCodec) Stringify(value depositBlock) string {
+	return fmt.Sprintf("%d (%s)", value.Number, value.Hash)
+}
+
+// ValueType returns the name of the type the codec is intended for.
+func (depositBlockCodec) ValueType() string {
+	return "depositBlock"
+}
@@ -22,6 +22,7 @@ package deposit
 
 import (
 	"context"
+	"encoding/json"
 	"sync"
 
 	sdkcollections "cosmossdk.io/collections"
@@ -31,16 +32,28 @@ import (
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/storage"
 	depositstorecommon "github.com/berachain/beacon-kit/storage/deposit/common"
+	"github.com/berachain/beacon-kit/storage/deposit/snapshot"
 	"github.com/berachain/beacon-kit/storage/encoding"
 	dbm "github.com/cosmos/cosmos-db"
 )
 
-const KeyDepositPrefix = "deposit"
+const (
+	KeyDepositPrefix      = "deposit"
+	KeyDepositBlockPrefix = "el_block"
+	KeySnapshotPrefix     = "snapshot"
+)
 
 // KVStore is a simple KV store based implementation that assumes
 // the deposit indexes are tracked outside of the kv store.
 type KVStore struct {
 	store sdkcollections.Map[uint64, *ctypes.Deposit]
+	// blocks maps a deposit index to the number and hash of the execution
+	// block the deposit was read from. It is used to serve node-api queries
+	// and to tag the EIP-4881 snapshots.
+	blocks sdkcollections.Map[uint64, depositBlock]
+	// snapshot is the JSON encoded EIP-4881 snapshot of the pruned deposits,
+	// if any. Deposits it covers are no longer available in store.
+	snapshot sdkcollections.Item[[]byte]
 
 	// closeFunc is a closure that closes the underlying database
 	// used by store to ensure that all writes are flushed to disk.
@@ -75,6 +88,19 @@ func NewStore(
 				NewEmptyF: ctypes.NewEmptyDeposit,
 			},
 		),
+		blocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyDepositBlockPrefix)),
+			KeyDepositBlockPrefix,
+			sdkcollections.Uint64Key,
+			depositBlockCodec{},
+		),
+		snapshot: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeySnapshotPrefix)),
+			KeySnapshotPrefix,
+			sdkcollections.BytesValue,
+		),
 		closeFunc: closeFunc,
 		logger:    logger,
 	}
@@ -144,6 +170,32 @@ func (kv *KVStore) EnqueueDeposits(ctx context.Context, deposits []*ctypes.Depos
 	return nil
 }
 
+// EnqueueDepositsFromBlock pushes multiple deposits to the queue, recording
+// the number and hash of the execution block they were read from.
+func (kv *KVStore) EnqueueDepositsFromBlock(
+	ctx context.Context,
+	deposits []*ctypes.Deposit,
+	blockNumber uint64,
+	blockHash common.ExecutionHash,
+) error {
+	block := depositBlock{Number: blockNumber, Hash: blockHash}
+	for _, deposit := range deposits {
+		idx := deposit.GetIndex().Unwrap()
+		if err := kv.blocks.Set(ctx, idx, block); err != nil {
+			return errors.Wrapf(err, "failed to set block of deposit %d", idx)
+		}
+	}
+	return kv.EnqueueDeposits(ctx, deposits)
+}
+
+// GetDepositBlockNumber returns the number of the execution block the deposit
+// with the given index was read from. It returns sdkcollections.ErrNotFound for
+// deposits enqueued without one, like genesis deposits.
+func (kv *KVStore) GetDepositBlockNumber(ctx context.Context, index uint64) (uint64, error) {
+	block, err := kv.blocks.Get(ctx, index)
+	return block.Number, err
+}
+
 // Prune removes the [start, end) deposits from the store.
 func (kv *KVStore) Prune(ctx context.Context, start, end uint64) error {
 	if start > end {
@@ -156,8 +208,139 @@ func (kv *KVStore) Prune(ctx context.Context, start, end uint64) error {
 		if err := kv.store.Remove(ctx, start+i); err != nil {
 			return errors.Wrapf(err, "failed to prune deposit %d", start+i)
 		}
+		if err := kv.blocks.Remove(ctx, start+i); err != nil {
+			return errors.Wrapf(err, "failed to prune block of deposit %d", start+i)
+		}
 	}
 
 	kv.logger.Debug("Pruned deposits", "start", start, "end", end)
 	return nil
 }
+
+// GetSnapshot returns the EIP-4881 snapshot of the deposit tree made of the
+// first maxCount deposits, or of all deposits in store if there are fewer.
+// The execution block is the one of the latest deposit, if known. It is left
+// empty for deposits enqueued without one, like genesis deposits.
+func (kv *KVStore) GetSnapshot(ctx context.Context, maxCount uint64) (*snapshot.Snapshot, error) {
+	base, err := kv.getSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if maxCount < base.DepositCount {
+		return nil, errors.Wrapf(
+			storage.ErrInvalidRange,
+			"deposits up to %d have been pruned, requested %d", base.DepositCount, maxCount,
+		)
+	}
+	tree, err := snapshot.FromSnapshot(base)
+	if err != nil {
+		return nil, err
+	}
+
+	for tree.Count() < maxCount {
+		deposit, errGet := kv.store.Get(ctx, tree.Count())
+		if errors.Is(errGet, sdkcollections.ErrNotFound) {
+			break
+		}
+		if errGet != nil {
+			return nil, errors.Wrapf(errGet, "failed to get deposit %d", tree.Count())
+		}
+		tree.Push(deposit.HashTreeRoot())
+	}
+	if tree.Count() == base.DepositCount {
+		return base, nil
+	}
+
+	block, err := kv.blocks.Get(ctx, tree.Count()-1)
+	if err != nil && !errors.Is(err, sdkcollections.ErrNotFound) {
+		return nil, errors.Wrapf(err, "failed to get block of deposit %d", tree.Count()-1)
+	}
+	return tree.Snapshot(block.Hash, block.Number), nil
+}
+
+// InitFromSnapshot initializes an empty store from the given snapshot, so
+// that deposits following it can be enqueued without the ones it covers.
+// It is a no-op if the store already holds deposits or a snapshot.
+func (kv *KVStore) InitFromSnapshot(ctx context.Context, s *snapshot.Snapshot) error {
+	if _, err := snapshot.FromSnapshot(s); err != nil {
+		return err
+	}
+	hasSnapshot, err := kv.snapshot.Has(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to check deposit snapshot")
+	}
+	hasDeposits, err := kv.store.Has(ctx, 0)
+	if err != nil {
+		return errors.Wrap(err, "failed to check deposits")
+	}
+	if hasSnapshot || hasDeposits {
+		kv.logger.Info("Deposit store already initialized, skipping snapshot")
+		return nil
+	}
+
+	if err = kv.setSnapshot(ctx, s); err != nil {
+		return err
+	}
+	kv.logger.Info("Initialized deposit store from snapshot", "count", s.DepositCount, "root", s.DepositRoot)
+	return nil
+}
+
+// PruneToSnapshot replaces the first count deposits, or all deposits in store
+// if there are fewer, with their EIP-4881 snapshot.
+func (kv *KVStore) PruneToSnapshot(ctx context.Context, count uint64) error {
+	base, err := kv.getSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+	if count <= base.DepositCount {
+		return nil
+	}
+	s, err := kv.GetSnapshot(ctx, count)
+	if err != nil {
+		return err
+	}
+	if s.DepositCount == base.DepositCount {
+		return nil
+	}
+
+	// Persist the snapshot first, so that pruned deposits are always covered.
+	if err = kv.setSnapshot(ctx, s); err != nil {
+		return err
+	}
+	for i := base.DepositCount; i < s.DepositCount; i++ {
+		if err = kv.store.Remove(ctx, i); err != nil {
+			return errors.Wrapf(err, "failed to prune deposit %d", i)
+		}
+		if err = kv.blocks.Remove(ctx, i); err != nil {
+			return errors.Wrapf(err, "failed to prune block of deposit %d", i)
+		}
+	}
+
+	kv.logger.Info("Pruned deposits to snapshot", "start", base.DepositCount, "end", s.DepositCount)
+	return nil
+}
+
+// getSnapshot returns the snapshot deposits were pruned to, or the snapshot
+// of the empty deposit tree if deposits were never pruned.
+func (kv *KVStore) getSnapshot(ctx context.Context) (*snapshot.Snapshot, error) {
+	bz, err := kv.snapshot.Get(ctx)
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return snapshot.NewTree().Snapshot(common.ExecutionHash{}, 0), nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get deposit snapshot")
+	}
+	s := new(snapshot.Snapshot)
+	if err = json.Unmarshal(bz, s); err != nil {
+		return nil, errors.Wrap(err, "failed to decode deposit snapshot")
+	}
+	return s, nil
+}
+
+func (kv *KVStore) setSnapshot(ctx context.Context, s *snapshot.Snapshot) error {
+	bz, err := json.Marshal(s)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode deposit snapshot")
+	}
+	return errors.Wrap(kv.snapshot.Set(ctx, bz), "failed to set deposit snapshot")
+}
@@ -23,12 +23,14 @@ package deposit_test
 import (
 	"testing"
 
+	sdkcollections "cosmossdk.io/collections"
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/storage/db"
+	"github.com/berachain/beacon-kit/storage/deposit/snapshot"
 	"github.com/berachain/beacon-kit/storage/deposit/v1"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
@@ -77,3 +79,121 @@ func BenchmarkDepositsInsertion(b *testing.B) {
 		}
 	}
 }
+
+func TestDepositBlockNumbers(t *testing.T) {
+	t.Parallel()
+
+	baseDB, err := db.OpenDB("", dbm.MemDBBackend)
+	require.NoError(t, err)
+	store := deposit.NewStore(baseDB, log.NewNopLogger())
+	ctx := t.Context()
+
+	newDeposit := func(index uint64) *types.Deposit {
+		return &types.Deposit{
+			Pubkey:      [48]byte{byte(index)},
+			Credentials: types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{byte(index)}),
+			Amount:      10_000,
+			Index:       index,
+		}
+	}
+
+	// Genesis deposits are not read from any execution block.
+	require.NoError(t, store.EnqueueDeposits(ctx, []*types.Deposit{newDeposit(0)}))
+	require.NoError(t, store.EnqueueDepositsFromBlock(
+		ctx, []*types.Deposit{newDeposit(1), newDeposit(2)}, 42, common.ExecutionHash{42},
+	))
+
+	deposits, _, err := store.GetDepositsByIndex(ctx, constants.FirstDepositIndex, 10)
+	require.NoError(t, err)
+	require.Len(t, deposits, 3)
+
+	_, err = store.GetDepositBlockNumber(ctx, 0)
+	require.ErrorIs(t, err, sdkcollections.ErrNotFound)
+	for _, idx := range []uint64{1, 2} {
+		blockNumber, errBlk := store.GetDepositBlockNumber(ctx, idx)
+		require.NoError(t, errBlk)
+		require.Equal(t, uint64(42), blockNumber)
+	}
+
+	// Pruning drops the block numbers along with the deposits.
+	require.NoError(t, store.Prune(ctx, 1, 1))
+	_, err = store.GetDepositBlockNumber(ctx, 1)
+	require.ErrorIs(t, err, sdkcollections.ErrNotFound)
+	blockNumber, err := store.GetDepositBlockNumber(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), blockNumber)
+}
+
+func TestPruneToSnapshot(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	newStore := func() *deposit.KVStore {
+		baseDB, err := db.OpenDB("", dbm.MemDBBackend)
+		require.NoError(t, err)
+		return deposit.NewStore(baseDB, log.NewNopLogger())
+	}
+
+	deposits := make(types.Deposits, 0, 10)
+	for i := range uint64(10) {
+		deposits = append(deposits, &types.Deposit{
+			Pubkey:      [48]byte{byte(i)},
+			Credentials: types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{byte(i)}),
+			Amount:      10_000,
+			Index:       i,
+		})
+	}
+
+	store := newStore()
+	require.NoError(t, store.EnqueueDepositsFromBlock(ctx, deposits[:6], 40, common.ExecutionHash{40}))
+	require.NoError(t, store.EnqueueDepositsFromBlock(ctx, deposits[6:], 42, common.ExecutionHash{42}))
+
+	s, err := store.GetSnapshot(ctx, 6)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), s.DepositCount)
+	require.Equal(t, deposits[:6].HashTreeRoot(), s.DepositRoot)
+	require.Equal(t, uint64(40), s.ExecutionBlockHeight)
+	require.Equal(t, common.ExecutionHash{40}, s.ExecutionBlockHash)
+
+	// Snapshots are capped to the deposits in store.
+	s, err = store.GetSnapshot(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), s.DepositCount)
+	require.Equal(t, deposits.HashTreeRoot(), s.DepositRoot)
+	require.Equal(t, uint64(42), s.ExecutionBlockHeight)
+	require.Equal(t, common.ExecutionHash{42}, s.ExecutionBlockHash)
+
+	// Pruning replaces deposits with their snapshot, which later snapshots build upon.
+	require.NoError(t, store.PruneToSnapshot(ctx, 6))
+	remaining, _, err := store.GetDepositsByIndex(ctx, constants.FirstDepositIndex, 10)
+	require.NoError(t, err)
+	require.Empty(t, remaining)
+	remaining, _, err = store.GetDepositsByIndex(ctx, 6, 10)
+	require.NoError(t, err)
+	require.Len(t, remaining, 4)
+
+	_, err = store.GetSnapshot(ctx, 5)
+	require.Error(t, err)
+	pruned, err := store.GetSnapshot(ctx, 6)
+	require.NoError(t, err)
+	require.Equal(t, deposits[:6].HashTreeRoot(), pruned.DepositRoot)
+	require.Equal(t, common.ExecutionHash{40}, pruned.ExecutionBlockHash)
+	s, err = store.GetSnapshot(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, deposits.HashTreeRoot(), s.DepositRoot)
+	require.Equal(t, common.ExecutionHash{42}, s.ExecutionBlockHash)
+
+	// A store initialized from the snapshot serves the same deposit tree.
+	restored := newStore()
+	require.NoError(t, restored.InitFromSnapshot(ctx, pruned))
+	require.NoError(t, restored.EnqueueDeposits(ctx, deposits[6:]))
+	s, err = restored.GetSnapshot(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, deposits.HashTreeRoot(), s.DepositRoot)
+
+	// Stores already holding deposits are not initialized from snapshots.
+	require.NoError(t, store.InitFromSnapshot(ctx, &snapshot.Snapshot{DepositRoot: types.Deposits{}.HashTreeRoot()}))
+	s, err = store.GetSnapshot(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), s.DepositCount)
+}
@@ -40,26 +40,37 @@ func FixedComponents(t *testing.T) []any {
 		components.ProvideBlsSigner,
 		components.ProvideBlobProcessor,
 		components.ProvideBlobProofVerifier,
+		components.ProvideBlockSchedule,
 		components.ProvideChainService,
 		components.ProvideNode,
 		components.ProvideConfig,
 		components.ProvideServerConfig,
 		components.ProvideDepositStore,
+		components.ProvideDebugService,
+		components.ProvideFeeRecipientRegistry,
 		components.ProvideEngineClient,
 		components.ProvideExecutionEngine,
+		components.ProvideHealthMonitor,
 		components.ProvideJWTSecret,
 		components.ProvideLocalBuilder,
+		components.ProvideLogReloadService,
+		components.ProvideRelayClient,
 		components.ProvideReportingService,
 		components.ProvideServiceRegistry,
 		components.ProvideSidecarFactory,
+		components.ProvideStateArchive,
 		components.ProvideStateProcessor,
 		components.ProvideKVStore,
 		components.ProvideStorageBackend,
+		components.ProvideMetricsRegistry,
+		components.ProvideMetricsService,
 		components.ProvideTelemetrySink,
 		components.ProvideTelemetryService,
+		components.ProvideTracingService,
 		components.ProvideTrustedSetup,
 		components.ProvideValidatorService,
 		components.ProvideNodeAPIServer,
+		components.ProvidePluginExtensions,
 		components.ProvideShutDownService,
 	}
 	return c
@@ -226,6 +226,7 @@ func (s *PectraForkSuite) TestTimestampFork_ELAndCLInSync_IsSuccessful() {
 			blockchain.BeaconBlockTxIndex,
 			blockchain.BlobSidecarsTxIndex,
 			forkVersion,
+			s.Reth.TestNode.ChainSpec.IsProposalCompressed(math.U64(consensusTime.Unix())), //#nosec: G115
 		)
 		s.Require().NoError(err)
 		consensusTime = time.Unix(
@@ -30,10 +30,12 @@ import (
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/delay"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-core/builder"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/node-core/types/network"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	cmtcrypto "github.com/cometbft/cometbft/crypto"
 	pvm "github.com/cometbft/cometbft/privval"
@@ -61,7 +63,8 @@ func ProvideSimComet(
 	cs chain.Spec,
 	cmtCfg *cmtcfg.Config,
 	appOpts config.AppOptions,
-	telemetrySink *metrics.TelemetrySink) *SimComet {
+	telemetrySink *metrics.TelemetrySink,
+	blockSchedule *delay.Schedule) *SimComet {
 	return &SimComet{
 		Comet: cometbft.NewService(
 			logger,
@@ -71,7 +74,7 @@ func ProvideSimComet(
 			cs,
 			cmtCfg,
 			telemetrySink,
-			builder.DefaultServiceOptions(appOpts)...,
+			append(builder.DefaultServiceOptions(appOpts), cometbft.SetBlockSchedule(blockSchedule))...,
 		),
 		cmtCfg: cmtCfg,
 	}
@@ -127,3 +130,12 @@ func (s *SimComet) GetBlock(height int64) *cmttypes.Block {
 func (s *SimComet) GetSignedHeader(height int64) *cmttypes.SignedHeader {
 	return s.Comet.GetSignedHeader(height)
 }
+
+func (s *SimComet) NodeIdentity() (*network.NodeIdentity, error) {
+	return s.Comet.NodeIdentity()
+}
+
+// Peers returns no peers, the simulated node not being on a p2p network.
+func (s *SimComet) Peers() []*network.PeerInfo {
+	return nil
+}
@@ -247,6 +247,7 @@ func (s *SharedAccessors) MoveChainToHeight(
 			blockchain.BeaconBlockTxIndex,
 			blockchain.BlobSidecarsTxIndex,
 			forkVersion,
+			s.TestNode.ChainSpec.IsProposalCompressed(math.U64(proposalTime.Unix())), //#nosec: G115
 		)
 		require.NoError(t, err)
 		proposalTime = time.Unix(